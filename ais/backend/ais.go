@@ -617,6 +617,44 @@ func (m *AISBackendProvider) PutObj(r io.ReadCloser, lom *cluster.LOM) (errCode
 	return
 }
 
+// PutObjRemote and DeleteObjRemote write (and delete) objects in remote buckets on behalf of
+// local ones - see xs.XactReplicate
+
+func (m *AISBackendProvider) PutObjRemote(remoteBck *cmn.Bck, lom *cluster.LOM, r cos.ReadOpenCloser) (errCode int, err error) {
+	var (
+		remAis *remAis
+		bck    = *remoteBck
+	)
+	if remAis, err = m.getRemAis(bck.Ns.UUID); err != nil {
+		cos.Close(r)
+		return
+	}
+	unsetUUID(&bck)
+	args := api.PutArgs{
+		BaseParams: remAis.bp,
+		Bck:        bck,
+		ObjName:    lom.ObjName,
+		Cksum:      lom.Checksum(),
+		Reader:     r,
+		Size:       uint64(lom.SizeBytes()),
+	}
+	_, err = api.PutObject(args)
+	return extractErrCode(err, remAis.uuid)
+}
+
+func (m *AISBackendProvider) DeleteObjRemote(remoteBck *cmn.Bck, objName string) (errCode int, err error) {
+	var (
+		remAis *remAis
+		bck    = *remoteBck
+	)
+	if remAis, err = m.getRemAis(bck.Ns.UUID); err != nil {
+		return
+	}
+	unsetUUID(&bck)
+	err = api.DeleteObject(remAis.bp, bck, objName)
+	return extractErrCode(err, remAis.uuid)
+}
+
 func (m *AISBackendProvider) DeleteObj(lom *cluster.LOM) (errCode int, err error) {
 	var (
 		remAis    *remAis
@@ -28,6 +28,7 @@ import (
 	"github.com/NVIDIA/aistore/fs"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
@@ -52,13 +53,16 @@ type (
 )
 
 var (
-	clients    map[string]map[string]*s3.S3 // one client per (region, endpoint)
+	clients    map[string]map[string]*s3.S3 // one client per (region, endpoint and credentials profile)
 	cmu        sync.RWMutex
 	s3Endpoint string
 )
 
 // interface guard
-var _ cluster.BackendProvider = (*awsProvider)(nil)
+var (
+	_ cluster.BackendProvider = (*awsProvider)(nil)
+	_ cluster.RangeReader     = (*awsProvider)(nil)
+)
 
 func NewAWS(t cluster.TargetPut) (cluster.BackendProvider, error) {
 	clients = make(map[string]map[string]*s3.S3, 2)
@@ -102,7 +106,7 @@ func (*awsProvider) HeadBucket(_ ctx, bck *meta.Bck) (bckProps cos.StrKVs, errCo
 			return
 		}
 		// Create new svc with the region details.
-		if svc, _, err = newClient(sessConf{region: region}, ""); err != nil {
+		if svc, _, err = newClient(sessConf{bck: cloudBck, region: region}, ""); err != nil {
 			errCode, err = awsErrorToAISError(err, cloudBck)
 			return
 		}
@@ -111,12 +115,14 @@ func (*awsProvider) HeadBucket(_ ctx, bck *meta.Bck) (bckProps cos.StrKVs, errCo
 	debug.Assert(region != "")
 
 	// NOTE: return a few assorted fields, specifically to fill-in vendor-specific `cmn.ExtraProps`
-	bckProps = make(cos.StrKVs, 4)
+	bckProps = make(cos.StrKVs, 5)
 	bckProps[apc.HdrBackendProvider] = apc.AWS
 	bckProps[apc.HdrS3Region] = region
 	bckProps[apc.HdrS3Endpoint] = ""
+	bckProps[apc.HdrS3Profile] = ""
 	if bck.Props != nil {
 		bckProps[apc.HdrS3Endpoint] = bck.Props.Extra.AWS.Endpoint
+		bckProps[apc.HdrS3Profile] = bck.Props.Extra.AWS.Profile
 	}
 	versioned, errV := getBucketVersioning(svc, cloudBck)
 	if errV != nil {
@@ -157,9 +163,15 @@ func (awsp *awsProvider) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.Ls
 	msg.PageSize = calcPageSize(msg.PageSize, awsp.MaxPageSize())
 	params.MaxKeys = aws.Int64(int64(msg.PageSize))
 
-	resp, err := svc.ListObjectsV2(params)
+	var resp *s3.ListObjectsV2Output
+	errCode, err = throttled(context.Background(), cloudBck, func() (int, error) {
+		resp, err = svc.ListObjectsV2(params)
+		if err != nil {
+			return awsErrorToAISError(err, cloudBck)
+		}
+		return 0, nil
+	})
 	if err != nil {
-		errCode, err = awsErrorToAISError(err, cloudBck)
 		return
 	}
 
@@ -261,7 +273,7 @@ func (*awsProvider) ListBuckets(cmn.QueryBcks) (bcks cmn.Bcks, errCode int, err
 // HEAD OBJECT //
 /////////////////
 
-func (*awsProvider) HeadObj(_ ctx, lom *cluster.LOM) (oa *cmn.ObjAttrs, errCode int, err error) {
+func (*awsProvider) HeadObj(ctx context.Context, lom *cluster.LOM) (oa *cmn.ObjAttrs, errCode int, err error) {
 	var (
 		headOutput *s3.HeadObjectOutput
 		svc        *s3.S3
@@ -272,12 +284,17 @@ func (*awsProvider) HeadObj(_ ctx, lom *cluster.LOM) (oa *cmn.ObjAttrs, errCode
 	if err != nil && verbose {
 		glog.Warning(err)
 	}
-	headOutput, err = svc.HeadObject(&s3.HeadObjectInput{
-		Bucket: aws.String(cloudBck.Name),
-		Key:    aws.String(lom.ObjName),
+	errCode, err = throttled(ctx, cloudBck, func() (int, error) {
+		headOutput, err = svc.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+			Bucket: aws.String(cloudBck.Name),
+			Key:    aws.String(lom.ObjName),
+		})
+		if err != nil {
+			return awsErrorToAISError(err, cloudBck)
+		}
+		return 0, nil
 	})
 	if err != nil {
-		errCode, err = awsErrorToAISError(err, cloudBck)
 		return
 	}
 	oa = &cmn.ObjAttrs{}
@@ -356,12 +373,17 @@ func (*awsProvider) GetObjReader(ctx context.Context, lom *cluster.LOM) (r io.Re
 	if err != nil && verbose {
 		glog.Warning(err)
 	}
-	obj, err = svc.GetObjectWithContext(ctx, &s3.GetObjectInput{
-		Bucket: aws.String(cloudBck.Name),
-		Key:    aws.String(lom.ObjName),
+	errCode, err = throttled(ctx, cloudBck, func() (int, error) {
+		obj, err = svc.GetObjectWithContext(ctx, &s3.GetObjectInput{
+			Bucket: aws.String(cloudBck.Name),
+			Key:    aws.String(lom.ObjName),
+		})
+		if err != nil {
+			return awsErrorToAISError(err, cloudBck)
+		}
+		return 0, nil
 	})
 	if err != nil {
-		errCode, err = awsErrorToAISError(err, cloudBck)
 		return
 	}
 
@@ -379,6 +401,44 @@ func (*awsProvider) GetObjReader(ctx context.Context, lom *cluster.LOM) (r io.Re
 	return wrapReader(ctx, obj.Body), expCksum, 0, nil
 }
 
+// GetObjRange reads a single byte range of the specified (via `oa`) version of the object
+func (*awsProvider) GetObjRange(ctx context.Context, lom *cluster.LOM, oa *cmn.ObjAttrs, offset, length int64) (r io.ReadCloser,
+	errCode int, err error) {
+	var (
+		obj      *s3.GetObjectOutput
+		svc      *s3.S3
+		cloudBck = lom.Bck().RemoteBck()
+		input    = &s3.GetObjectInput{
+			Bucket: aws.String(cloudBck.Name),
+			Key:    aws.String(lom.ObjName),
+			Range:  aws.String(fmtRange(offset, length)),
+		}
+	)
+	if oa.Ver != "" {
+		input.VersionId = aws.String(oa.Ver)
+	} else if etag, ok := oa.GetCustomKey(cmn.ETag); ok {
+		if !strings.HasPrefix(etag, "\"") {
+			etag = strconv.Quote(etag)
+		}
+		input.IfMatch = aws.String(etag)
+	}
+	svc, _, err = newClient(sessConf{bck: cloudBck}, "[get_object_range]")
+	if err != nil && verbose {
+		glog.Warning(err)
+	}
+	errCode, err = throttled(ctx, cloudBck, func() (int, error) {
+		obj, err = svc.GetObjectWithContext(ctx, input)
+		if err != nil {
+			return awsErrorToAISError(err, cloudBck)
+		}
+		return 0, nil
+	})
+	if err != nil {
+		return
+	}
+	return obj.Body, 0, nil
+}
+
 func getobjCustom(lom *cluster.LOM, obj *s3.GetObjectOutput) (expCksum *cos.Cksum) {
 	h := cmn.BackendHelpers.Amazon
 	if v, ok := h.EncodeVersion(obj.VersionId); ok {
@@ -386,9 +446,12 @@ func getobjCustom(lom *cluster.LOM, obj *s3.GetObjectOutput) (expCksum *cos.Cksu
 		lom.SetCustomKey(cmn.VersionObjMD, v)
 	}
 	// see ETag/MD5 NOTE above
-	if v, ok := h.EncodeCksum(obj.ETag); ok && !strings.Contains(v, cmn.AwsMultipartDelim) {
-		expCksum = cos.NewCksum(cos.ChecksumMD5, v)
-		lom.SetCustomKey(cmn.MD5ObjMD, v)
+	if v, ok := h.EncodeCksum(obj.ETag); ok {
+		lom.SetCustomKey(cmn.ETag, v) // (pins the object for subsequent range reads - see GetObjRange)
+		if !strings.Contains(v, cmn.AwsMultipartDelim) {
+			expCksum = cos.NewCksum(cos.ChecksumMD5, v)
+			lom.SetCustomKey(cmn.MD5ObjMD, v)
+		}
 	}
 	mtime := *(obj.LastModified)
 	lom.SetCustomKey(cmn.LastModified, fmtTime(mtime))
@@ -399,52 +462,73 @@ func getobjCustom(lom *cluster.LOM, obj *s3.GetObjectOutput) (expCksum *cos.Cksu
 // PUT OBJECT //
 ////////////////
 
-func (*awsProvider) PutObj(r io.ReadCloser, lom *cluster.LOM) (errCode int, err error) {
+func (awsp *awsProvider) PutObj(r io.ReadCloser, lom *cluster.LOM) (errCode int, err error) {
 	var (
-		svc                   *s3.S3
-		uploadOutput          *s3manager.UploadOutput
-		h                     = cmn.BackendHelpers.Amazon
-		cksumType, cksumValue = lom.Checksum().Get()
-		cloudBck              = lom.Bck().RemoteBck()
-		md                    = make(map[string]*string, 2)
+		svc          *s3.S3
+		uploadOutput *s3manager.UploadOutput
+		cloudBck     = lom.Bck().RemoteBck()
 	)
 	defer cos.Close(r)
 
+	if ra, conf, ok := mpuEnabled(r, lom); ok {
+		errCode, err = mpuPut(awsp, ra, lom, conf)
+		if err == nil && verbose {
+			glog.Infof("[put_object] %s (multipart)", lom)
+		}
+		return
+	}
+
 	svc, _, err = newClient(sessConf{bck: cloudBck}, "[put_object]")
 	if err != nil && verbose {
 		glog.Warning(err)
 	}
 
-	md[cos.S3MetadataChecksumType] = aws.String(cksumType)
-	md[cos.S3MetadataChecksumVal] = aws.String(cksumValue)
-
+	rateLimit(cloudBck)
 	uploader := s3manager.NewUploaderWithClient(svc)
 	uploadOutput, err = uploader.Upload(&s3manager.UploadInput{
 		Bucket:   aws.String(cloudBck.Name),
 		Key:      aws.String(lom.ObjName),
 		Body:     r,
-		Metadata: md,
+		Metadata: putobjMD(lom),
 	})
 	if err != nil {
 		errCode, err = awsErrorToAISError(err, cloudBck)
 		return
 	}
-	// compare with setCustomS3() above
-	if v, ok := h.EncodeVersion(uploadOutput.VersionID); ok {
+	putobjCustom(lom, uploadOutput.VersionID, uploadOutput.ETag)
+	if verbose {
+		glog.Infof("[put_object] %s", lom)
+	}
+	return
+}
+
+func putobjMD(lom *cluster.LOM) map[string]*string {
+	var (
+		md                    = make(map[string]*string, 2)
+		cksumType, cksumValue = lom.Checksum().Get()
+	)
+	md[cos.S3MetadataChecksumType] = aws.String(cksumType)
+	md[cos.S3MetadataChecksumVal] = aws.String(cksumValue)
+	return md
+}
+
+// compare with getobjCustom() above
+func putobjCustom(lom *cluster.LOM, versionID, etag *string) {
+	h := cmn.BackendHelpers.Amazon
+	if v, ok := h.EncodeVersion(versionID); ok {
 		lom.SetCustomKey(cmn.VersionObjMD, v)
 		lom.SetVersion(v)
 	}
-	if v, ok := h.EncodeCksum(uploadOutput.ETag); ok {
+	if etag == nil {
+		return
+	}
+	if v, ok := h.EncodeCksum(etag); ok {
 		lom.SetCustomKey(cmn.ETag, v)
 		// see ETag/MD5 NOTE above
 		if !strings.Contains(v, cmn.AwsMultipartDelim) {
 			lom.SetCustomKey(cmn.MD5ObjMD, v)
 		}
 	}
-	if verbose {
-		glog.Infof("[put_object] %s", lom)
-	}
-	return
 }
 
 ///////////////////
@@ -460,12 +544,17 @@ func (*awsProvider) DeleteObj(lom *cluster.LOM) (errCode int, err error) {
 	if err != nil && verbose {
 		glog.Warning(err)
 	}
-	_, err = svc.DeleteObject(&s3.DeleteObjectInput{
-		Bucket: aws.String(cloudBck.Name),
-		Key:    aws.String(lom.ObjName),
+	errCode, err = throttled(context.Background(), cloudBck, func() (int, error) {
+		_, err = svc.DeleteObject(&s3.DeleteObjectInput{
+			Bucket: aws.String(cloudBck.Name),
+			Key:    aws.String(lom.ObjName),
+		})
+		if err != nil {
+			return awsErrorToAISError(err, cloudBck)
+		}
+		return 0, nil
 	})
 	if err != nil {
-		errCode, err = awsErrorToAISError(err, cloudBck)
 		return
 	}
 	if verbose {
@@ -486,7 +575,10 @@ func (*awsProvider) DeleteObj(lom *cluster.LOM) (errCode int, err error) {
 // "S3 methods are safe to use concurrently. It is not safe to modify mutate
 // any of the struct's properties though."
 func newClient(conf sessConf, tag string) (svc *s3.S3, region string, err error) {
-	endpoint := s3Endpoint
+	var (
+		profile  string
+		endpoint = s3Endpoint
+	)
 	region = conf.region
 	if conf.bck != nil && conf.bck.Props != nil {
 		if region == "" {
@@ -495,12 +587,17 @@ func newClient(conf sessConf, tag string) (svc *s3.S3, region string, err error)
 		if conf.bck.Props.Extra.AWS.Endpoint != "" {
 			endpoint = conf.bck.Props.Extra.AWS.Endpoint
 		}
+		profile = conf.bck.Props.Extra.AWS.Profile
+	}
+	ekey := endpoint
+	if profile != "" {
+		ekey += "#" + profile
 	}
 
 	// reuse
 	if region != "" {
 		cmu.RLock()
-		svc = clients[region][endpoint]
+		svc = clients[region][ekey]
 		cmu.RUnlock()
 		if svc != nil {
 			return
@@ -511,11 +608,15 @@ func newClient(conf sessConf, tag string) (svc *s3.S3, region string, err error)
 		sess    = _session(endpoint)
 		awsConf = &aws.Config{}
 	)
+	if profile != "" {
+		// NOTE: loaded lazily - a missing profile fails the first request (rather than this call)
+		awsConf.Credentials = credentials.NewSharedCredentials("", profile)
+	}
 	if region == "" {
 		if tag != "" {
 			err = fmt.Errorf("%s: unknown region for bucket %s -- proceeding with default", tag, conf.bck)
 		}
-		svc = s3.New(sess)
+		svc = s3.New(sess, awsConf)
 		return
 	}
 	// ok
@@ -529,7 +630,7 @@ func newClient(conf sessConf, tag string) (svc *s3.S3, region string, err error)
 		eps = make(map[string]*s3.S3, 1)
 		clients[region] = eps
 	}
-	eps[endpoint] = svc
+	eps[ekey] = svc
 	cmu.Unlock()
 	return
 }
@@ -0,0 +1,114 @@
+//go:build aws
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"io"
+
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// S3 multipart upload (see mpu.go)
+
+// interface guard
+var _ mpuProvider = (*awsProvider)(nil)
+
+func (*awsProvider) mpuStart(lom *cluster.LOM) (uploadID string, errCode int, err error) {
+	var (
+		svc      *s3.S3
+		out      *s3.CreateMultipartUploadOutput
+		cloudBck = lom.Bck().RemoteBck()
+	)
+	svc, _, err = newClient(sessConf{bck: cloudBck}, "[mpu_start]")
+	if err != nil && verbose {
+		glog.Warning(err)
+	}
+	out, err = svc.CreateMultipartUpload(&s3.CreateMultipartUploadInput{
+		Bucket:   aws.String(cloudBck.Name),
+		Key:      aws.String(lom.ObjName),
+		Metadata: putobjMD(lom),
+	})
+	if err != nil {
+		errCode, err = awsErrorToAISError(err, cloudBck)
+		return
+	}
+	return *out.UploadId, 0, nil
+}
+
+func (*awsProvider) mpuPart(lom *cluster.LOM, uploadID string, num int, r *io.SectionReader) (etag string, errCode int, err error) {
+	var (
+		svc      *s3.S3
+		out      *s3.UploadPartOutput
+		cloudBck = lom.Bck().RemoteBck()
+	)
+	svc, _, err = newClient(sessConf{bck: cloudBck}, "[mpu_part]")
+	if err != nil && verbose {
+		glog.Warning(err)
+	}
+	out, err = svc.UploadPart(&s3.UploadPartInput{
+		Bucket:        aws.String(cloudBck.Name),
+		Key:           aws.String(lom.ObjName),
+		UploadId:      aws.String(uploadID),
+		PartNumber:    aws.Int64(int64(num)),
+		Body:          r,
+		ContentLength: aws.Int64(r.Size()),
+	})
+	if err != nil {
+		errCode, err = awsErrorToAISError(err, cloudBck)
+		return
+	}
+	return *out.ETag, 0, nil
+}
+
+func (*awsProvider) mpuComplete(lom *cluster.LOM, uploadID string, parts []mpuPart) (errCode int, err error) {
+	var (
+		svc       *s3.S3
+		out       *s3.CompleteMultipartUploadOutput
+		cloudBck  = lom.Bck().RemoteBck()
+		completed = make([]*s3.CompletedPart, 0, len(parts))
+	)
+	svc, _, err = newClient(sessConf{bck: cloudBck}, "[mpu_complete]")
+	if err != nil && verbose {
+		glog.Warning(err)
+	}
+	for _, p := range parts {
+		completed = append(completed, &s3.CompletedPart{ETag: aws.String(p.ETag), PartNumber: aws.Int64(int64(p.Num))})
+	}
+	out, err = svc.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(cloudBck.Name),
+		Key:             aws.String(lom.ObjName),
+		UploadId:        aws.String(uploadID),
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: completed},
+	})
+	if err != nil {
+		errCode, err = awsErrorToAISError(err, cloudBck)
+		return
+	}
+	putobjCustom(lom, out.VersionId, out.ETag)
+	return
+}
+
+func (*awsProvider) mpuAbort(bck *cmn.Bck, objName, uploadID string) (errCode int, err error) {
+	var svc *s3.S3
+	svc, _, err = newClient(sessConf{bck: bck}, "[mpu_abort]")
+	if err != nil && verbose {
+		glog.Warning(err)
+	}
+	_, err = svc.AbortMultipartUpload(&s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(bck.Name),
+		Key:      aws.String(objName),
+		UploadId: aws.String(uploadID),
+	})
+	if err != nil {
+		errCode, err = awsErrorToAISError(err, bck)
+	}
+	return
+}
@@ -67,6 +67,7 @@ var (
 
 	// interface guard
 	_ cluster.BackendProvider = (*azureProvider)(nil)
+	_ cluster.RangeReader     = (*azureProvider)(nil)
 )
 
 func azureProto() string {
@@ -241,13 +242,20 @@ func (ap *azureProvider) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.Ls
 		marker.Val = api.String(msg.ContinuationToken)
 	}
 
-	resp, err := cntURL.ListBlobsFlatSegment(azctx, marker, opts)
+	var resp *azblob.ListBlobsFlatSegmentResponse
+	errCode, err = throttled(azctx, cloudBck, func() (int, error) {
+		resp, err = cntURL.ListBlobsFlatSegment(azctx, marker, opts)
+		if err != nil {
+			return azureErrorToAISError(err, cloudBck, "")
+		}
+		if resp.StatusCode() >= http.StatusBadRequest {
+			err := cmn.NewErrFailedTo(apc.Azure, "list objects of", cloudBck.Name, azureErrStatus(resp.StatusCode()))
+			return resp.StatusCode(), err
+		}
+		return 0, nil
+	})
 	if err != nil {
-		return azureErrorToAISError(err, cloudBck, "")
-	}
-	if resp.StatusCode() >= http.StatusBadRequest {
-		err := cmn.NewErrFailedTo(apc.Azure, "list objects of", cloudBck.Name, azureErrStatus(resp.StatusCode()))
-		return resp.StatusCode(), err
+		return
 	}
 
 	l := len(resp.Segment.BlobItems)
@@ -321,14 +329,18 @@ func (ap *azureProvider) HeadObj(ctx context.Context, lom *cluster.LOM) (oa *cmn
 		cntURL   = ap.s.NewContainerURL(cloudBck.Name)
 		blobURL  = cntURL.NewBlobURL(lom.ObjName)
 	)
-	if resp, err = blobURL.GetProperties(ctx, azblob.BlobAccessConditions{}, defaultKeyOptions); err != nil {
-		errCode, err = azureErrorToAISError(err, cloudBck, lom.ObjName)
-		return
-	}
-	if resp.StatusCode() >= http.StatusBadRequest {
-		err = cmn.NewErrFailedTo(apc.Azure, "get object props of", cloudBck.Name+"/"+lom.ObjName,
-			azureErrStatus(resp.StatusCode()))
-		errCode = resp.StatusCode()
+	errCode, err = throttled(ctx, cloudBck, func() (int, error) {
+		if resp, err = blobURL.GetProperties(ctx, azblob.BlobAccessConditions{}, defaultKeyOptions); err != nil {
+			return azureErrorToAISError(err, cloudBck, lom.ObjName)
+		}
+		if resp.StatusCode() >= http.StatusBadRequest {
+			err = cmn.NewErrFailedTo(apc.Azure, "get object props of", cloudBck.Name+"/"+lom.ObjName,
+				azureErrStatus(resp.StatusCode()))
+			return resp.StatusCode(), err
+		}
+		return 0, nil
+	})
+	if err != nil {
 		return
 	}
 	oa = &cmn.ObjAttrs{}
@@ -386,28 +398,36 @@ func (ap *azureProvider) GetObjReader(ctx context.Context, lom *cluster.LOM) (re
 		cntURL   = ap.s.NewContainerURL(cloudBck.Name)
 		blobURL  = cntURL.NewBlobURL(lom.ObjName)
 	)
-	// Get checksum
-	respProps, err := blobURL.GetProperties(ctx, azblob.BlobAccessConditions{}, defaultKeyOptions)
-	if err != nil {
-		status, err := azureErrorToAISError(err, cloudBck, lom.ObjName)
-		return nil, nil, status, err
-	}
-	if respProps.StatusCode() >= http.StatusBadRequest {
-		err := cmn.NewErrFailedTo(apc.Azure, "get object props of", cloudBck.Name+"/"+lom.ObjName,
-			azureErrStatus(respProps.StatusCode()))
-		return nil, nil, respProps.StatusCode(), err
-	}
-	// 0, 0 = read range: the whole object
-	resp, err := blobURL.Download(ctx, 0, 0, azblob.BlobAccessConditions{}, false, defaultKeyOptions)
+	var (
+		respProps *azblob.BlobGetPropertiesResponse
+		resp      *azblob.DownloadResponse
+	)
+	errCode, err = throttled(ctx, cloudBck, func() (int, error) {
+		// Get checksum
+		respProps, err = blobURL.GetProperties(ctx, azblob.BlobAccessConditions{}, defaultKeyOptions)
+		if err != nil {
+			return azureErrorToAISError(err, cloudBck, lom.ObjName)
+		}
+		if respProps.StatusCode() >= http.StatusBadRequest {
+			err := cmn.NewErrFailedTo(apc.Azure, "get object props of", cloudBck.Name+"/"+lom.ObjName,
+				azureErrStatus(respProps.StatusCode()))
+			return respProps.StatusCode(), err
+		}
+		// 0, 0 = read range: the whole object
+		resp, err = blobURL.Download(ctx, 0, 0, azblob.BlobAccessConditions{}, false, defaultKeyOptions)
+		if err != nil {
+			return azureErrorToAISError(err, cloudBck, lom.ObjName)
+		}
+		if resp.StatusCode() >= http.StatusBadRequest {
+			err := cmn.NewErrFailedTo(apc.Azure, "get object", cloudBck.Name+"/"+lom.ObjName,
+				azureErrStatus(respProps.StatusCode()))
+			return resp.StatusCode(), err
+		}
+		return 0, nil
+	})
 	if err != nil {
-		errCode, err = azureErrorToAISError(err, cloudBck, lom.ObjName)
 		return nil, nil, errCode, err
 	}
-	if resp.StatusCode() >= http.StatusBadRequest {
-		err := cmn.NewErrFailedTo(apc.Azure, "get object", cloudBck.Name+"/"+lom.ObjName,
-			azureErrStatus(respProps.StatusCode()))
-		return nil, nil, resp.StatusCode(), err
-	}
 
 	// custom metadata
 	lom.SetCustomKey(cmn.SourceObjMD, apc.Azure)
@@ -426,6 +446,38 @@ func (ap *azureProvider) GetObjReader(ctx context.Context, lom *cluster.LOM) (re
 	return wrapReader(ctx, resp.Body(retryOpts)), expCksum, 0, nil
 }
 
+// GetObjRange reads a single byte range of the specified (via `oa`) version (ETag) of the object
+func (ap *azureProvider) GetObjRange(ctx context.Context, lom *cluster.LOM, oa *cmn.ObjAttrs, offset, length int64) (r io.ReadCloser,
+	errCode int, err error) {
+	var (
+		cond     azblob.BlobAccessConditions
+		cloudBck = lom.Bck().RemoteBck()
+		cntURL   = ap.s.NewContainerURL(cloudBck.Name)
+		blobURL  = cntURL.NewBlobURL(lom.ObjName)
+	)
+	if oa.Ver != "" {
+		cond.ModifiedAccessConditions.IfMatch = azblob.ETag("\"" + oa.Ver + "\"")
+	}
+	var resp *azblob.DownloadResponse
+	errCode, err = throttled(ctx, cloudBck, func() (int, error) {
+		resp, err = blobURL.Download(ctx, offset, length, cond, false, defaultKeyOptions)
+		if err != nil {
+			return azureErrorToAISError(err, cloudBck, lom.ObjName)
+		}
+		if resp.StatusCode() >= http.StatusBadRequest {
+			err := cmn.NewErrFailedTo(apc.Azure, "get object range", cloudBck.Name+"/"+lom.ObjName,
+				azureErrStatus(resp.StatusCode()))
+			return resp.StatusCode(), err
+		}
+		return 0, nil
+	})
+	if err != nil {
+		return nil, errCode, err
+	}
+	retryOpts := azblob.RetryReaderOptions{MaxRetryRequests: 3}
+	return resp.Body(retryOpts), 0, nil
+}
+
 ////////////////
 // PUT OBJECT //
 ////////////////
@@ -433,6 +485,14 @@ func (ap *azureProvider) GetObjReader(ctx context.Context, lom *cluster.LOM) (re
 func (ap *azureProvider) PutObj(r io.ReadCloser, lom *cluster.LOM) (int, error) {
 	defer cos.Close(r)
 
+	if ra, conf, ok := mpuEnabled(r, lom); ok {
+		errCode, err := mpuPut(ap, ra, lom, conf)
+		if err == nil && verbose {
+			glog.Infof("[put_object] %s (multipart)", lom)
+		}
+		return errCode, err
+	}
+
 	var (
 		leaseID  string
 		h        = cmn.BackendHelpers.Azure
@@ -465,6 +525,7 @@ func (ap *azureProvider) PutObj(r io.ReadCloser, lom *cluster.LOM) (int, error)
 			LeaseAccessConditions: azblob.LeaseAccessConditions{LeaseID: leaseID},
 		}
 	}
+	rateLimit(cloudBck)
 	putResp, err := azblob.UploadStreamToBlockBlob(azctx, r, blobURL, opts)
 	if err != nil {
 		status, err := azureErrorToAISError(err, cloudBck, lom.ObjName)
@@ -494,11 +555,15 @@ func (ap *azureProvider) PutObj(r io.ReadCloser, lom *cluster.LOM) (int, error)
 // Delete looks complex because according to docs, it needs acquiring
 // an object beforehand and releasing the lease after
 func (ap *azureProvider) DeleteObj(lom *cluster.LOM) (int, error) {
+	cloudBck := lom.Bck().RemoteBck()
+	return throttled(azctx, cloudBck, func() (int, error) { return ap.deleteObj(lom, cloudBck) })
+}
+
+func (ap *azureProvider) deleteObj(lom *cluster.LOM, cloudBck *cmn.Bck) (int, error) {
 	var (
-		cloudBck = lom.Bck().RemoteBck()
-		cntURL   = ap.s.NewContainerURL(lom.Bck().Name)
-		blobURL  = cntURL.NewBlobURL(lom.ObjName)
-		cond     = azblob.ModifiedAccessConditions{}
+		cntURL  = ap.s.NewContainerURL(lom.Bck().Name)
+		blobURL = cntURL.NewBlobURL(lom.ObjName)
+		cond    = azblob.ModifiedAccessConditions{}
 	)
 
 	acqResp, err := blobURL.AcquireLease(azctx, "", leaseTime, cond)
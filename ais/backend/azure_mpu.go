@@ -0,0 +1,74 @@
+//go:build azure
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+// Azure multipart upload (see mpu.go): parts are staged as uncommitted blocks
+// of the destination block blob and then committed all at once
+
+// interface guard
+var _ mpuProvider = (*azureProvider)(nil)
+
+// NOTE: all block IDs of a given blob must have the same length
+func azureBlockID(uploadID string, num int) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s-%05d", uploadID, num)))
+}
+
+func (*azureProvider) mpuStart(*cluster.LOM) (string, int, error) { return mpuGenID(), 0, nil }
+
+func (ap *azureProvider) mpuPart(lom *cluster.LOM, uploadID string, num int, r *io.SectionReader) (string, int, error) {
+	var (
+		id       = azureBlockID(uploadID, num)
+		cloudBck = lom.Bck().RemoteBck()
+		blobURL  = ap.s.NewContainerURL(cloudBck.Name).NewBlockBlobURL(lom.ObjName)
+	)
+	if _, err := blobURL.StageBlock(azctx, id, r, azblob.LeaseAccessConditions{}, nil, defaultKeyOptions); err != nil {
+		code, err := azureErrorToAISError(err, cloudBck, lom.ObjName)
+		return "", code, err
+	}
+	return id, 0, nil
+}
+
+func (ap *azureProvider) mpuComplete(lom *cluster.LOM, _ string, parts []mpuPart) (int, error) {
+	var (
+		h        = cmn.BackendHelpers.Azure
+		cloudBck = lom.Bck().RemoteBck()
+		blobURL  = ap.s.NewContainerURL(cloudBck.Name).NewBlockBlobURL(lom.ObjName)
+		ids      = make([]string, 0, len(parts))
+	)
+	for _, p := range parts {
+		ids = append(ids, p.ETag)
+	}
+	resp, err := blobURL.CommitBlockList(azctx, ids, azblob.BlobHTTPHeaders{}, azblob.Metadata{},
+		azblob.BlobAccessConditions{}, azblob.AccessTierNone, nil, defaultKeyOptions, azblob.ImmutabilityPolicyOptions{})
+	if err != nil {
+		return azureErrorToAISError(err, cloudBck, lom.ObjName)
+	}
+	if code := resp.StatusCode(); code >= http.StatusBadRequest {
+		err := cmn.NewErrFailedTo(apc.Azure, "PUT", cloudBck.Name+"/"+lom.ObjName, azureErrStatus(code))
+		return code, err
+	}
+	if v, ok := h.EncodeVersion(string(resp.ETag())); ok {
+		lom.SetCustomKey(cmn.ETag, v) // NOTE: using ETag as version
+		lom.SetVersion(v)
+	}
+	return 0, nil
+}
+
+// nothing to do: uncommitted blocks get garbage-collected by Azure (in 7 days)
+func (*azureProvider) mpuAbort(*cmn.Bck, string, string) (int, error) { return 0, nil }
@@ -6,6 +6,7 @@ package backend
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"time"
 
@@ -36,6 +37,11 @@ func setSize(ctx context.Context, size int64) {
 
 func fmtTime(t time.Time) string { return t.Format(time.RFC3339) }
 
+// HTTP Range header value for the given (offset, length)
+func fmtRange(offset, length int64) string {
+	return fmt.Sprintf("%s%d-%d", cos.HdrRangeValPrefix, offset, offset+length-1)
+}
+
 func calcPageSize(pageSize, maxPageSize uint) uint {
 	if pageSize == 0 {
 		return maxPageSize
@@ -0,0 +1,485 @@
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// file:// backend maps a bucket onto a (local or shared, e.g. NFS or Lustre) directory tree -
+// the bucket's `extra.file.ref_directory` - with each regular file being an object named
+// by its relative path. Objects are cold-GET (cached) on demand; PUT and DELETE write through.
+// The object's version is derived from the file's mtime and size (see fileVersion).
+//
+// The directory must be located under one of the configured `backend.file.roots`
+// (cmn.BackendConfFile); symbolic links are resolved, and neither the directory
+// nor any object may resolve to a path outside of it (see fileRoot and filePath).
+
+const fileTmpInfix = ".ais-tmp." // write-through in progress (excluded from listing)
+
+type (
+	fileProvider struct {
+		t cluster.TargetPut
+	}
+	// byte range of an open file
+	fileSection struct {
+		*io.SectionReader
+		fh *os.File
+	}
+)
+
+// interface guard
+var (
+	_ cluster.BackendProvider = (*fileProvider)(nil)
+	_ cluster.RangeReader     = (*fileProvider)(nil)
+)
+
+func NewFile(t cluster.TargetPut) (cluster.BackendProvider, error) {
+	return &fileProvider{t: t}, nil
+}
+
+func fileErrorToAISError(err error) (int, error) {
+	switch {
+	case os.IsNotExist(err), cos.IsErrNotFound(err):
+		return http.StatusNotFound, err
+	case os.IsExist(err):
+		return http.StatusConflict, err
+	case os.IsPermission(err):
+		return http.StatusForbidden, err
+	}
+	return http.StatusInternalServerError, err
+}
+
+// version := (mtime, size)
+func fileVersion(fi os.FileInfo) string {
+	return strconv.FormatInt(fi.ModTime().UnixNano(), 10) + "-" + strconv.FormatInt(fi.Size(), 10)
+}
+
+// the bucket's directory with symlinks resolved, provided it is located under one of the allowed roots
+func fileRoot(bck *meta.Bck) (string, error) {
+	debug.Assert(bck.Props != nil)
+	dir, err := filepath.EvalSymlinks(bck.Props.Extra.File.RefDirectory)
+	if err != nil {
+		return "", err
+	}
+	for _, root := range cmn.GCO.Get().Backend.File.Roots {
+		if r, err := filepath.EvalSymlinks(root); err == nil && (dir == r || fileInDir(dir, r)) {
+			return dir, nil
+		}
+	}
+	return "", fmt.Errorf("%s: directory %q is not located under any of the allowed roots (backend.%s.roots)",
+		bck, dir, apc.File)
+}
+
+// object => file, making sure the latter does not escape the bucket's directory -
+// neither by its name nor via symlinks
+func filePath(bck *meta.Bck, objName string) (string, error) {
+	root, err := fileRoot(bck)
+	if err != nil {
+		return "", err
+	}
+	fpath := filepath.Join(root, objName)
+	if !fileInDir(fpath, root) {
+		return "", fmt.Errorf("%s: invalid object name %q", bck, objName)
+	}
+	resolved, err := fileEvalSymlinks(fpath)
+	if err != nil {
+		return "", err
+	}
+	if !fileInDir(resolved, root) {
+		return "", fmt.Errorf("%s: object %q resolves to %q outside %q", bck, objName, resolved, root)
+	}
+	return fpath, nil
+}
+
+func fileInDir(fpath, dir string) bool {
+	return strings.HasPrefix(fpath, strings.TrimSuffix(dir, string(filepath.Separator))+string(filepath.Separator))
+}
+
+// same as filepath.EvalSymlinks but allows for the trailing part of the path
+// to not exist yet (e.g., PUT)
+func fileEvalSymlinks(fpath string) (string, error) {
+	resolved, err := filepath.EvalSymlinks(fpath)
+	if err == nil || !os.IsNotExist(err) {
+		return resolved, err
+	}
+	parent := filepath.Dir(fpath)
+	if parent == fpath {
+		return fpath, nil
+	}
+	if resolved, err = fileEvalSymlinks(parent); err != nil {
+		return "", err
+	}
+	return filepath.Join(resolved, filepath.Base(fpath)), nil
+}
+
+func (*fileProvider) Provider() string  { return apc.File }
+func (*fileProvider) MaxPageSize() uint { return 10000 }
+
+///////////////////
+// CREATE BUCKET //
+///////////////////
+
+func (*fileProvider) CreateBucket(bck *meta.Bck) (errCode int, err error) {
+	return checkRefDirectory(bck)
+}
+
+// the directory must exist, must be located under one of the allowed roots,
+// and must not overlap with any of the target's mountpaths
+func checkRefDirectory(bck *meta.Bck) (int, error) {
+	dir, err := fileRoot(bck)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return http.StatusBadRequest, err
+		}
+		return http.StatusForbidden, err
+	}
+	fi, err := os.Stat(dir)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	if !fi.IsDir() {
+		return http.StatusBadRequest, fmt.Errorf("%s: %q is not a directory", bck, dir)
+	}
+	avail, _ := fs.Get()
+	for mpath := range avail {
+		if cmn.DirHasOrIsPrefix(mpath+"/", dir+"/") {
+			return http.StatusBadRequest, fmt.Errorf("%s: directory %q overlaps with mountpath %q", bck, dir, mpath)
+		}
+	}
+	return 0, nil
+}
+
+/////////////////
+// HEAD BUCKET //
+/////////////////
+
+func (*fileProvider) HeadBucket(_ ctx, bck *meta.Bck) (bckProps cos.StrKVs, errCode int, err error) {
+	// (not in BMD yet - nothing to check, see CreateBucket)
+	if bck.Props != nil {
+		if errCode, err = checkRefDirectory(bck); err != nil {
+			return
+		}
+	}
+	bckProps = make(cos.StrKVs, 2)
+	bckProps[apc.HdrBackendProvider] = apc.File
+	bckProps[apc.HdrBucketVerEnabled] = "true"
+	return
+}
+
+//////////////////
+// LIST OBJECTS //
+//////////////////
+
+// NOTE: walking the directory tree in the lexicographical order of (resulting) object names
+// - the order that allows to use the last listed name as a continuation token
+func (fp *fileProvider) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoResult) (int, error) {
+	dir, err := fileRoot(bck)
+	if err != nil {
+		return fileErrorToAISError(err)
+	}
+	w := &fileWalk{msg: msg, lst: lst, root: dir, token: msg.ContinuationToken}
+	msg.PageSize = calcPageSize(msg.PageSize, fp.MaxPageSize())
+	if msg.StartAfter > w.token {
+		w.token = msg.StartAfter
+	}
+	lst.Entries = lst.Entries[:0]
+	if err := w.walk(dir, ""); err != nil {
+		return fileErrorToAISError(err)
+	}
+	if uint(len(lst.Entries)) >= msg.PageSize {
+		lst.ContinuationToken = lst.Entries[len(lst.Entries)-1].Name
+	}
+	if verbose {
+		glog.Infof("[list_objects] %s: count %d", bck, len(lst.Entries))
+	}
+	return 0, nil
+}
+
+type fileWalk struct {
+	msg   *apc.LsoMsg
+	lst   *cmn.LsoResult
+	root  string // bucket's directory (symlinks resolved)
+	token string // list names greater than
+}
+
+func (w *fileWalk) full() bool { return uint(len(w.lst.Entries)) >= w.msg.PageSize }
+
+// `prefix` is the object name of the directory (with trailing slash), or empty for the root
+func (w *fileWalk) walk(dir, prefix string) error {
+	dentries, err := os.ReadDir(dir)
+	if err != nil {
+		if prefix != "" && os.IsNotExist(err) {
+			return nil // removed in the meantime
+		}
+		return err
+	}
+	// (a directory sorts as its name with trailing slash)
+	names := make([]string, len(dentries))
+	for i, dent := range dentries {
+		names[i] = prefix + dent.Name()
+		if dent.IsDir() {
+			names[i] += "/"
+		}
+	}
+	sort.Sort(&fileDentries{dentries, names})
+
+	for i, dent := range dentries {
+		if w.full() {
+			break
+		}
+		name := names[i]
+		if dent.IsDir() {
+			if !cmn.DirHasOrIsPrefix(name, w.msg.Prefix) {
+				continue
+			}
+			if name <= w.token && !strings.HasPrefix(w.token, name) {
+				continue // listed in the previous pages
+			}
+			if err := w.walk(filepath.Join(dir, dent.Name()), name); err != nil {
+				return err
+			}
+			continue
+		}
+		if !cmn.ObjHasPrefix(name, w.msg.Prefix) || name <= w.token || strings.Contains(name, fileTmpInfix) {
+			continue
+		}
+		fpath := filepath.Join(dir, dent.Name())
+		if dent.Type()&os.ModeSymlink != 0 {
+			if resolved, err := filepath.EvalSymlinks(fpath); err != nil || !fileInDir(resolved, w.root) {
+				continue // dangling or pointing outside the bucket's directory
+			}
+		}
+		fi, err := os.Stat(fpath) // (following symlinks)
+		if err != nil || !fi.Mode().IsRegular() {
+			continue
+		}
+		entry := &cmn.LsoEntry{Name: name, Size: fi.Size()}
+		if w.msg.WantProp(apc.GetPropsVersion) {
+			entry.Version = fileVersion(fi)
+		}
+		if w.msg.WantProp(apc.GetPropsCustom) {
+			entry.Custom = cmn.CustomMD2S(cos.StrKVs{cmn.LastModified: fmtTime(fi.ModTime())})
+		}
+		w.lst.Entries = append(w.lst.Entries, entry)
+	}
+	return nil
+}
+
+type fileDentries struct {
+	dentries []os.DirEntry
+	names    []string
+}
+
+func (d *fileDentries) Len() int           { return len(d.names) }
+func (d *fileDentries) Less(i, j int) bool { return d.names[i] < d.names[j] }
+func (d *fileDentries) Swap(i, j int) {
+	d.names[i], d.names[j] = d.names[j], d.names[i]
+	d.dentries[i], d.dentries[j] = d.dentries[j], d.dentries[i]
+}
+
+//////////////////
+// LIST BUCKETS //
+//////////////////
+
+func (*fileProvider) ListBuckets(cmn.QueryBcks) (bcks cmn.Bcks, errCode int, err error) {
+	debug.Assert(false) // (file buckets are listed from BMD)
+	return
+}
+
+/////////////////
+// HEAD OBJECT //
+/////////////////
+
+func (*fileProvider) HeadObj(_ ctx, lom *cluster.LOM) (oa *cmn.ObjAttrs, errCode int, err error) {
+	var (
+		fi    os.FileInfo
+		fpath string
+	)
+	if fpath, err = filePath(lom.Bck(), lom.ObjName); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	if fi, err = os.Stat(fpath); err != nil {
+		errCode, err = fileErrorToAISError(err)
+		return
+	}
+	if !fi.Mode().IsRegular() {
+		return nil, http.StatusNotFound, cos.NewErrNotFound("%s: %q is not a regular file", lom, fpath)
+	}
+	oa = &cmn.ObjAttrs{Size: fi.Size(), Ver: fileVersion(fi), Atime: fi.ModTime().UnixNano()}
+	oa.SetCustomKey(cmn.SourceObjMD, apc.File)
+	oa.SetCustomKey(cmn.LastModified, fmtTime(fi.ModTime()))
+	if verbose {
+		glog.Infof("[head_object] %s", lom)
+	}
+	return
+}
+
+////////////////
+// GET OBJECT //
+////////////////
+
+func (fp *fileProvider) GetObj(ctx context.Context, lom *cluster.LOM, owt cmn.OWT) (errCode int, err error) {
+	r, _, errCode, err := fp.GetObjReader(ctx, lom)
+	if err != nil {
+		return
+	}
+	params := cluster.AllocPutObjParams()
+	{
+		params.WorkTag = fs.WorkfileColdget
+		params.Reader = r
+		params.OWT = owt
+		params.Atime = time.Now()
+	}
+	err = fp.t.PutObject(lom, params)
+	if verbose {
+		glog.Infof("[get_object] %s: %v", lom, err)
+	}
+	return
+}
+
+////////////////////
+// GET OBJ READER //
+////////////////////
+
+func (*fileProvider) GetObjReader(ctx context.Context, lom *cluster.LOM) (r io.ReadCloser, expCksum *cos.Cksum,
+	errCode int, err error) {
+	var (
+		fh    *os.File
+		fi    os.FileInfo
+		fpath string
+	)
+	if fpath, err = filePath(lom.Bck(), lom.ObjName); err != nil {
+		return nil, nil, http.StatusBadRequest, err
+	}
+	if fh, err = os.Open(fpath); err != nil {
+		errCode, err = fileErrorToAISError(err)
+		return
+	}
+	if fi, err = fh.Stat(); err != nil || !fi.Mode().IsRegular() {
+		fh.Close()
+		if err == nil {
+			err = cos.NewErrNotFound("%s: %q is not a regular file", lom, fpath)
+		}
+		errCode, err = fileErrorToAISError(err)
+		return
+	}
+	lom.SetCustomKey(cmn.SourceObjMD, apc.File)
+	lom.SetCustomKey(cmn.LastModified, fmtTime(fi.ModTime()))
+	lom.SetVersion(fileVersion(fi))
+	setSize(ctx, fi.Size())
+	return wrapReader(ctx, fh), nil, 0, nil
+}
+
+// GetObjRange implements cluster.RangeReader
+func (*fileProvider) GetObjRange(_ ctx, lom *cluster.LOM, oa *cmn.ObjAttrs, offset, length int64) (r io.ReadCloser,
+	errCode int, err error) {
+	var (
+		fh    *os.File
+		fi    os.FileInfo
+		fpath string
+	)
+	if fpath, err = filePath(lom.Bck(), lom.ObjName); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	if fh, err = os.Open(fpath); err != nil {
+		errCode, err = fileErrorToAISError(err)
+		return
+	}
+	if fi, err = fh.Stat(); err != nil {
+		fh.Close()
+		errCode, err = fileErrorToAISError(err)
+		return
+	}
+	if v := fileVersion(fi); v != oa.Ver {
+		fh.Close()
+		return nil, http.StatusPreconditionFailed, fmt.Errorf("%s: file %q has changed (version %q, expected %q)",
+			lom, fpath, v, oa.Ver)
+	}
+	return &fileSection{io.NewSectionReader(fh, offset, length), fh}, 0, nil
+}
+
+func (s *fileSection) Close() error { return s.fh.Close() }
+
+////////////////
+// PUT OBJECT //
+////////////////
+
+// write through: write a temp file next to the destination and rename
+func (*fileProvider) PutObj(r io.ReadCloser, lom *cluster.LOM) (errCode int, err error) {
+	var (
+		fh    *os.File
+		fi    os.FileInfo
+		fpath string
+	)
+	defer cos.Close(r)
+	if fpath, err = filePath(lom.Bck(), lom.ObjName); err != nil {
+		return http.StatusBadRequest, err
+	}
+	tmp := filepath.Join(filepath.Dir(fpath), "."+filepath.Base(fpath)+fileTmpInfix+cos.GenTie())
+	if fh, err = cos.CreateFile(tmp); err != nil {
+		return fileErrorToAISError(err)
+	}
+	if _, err = io.Copy(fh, r); err == nil {
+		err = fh.Sync()
+	}
+	if errC := fh.Close(); err == nil {
+		err = errC
+	}
+	if err == nil {
+		if err = os.Rename(tmp, fpath); err == nil {
+			fi, err = os.Stat(fpath)
+		}
+	}
+	if err != nil {
+		if errRm := cos.RemoveFile(tmp); errRm != nil {
+			glog.Errorf("failed to remove %q: %v", tmp, errRm)
+		}
+		return fileErrorToAISError(err)
+	}
+	lom.SetCustomKey(cmn.SourceObjMD, apc.File)
+	lom.SetCustomKey(cmn.LastModified, fmtTime(fi.ModTime()))
+	lom.SetVersion(fileVersion(fi))
+	if verbose {
+		glog.Infof("[put_object] %s", lom)
+	}
+	return 0, nil
+}
+
+///////////////////
+// DELETE OBJECT //
+///////////////////
+
+func (*fileProvider) DeleteObj(lom *cluster.LOM) (errCode int, err error) {
+	var fpath string
+	if fpath, err = filePath(lom.Bck(), lom.ObjName); err != nil {
+		return http.StatusBadRequest, err
+	}
+	if err = os.Remove(fpath); err != nil {
+		return fileErrorToAISError(err)
+	}
+	if verbose {
+		glog.Infof("[delete_object] %s", lom)
+	}
+	return 0, nil
+}
@@ -0,0 +1,139 @@
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestFileListObjects(t *testing.T) {
+	var (
+		dir   = t.TempDir()
+		names = []string{"a.txt", "a.b", "a/b", "a/c/d", "a-z", "b/x", "b/y/z", "c", "d/.e"}
+		bck   = &meta.Bck{Name: "test", Provider: apc.File, Props: &cmn.BucketProps{
+			Extra: cmn.ExtraProps{File: cmn.ExtraPropsFile{RefDirectory: dir}},
+		}}
+		fp = &fileProvider{}
+	)
+	testFileRoots(t, dir)
+	for _, name := range names {
+		fqn := filepath.Join(dir, name)
+		tassert.CheckFatal(t, os.MkdirAll(filepath.Dir(fqn), 0o755))
+		tassert.CheckFatal(t, os.WriteFile(fqn, []byte(name), 0o644))
+	}
+	tassert.CheckFatal(t, os.WriteFile(filepath.Join(dir, "b", ".x"+fileTmpInfix+"123"), nil, 0o644))
+
+	expected := []string{"a-z", "a.b", "a.txt", "a/b", "a/c/d", "b/x", "b/y/z", "c", "d/.e"}
+	tassert.Fatalf(t, sort.StringsAreSorted(expected), "test: expecting sorted names")
+
+	tests := []struct {
+		prefix   string
+		pageSize uint
+		expected []string
+	}{
+		{"", 0, expected},
+		{"", 1, expected},
+		{"", 2, expected},
+		{"", 4, expected},
+		{"a/", 1, []string{"a/b", "a/c/d"}},
+		{"a", 2, []string{"a-z", "a.b", "a.txt", "a/b", "a/c/d"}},
+		{"b/y", 0, []string{"b/y/z"}},
+		{"x", 0, nil},
+	}
+	for _, test := range tests {
+		var (
+			listed []string
+			msg    = &apc.LsoMsg{Prefix: test.prefix}
+		)
+		for {
+			lst := &cmn.LsoResult{}
+			msg.PageSize = test.pageSize
+			_, err := fp.ListObjects(bck, msg, lst)
+			tassert.CheckFatal(t, err)
+			for _, en := range lst.Entries {
+				listed = append(listed, en.Name)
+			}
+			if lst.ContinuationToken == "" {
+				break
+			}
+			msg.ContinuationToken = lst.ContinuationToken
+		}
+		tassert.Errorf(t, len(listed) == len(test.expected), "prefix %q, page %d: expected %v, got %v",
+			test.prefix, test.pageSize, test.expected, listed)
+		for i := 0; i < len(listed) && i < len(test.expected); i++ {
+			tassert.Errorf(t, listed[i] == test.expected[i], "prefix %q, page %d: expected %v, got %v",
+				test.prefix, test.pageSize, test.expected, listed)
+		}
+	}
+
+	for _, name := range []string{"", "..", "../x", "a/../../x"} {
+		_, err := filePath(bck, name)
+		tassert.Errorf(t, err != nil, "expected error for object name %q", name)
+	}
+}
+
+func testFileRoots(t *testing.T, roots ...string) {
+	config := cmn.GCO.BeginUpdate()
+	config.Backend.File.Roots = roots
+	cmn.GCO.CommitUpdate(config)
+	t.Cleanup(func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Backend.File.Roots = nil
+		cmn.GCO.CommitUpdate(config)
+	})
+}
+
+func TestFileSymlinksAndRoots(t *testing.T) {
+	var (
+		root    = t.TempDir()
+		dir     = filepath.Join(root, "bucket")
+		outside = t.TempDir()
+		bck     = &meta.Bck{Name: "test", Provider: apc.File, Props: &cmn.BucketProps{
+			Extra: cmn.ExtraProps{File: cmn.ExtraPropsFile{RefDirectory: dir}},
+		}}
+		fp = &fileProvider{}
+	)
+	tassert.CheckFatal(t, os.MkdirAll(filepath.Join(dir, "sub"), 0o755))
+	tassert.CheckFatal(t, os.WriteFile(filepath.Join(dir, "sub", "a"), []byte("a"), 0o644))
+	tassert.CheckFatal(t, os.WriteFile(filepath.Join(outside, "secret"), []byte("secret"), 0o644))
+	tassert.CheckFatal(t, os.Symlink(filepath.Join(dir, "sub", "a"), filepath.Join(dir, "in")))
+	tassert.CheckFatal(t, os.Symlink(filepath.Join(outside, "secret"), filepath.Join(dir, "out")))
+	tassert.CheckFatal(t, os.Symlink(outside, filepath.Join(dir, "outdir")))
+
+	// not under any of the allowed roots
+	testFileRoots(t)
+	_, err := filePath(bck, "sub/a")
+	tassert.Errorf(t, err != nil, "expected error: no allowed roots")
+	testFileRoots(t, outside)
+	_, err = filePath(bck, "sub/a")
+	tassert.Errorf(t, err != nil, "expected error: %q is not under %q", dir, outside)
+
+	testFileRoots(t, root)
+	for _, name := range []string{"sub/a", "in", "sub/new", "new/x"} {
+		_, err := filePath(bck, name)
+		tassert.Errorf(t, err == nil, "object name %q: %v", name, err)
+	}
+	for _, name := range []string{"out", "outdir/secret", "outdir/new"} {
+		_, err := filePath(bck, name)
+		tassert.Errorf(t, err != nil, "expected error for object name %q (resolves outside)", name)
+	}
+
+	lst := &cmn.LsoResult{}
+	_, err = fp.ListObjects(bck, &apc.LsoMsg{}, lst)
+	tassert.CheckFatal(t, err)
+	var listed []string
+	for _, en := range lst.Entries {
+		listed = append(listed, en.Name)
+	}
+	tassert.Errorf(t, len(listed) == 2 && listed[0] == "in" && listed[1] == "sub/a", "expected [in sub/a], got %v", listed)
+}
@@ -13,6 +13,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -59,6 +60,7 @@ var (
 
 	// interface guard
 	_ cluster.BackendProvider = (*gcpProvider)(nil)
+	_ cluster.RangeReader     = (*gcpProvider)(nil)
 )
 
 func NewGCP(t cluster.TargetPut) (bp cluster.BackendProvider, err error) {
@@ -173,13 +175,21 @@ func (gcpp *gcpProvider) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.Ls
 		pager = iterator.NewPager(it, int(msg.PageSize), msg.ContinuationToken)
 		objs  = make([]*storage.ObjectAttrs, 0, msg.PageSize)
 	)
-	nextPageToken, errPage := pager.NextPage(&objs)
-	if errPage != nil {
-		errCode, err = gcpErrorToAISError(errPage, cloudBck)
+	var nextPageToken string
+	errCode, err = throttled(gctx, cloudBck, func() (int, error) {
+		var errPage error
+		nextPageToken, errPage = pager.NextPage(&objs)
+		if errPage != nil {
+			return gcpErrorToAISError(errPage, cloudBck)
+		}
+		return 0, nil
+	})
+	if err != nil {
 		return
 	}
 
 	lst.ContinuationToken = nextPageToken
+	objs = gcpSkipMpuTmp(objs) // (multipart uploads in progress - see gcp_mpu.go)
 
 	l := len(objs)
 	for i := len(lst.Entries); i < l; i++ {
@@ -256,9 +266,14 @@ func (*gcpProvider) HeadObj(ctx context.Context, lom *cluster.LOM) (oa *cmn.ObjA
 		h        = cmn.BackendHelpers.Google
 		cloudBck = lom.Bck().RemoteBck()
 	)
-	attrs, err = gcpClient.Bucket(cloudBck.Name).Object(lom.ObjName).Attrs(ctx)
+	errCode, err = throttled(ctx, cloudBck, func() (int, error) {
+		attrs, err = gcpClient.Bucket(cloudBck.Name).Object(lom.ObjName).Attrs(ctx)
+		if err != nil {
+			return handleObjectError(ctx, gcpClient, err, cloudBck)
+		}
+		return 0, nil
+	})
 	if err != nil {
-		errCode, err = handleObjectError(ctx, gcpClient, err, cloudBck)
 		return
 	}
 	oa = &cmn.ObjAttrs{}
@@ -326,12 +341,17 @@ func (*gcpProvider) GetObjReader(ctx context.Context, lom *cluster.LOM) (r io.Re
 		cloudBck = lom.Bck().RemoteBck()
 		o        = gcpClient.Bucket(cloudBck.Name).Object(lom.ObjName)
 	)
-	attrs, err = o.Attrs(ctx)
-	if err != nil {
-		errCode, err = gcpErrorToAISError(err, cloudBck)
-		return
-	}
-	rc, err = o.NewReader(ctx)
+	errCode, err = throttled(ctx, cloudBck, func() (int, error) {
+		attrs, err = o.Attrs(ctx)
+		if err != nil {
+			return gcpErrorToAISError(err, cloudBck)
+		}
+		rc, err = o.NewReader(ctx)
+		if err != nil {
+			return gcpErrorToAISError(err, cloudBck)
+		}
+		return 0, nil
+	})
 	if err != nil {
 		return
 	}
@@ -349,6 +369,30 @@ func (*gcpProvider) GetObjReader(ctx context.Context, lom *cluster.LOM) (r io.Re
 	return
 }
 
+// GetObjRange reads a single byte range of the specified (via `oa`) generation of the object
+func (*gcpProvider) GetObjRange(ctx context.Context, lom *cluster.LOM, oa *cmn.ObjAttrs, offset, length int64) (r io.ReadCloser,
+	errCode int, err error) {
+	var (
+		cloudBck = lom.Bck().RemoteBck()
+		o        = gcpClient.Bucket(cloudBck.Name).Object(lom.ObjName)
+	)
+	if v, ok := oa.GetCustomKey(cmn.VersionObjMD); ok {
+		gen, errV := strconv.ParseInt(v, 10, 64)
+		if errV != nil {
+			return nil, http.StatusBadRequest, fmt.Errorf("invalid generation %q: %v", v, errV)
+		}
+		o = o.Generation(gen)
+	}
+	errCode, err = throttled(ctx, cloudBck, func() (int, error) {
+		r, err = o.NewRangeReader(ctx, offset, length)
+		if err != nil {
+			return gcpErrorToAISError(err, cloudBck)
+		}
+		return 0, nil
+	})
+	return
+}
+
 func setCustomGs(lom *cluster.LOM, attrs *storage.ObjectAttrs) (expCksum *cos.Cksum) {
 	h := cmn.BackendHelpers.Google
 	if v, ok := h.EncodeVersion(attrs.Generation); ok {
@@ -377,6 +421,14 @@ func setCustomGs(lom *cluster.LOM, attrs *storage.ObjectAttrs) (expCksum *cos.Ck
 ////////////////
 
 func (gcpp *gcpProvider) PutObj(r io.ReadCloser, lom *cluster.LOM) (errCode int, err error) {
+	if ra, conf, ok := mpuEnabled(r, lom); ok {
+		errCode, err = mpuPut(gcpp, ra, lom, conf)
+		cos.Close(r)
+		if err == nil && verbose {
+			glog.Infof("[put_object] %s (multipart)", lom)
+		}
+		return
+	}
 	var (
 		attrs    *storage.ObjectAttrs
 		written  int64
@@ -386,6 +438,7 @@ func (gcpp *gcpProvider) PutObj(r io.ReadCloser, lom *cluster.LOM) (errCode int,
 		wc       = gcpObj.NewWriter(gctx)
 	)
 	md[gcpChecksumType], md[gcpChecksumVal] = lom.Checksum().Get()
+	rateLimit(cloudBck)
 
 	wc.Metadata = md
 	buf, slab := gcpp.t.PageMM().Alloc()
@@ -420,8 +473,13 @@ func (*gcpProvider) DeleteObj(lom *cluster.LOM) (errCode int, err error) {
 		cloudBck = lom.Bck().RemoteBck()
 		o        = gcpClient.Bucket(cloudBck.Name).Object(lom.ObjName)
 	)
-	if err = o.Delete(gctx); err != nil {
-		errCode, err = handleObjectError(gctx, gcpClient, err, cloudBck)
+	errCode, err = throttled(gctx, cloudBck, func() (int, error) {
+		if err = o.Delete(gctx); err != nil {
+			return handleObjectError(gctx, gcpClient, err, cloudBck)
+		}
+		return 0, nil
+	})
+	if err != nil {
 		return
 	}
 	if verbose {
@@ -463,6 +521,9 @@ func gcpErrorToAISError(gcpError error, bck *cmn.Bck) (int, error) {
 
 func handleObjectError(ctx context.Context, gcpClient *storage.Client, objErr error, bck *cmn.Bck) (int, error) {
 	if objErr != storage.ErrObjectNotExist {
+		if apiErr, ok := objErr.(*googleapi.Error); ok {
+			return apiErr.Code, objErr // e.g., 429 (see throttled)
+		}
 		return http.StatusBadRequest, objErr
 	}
 
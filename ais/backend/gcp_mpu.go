@@ -0,0 +1,146 @@
+//go:build gcp
+
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+
+	"cloud.google.com/go/storage"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"google.golang.org/api/iterator"
+)
+
+// GCS multipart upload (see mpu.go): parts are uploaded as temporary objects
+// that are then composed into the destination object and deleted.
+// NOTE: while the upload is in progress (or remains abandoned - see MpuCleanup)
+// the temporary objects are visible to other GCS clients; list-objects skips them.
+
+const gcpMaxCompose = 32 // max number of sources in a single compose request
+
+// interface guard
+var _ mpuProvider = (*gcpProvider)(nil)
+
+// temporary object names: <object>.ais-mpu.<upload ID>.[c<level>.]<number>
+var gcpMpuTmpRegex = regexp.MustCompile(`\.ais-mpu\.[0-9a-f]{16}\.(c[0-9]+\.)?[0-9]{5}$`)
+
+func gcpMpuPrefix(objName, uploadID string) string { return objName + ".ais-mpu." + uploadID + "." }
+
+func gcpIsMpuTmp(name string) bool { return gcpMpuTmpRegex.MatchString(name) }
+
+// (in place)
+func gcpSkipMpuTmp(objs []*storage.ObjectAttrs) []*storage.ObjectAttrs {
+	out := objs[:0]
+	for _, attrs := range objs {
+		if !gcpIsMpuTmp(attrs.Name) {
+			out = append(out, attrs)
+		}
+	}
+	return out
+}
+
+func (*gcpProvider) mpuStart(*cluster.LOM) (string, int, error) { return mpuGenID(), 0, nil }
+
+func (gcpp *gcpProvider) mpuPart(lom *cluster.LOM, uploadID string, num int, r *io.SectionReader) (etag string,
+	errCode int, err error) {
+	var (
+		cloudBck    = lom.Bck().RemoteBck()
+		name        = gcpMpuPrefix(lom.ObjName, uploadID) + fmt.Sprintf("%05d", num)
+		ctx, cancel = context.WithCancel(gctx)
+		wc          = gcpClient.Bucket(cloudBck.Name).Object(name).NewWriter(ctx)
+	)
+	defer cancel()
+	buf, slab := gcpp.t.PageMM().Alloc()
+	_, err = io.CopyBuffer(wc, r, buf)
+	slab.Free(buf)
+	if err != nil {
+		cancel() // (discard partially written part)
+		wc.Close()
+		return
+	}
+	if err = wc.Close(); err != nil {
+		errCode, err = gcpErrorToAISError(err, cloudBck)
+		return
+	}
+	return strconv.FormatInt(wc.Attrs().Generation, 10), 0, nil
+}
+
+func (*gcpProvider) mpuComplete(lom *cluster.LOM, uploadID string, parts []mpuPart) (errCode int, err error) {
+	var (
+		attrs    *storage.ObjectAttrs
+		cloudBck = lom.Bck().RemoteBck()
+		bh       = gcpClient.Bucket(cloudBck.Name)
+		prefix   = gcpMpuPrefix(lom.ObjName, uploadID)
+		srcs     = make([]*storage.ObjectHandle, 0, len(parts))
+	)
+	for _, p := range parts {
+		gen, errV := strconv.ParseInt(p.ETag, 10, 64)
+		if errV != nil {
+			return 0, fmt.Errorf("%s: invalid generation %q of part %d: %v", lom, p.ETag, p.Num, errV)
+		}
+		srcs = append(srcs, bh.Object(prefix+fmt.Sprintf("%05d", p.Num)).Generation(gen))
+	}
+	// compose intermediate objects, as many levels as needed
+	for level := 0; len(srcs) > gcpMaxCompose; level++ {
+		next := make([]*storage.ObjectHandle, 0, (len(srcs)+gcpMaxCompose-1)/gcpMaxCompose)
+		for i := 0; i < len(srcs); i += gcpMaxCompose {
+			j := i + gcpMaxCompose
+			if j > len(srcs) {
+				j = len(srcs)
+			}
+			dst := bh.Object(fmt.Sprintf("%sc%d.%05d", prefix, level, i/gcpMaxCompose))
+			if attrs, err = dst.ComposerFrom(srcs[i:j]...).Run(gctx); err != nil {
+				errCode, err = gcpErrorToAISError(err, cloudBck)
+				return
+			}
+			next = append(next, dst.Generation(attrs.Generation))
+		}
+		srcs = next
+	}
+	composer := bh.Object(lom.ObjName).ComposerFrom(srcs...)
+	composer.Metadata = make(cos.StrKVs, 2)
+	composer.Metadata[gcpChecksumType], composer.Metadata[gcpChecksumVal] = lom.Checksum().Get()
+	if attrs, err = composer.Run(gctx); err != nil {
+		errCode, err = gcpErrorToAISError(err, cloudBck)
+		return
+	}
+	_ = setCustomGs(lom, attrs)
+
+	// cleanup (best effort)
+	gcpMpuDelete(cloudBck, prefix)
+	return
+}
+
+func (*gcpProvider) mpuAbort(bck *cmn.Bck, objName, uploadID string) (int, error) {
+	return gcpMpuDelete(bck, gcpMpuPrefix(objName, uploadID))
+}
+
+// delete temporary objects: uploaded parts and intermediate composites
+func gcpMpuDelete(bck *cmn.Bck, prefix string) (errCode int, err error) {
+	var (
+		bh = gcpClient.Bucket(bck.Name)
+		it = bh.Objects(gctx, &storage.Query{Prefix: prefix})
+	)
+	for {
+		attrs, errN := it.Next()
+		if errN == iterator.Done {
+			break
+		}
+		if errN != nil {
+			return gcpErrorToAISError(errN, bck)
+		}
+		if errD := bh.Object(attrs.Name).Delete(gctx); errD != nil && err == nil {
+			errCode, err = gcpErrorToAISError(errD, bck)
+		}
+	}
+	return
+}
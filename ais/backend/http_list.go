@@ -0,0 +1,297 @@
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Listing ht:// buckets (see cmn.ExtraPropsHTTP.Listing)
+//
+// The entire result set is fetched (and, in the autoindex case, crawled) when listing
+// starts, and is then cached for subsequent pages.
+
+const (
+	httpListTTL        = 10 * time.Minute // max age of the cached result set
+	httpListMaxDepth   = 32               // autoindex: max directory depth
+	httpListMaxEntries = 4 * 1024 * 1024
+	httpListMaxBody    = 256 * cos.MiB // max size of a manifest or an index page
+)
+
+type (
+	httpListEntry struct {
+		Name string `json:"name"`
+		Size int64  `json:"size,omitempty"`
+	}
+	httpList struct {
+		added   time.Time
+		entries []httpListEntry // sorted by name
+	}
+	httpCrawl struct {
+		hp      *httpProvider
+		prefix  string
+		entries []httpListEntry
+	}
+)
+
+var (
+	httpLists   = make(map[string]*httpList) // (bucket, prefix) => result set
+	httpListsMu sync.Mutex
+
+	// <a href="...">
+	httpHrefRe = regexp.MustCompile(`(?i)<a\s[^>]*?href\s*=\s*["']([^"']+)["']`)
+)
+
+func (hp *httpProvider) ListObjects(bck *meta.Bck, msg *apc.LsoMsg, lst *cmn.LsoResult) (errCode int, err error) {
+	var (
+		hl    *httpList
+		extra = &bck.Props.Extra.HTTP
+		key   = bck.MakeUname(msg.Prefix)
+		token = msg.ContinuationToken
+	)
+	if !extra.Listable() {
+		return http.StatusBadRequest, cmn.NewErrUnsupp("list", bck.String()+" (hint: see 'extra.http.listing')")
+	}
+	msg.PageSize = calcPageSize(msg.PageSize, hp.MaxPageSize())
+	if msg.StartAfter > token {
+		token = msg.StartAfter
+	}
+
+	// first page always starts from scratch
+	httpListsMu.Lock()
+	if hl = httpLists[key]; hl != nil && (msg.ContinuationToken == "" || time.Since(hl.added) > httpListTTL) {
+		hl = nil
+	}
+	httpListsMu.Unlock()
+	if hl == nil {
+		hl = &httpList{added: time.Now()}
+		if hl.entries, errCode, err = hp.list(extra, msg.Prefix); err != nil {
+			return
+		}
+		httpListsMu.Lock()
+		httpLists[key] = hl
+		for k, v := range httpLists {
+			if time.Since(v.added) > httpListTTL {
+				delete(httpLists, k)
+			}
+		}
+		httpListsMu.Unlock()
+	}
+
+	var (
+		entries = hl.entries
+		i       = sort.Search(len(entries), func(i int) bool { return entries[i].Name > token })
+	)
+	lst.Entries = lst.Entries[:0]
+	for ; i < len(entries) && uint(len(lst.Entries)) < msg.PageSize; i++ {
+		lst.Entries = append(lst.Entries, &cmn.LsoEntry{Name: entries[i].Name, Size: entries[i].Size})
+	}
+	if i < len(entries) {
+		lst.ContinuationToken = entries[i-1].Name
+	}
+	if verbose {
+		glog.Infof("[list_objects] %s: count %d", bck, len(lst.Entries))
+	}
+	return
+}
+
+func (hp *httpProvider) list(extra *cmn.ExtraPropsHTTP, prefix string) ([]httpListEntry, int, error) {
+	var (
+		entries []httpListEntry
+		base    = extra.OrigURLBck
+	)
+	if !strings.HasSuffix(base, "/") {
+		base += "/"
+	}
+	if extra.Listing == cmn.HTTPListAutoindex {
+		c := &httpCrawl{hp: hp, prefix: prefix}
+		if code, err := c.crawl(base, "", 0); err != nil {
+			return nil, code, err
+		}
+		entries = c.entries
+	} else {
+		var (
+			code int
+			err  error
+		)
+		if entries, code, err = hp.manifest(base, extra.Listing); err != nil {
+			return nil, code, err
+		}
+		all := entries
+		entries = entries[:0]
+		for _, en := range all {
+			if cmn.ObjHasPrefix(en.Name, prefix) {
+				entries = append(entries, en)
+			}
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+	// dedup
+	if len(entries) > 1 {
+		j := 1
+		for i := 1; i < len(entries); i++ {
+			if entries[i].Name != entries[j-1].Name {
+				entries[j] = entries[i]
+				j++
+			}
+		}
+		entries = entries[:j]
+	}
+	return entries, 0, nil
+}
+
+func (hp *httpProvider) get(u string) ([]byte, int, error) {
+	resp, err := hp.client(u).Get(u)
+	if err != nil {
+		return nil, http.StatusBadGateway, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, resp.StatusCode, fmt.Errorf("GET(%s) failed, status %d", u, resp.StatusCode)
+	}
+	b, err := io.ReadAll(io.LimitReader(resp.Body, httpListMaxBody+1))
+	if err == nil && len(b) > httpListMaxBody {
+		err = fmt.Errorf("GET(%s): size exceeds %s", u, cos.ToSizeIEC(httpListMaxBody, 0))
+	}
+	if err != nil {
+		return nil, http.StatusBadGateway, err
+	}
+	return b, 0, nil
+}
+
+//
+// manifest
+//
+
+func (hp *httpProvider) manifest(base, listing string) (entries []httpListEntry, errCode int, err error) {
+	var (
+		b []byte
+		u = listing
+	)
+	if !strings.Contains(u, "://") {
+		u = base + strings.TrimPrefix(u, "/")
+	}
+	if b, errCode, err = hp.get(u); err != nil {
+		return
+	}
+	if b = bytes.TrimSpace(b); len(b) > 0 && b[0] == '[' {
+		// JSON: array of relative URLs or array of {"name": ..., "size": ...}
+		var names []string
+		if errN := jsoniter.Unmarshal(b, &names); errN == nil {
+			entries = make([]httpListEntry, 0, len(names))
+			for _, name := range names {
+				entries = append(entries, httpListEntry{Name: name})
+			}
+		} else if err = jsoniter.Unmarshal(b, &entries); err != nil {
+			return nil, http.StatusBadRequest, fmt.Errorf("invalid manifest %q: %v", u, err)
+		}
+	} else {
+		// text: one relative URL (optionally, followed by size) per line; '#' comments
+		for _, line := range strings.Split(string(b), "\n") {
+			if line = strings.TrimSpace(line); line == "" || line[0] == '#' {
+				continue
+			}
+			var en httpListEntry
+			fields := strings.Fields(line)
+			en.Name = fields[0]
+			if len(fields) > 1 {
+				en.Size, _ = strconv.ParseInt(fields[1], 10, 64)
+			}
+			entries = append(entries, en)
+		}
+	}
+	if len(entries) > httpListMaxEntries {
+		return nil, http.StatusBadRequest, fmt.Errorf("manifest %q: too many entries (%d)", u, len(entries))
+	}
+	// normalize: relative to the original URL
+	all := entries
+	entries = entries[:0]
+	for _, en := range all {
+		if name, ok := httpObjName(base, en.Name); ok {
+			en.Name = name
+			entries = append(entries, en)
+		}
+	}
+	return
+}
+
+// (absolute, or relative to the base) URL => object name
+func httpObjName(base, s string) (string, bool) {
+	if strings.Contains(s, "://") {
+		if !strings.HasPrefix(s, base) {
+			return "", false // elsewhere
+		}
+		s = s[len(base):]
+	}
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "./"), "/")
+	if s == "" || strings.HasSuffix(s, "/") || strings.HasPrefix(s, "../") || strings.Contains(s, "/../") {
+		return "", false
+	}
+	return s, true
+}
+
+//
+// autoindex
+//
+
+// crawl the index page of the `dir` (relative to `base` and URL-escaped)
+func (c *httpCrawl) crawl(base, dir string, depth int) (int, error) {
+	b, code, err := c.hp.get(base + dir)
+	if err != nil {
+		return code, err
+	}
+	for _, m := range httpHrefRe.FindAllSubmatch(b, -1) {
+		href := html.UnescapeString(string(m[1]))
+		// skip sorting links ("?C=N;O=D"), parent, and anything that's not a child
+		if i := strings.IndexAny(href, "?#"); i >= 0 {
+			href = href[:i]
+		}
+		if href == "" || href == "./" || href == "../" || strings.HasPrefix(href, "/") || strings.Contains(href, "://") {
+			continue
+		}
+		child := strings.TrimSuffix(href, "/")
+		if child == "" || strings.Contains(child, "/") {
+			continue
+		}
+		name, err := url.PathUnescape(dir + href)
+		if err != nil {
+			continue
+		}
+		if strings.HasSuffix(href, "/") {
+			if depth+1 < httpListMaxDepth && cmn.DirHasOrIsPrefix(name, c.prefix) {
+				if code, err := c.crawl(base, dir+href, depth+1); err != nil {
+					return code, err
+				}
+			}
+			continue
+		}
+		if !cmn.ObjHasPrefix(name, c.prefix) {
+			continue
+		}
+		if len(c.entries) >= httpListMaxEntries {
+			return http.StatusBadRequest, fmt.Errorf("%s: too many entries (%d)", base, len(c.entries))
+		}
+		c.entries = append(c.entries, httpListEntry{Name: name})
+	}
+	return 0, nil
+}
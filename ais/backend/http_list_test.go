@@ -0,0 +1,106 @@
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// nginx-style autoindex pages
+var httpTestIndex = map[string]string{
+	"/data/": `<html><head><title>Index of /data/</title></head><body><pre>
+<a href="../">../</a>
+<a href="?C=N;O=D">Name</a>
+<a href="train/">train/</a>                 17-Oct-2023 10:00    -
+<a href="val/">val/</a>                     17-Oct-2023 10:00    -
+<a href="README.md">README.md</a>           17-Oct-2023 10:00    42
+<a href="/elsewhere/">elsewhere</a>
+<a href="https://example.com/x">x</a>
+</pre></body></html>`,
+	"/data/train/": `<a href="../">../</a>
+<a href="a%20b.tar">a b.tar</a>
+<a HREF='c.tar'>c.tar</a>
+<a href="sub/">sub/</a>`,
+	"/data/train/sub/": `<a href="d.tar">d.tar</a>`,
+	"/data/val/":       `<a href="e.tar">e.tar</a><a href="f.tar">f.tar</a>`,
+}
+
+func TestHTTPListObjects(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/data/manifest.txt":
+			fmt.Fprint(w, "# comment\nval/f.tar 10\n./train/c.tar\n\n/README.md\n"+
+				"http://"+r.Host+"/data/train/sub/d.tar\nhttp://example.com/data/z\n../x\nval/f.tar\n")
+		case "/data/manifest.json":
+			fmt.Fprint(w, `[{"name": "val/f.tar", "size": 10}, {"name": "train/c.tar"}]`)
+		default:
+			page, ok := httpTestIndex[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			fmt.Fprint(w, page)
+		}
+	}))
+	defer ts.Close()
+
+	hp := &httpProvider{httpClient: ts.Client(), httpsClient: ts.Client()}
+	tests := []struct {
+		listing  string
+		prefix   string
+		pageSize uint
+		expected []string
+	}{
+		{cmn.HTTPListAutoindex, "", 0,
+			[]string{"README.md", "train/a b.tar", "train/c.tar", "train/sub/d.tar", "val/e.tar", "val/f.tar"}},
+		{cmn.HTTPListAutoindex, "", 1,
+			[]string{"README.md", "train/a b.tar", "train/c.tar", "train/sub/d.tar", "val/e.tar", "val/f.tar"}},
+		{cmn.HTTPListAutoindex, "train/s", 2, []string{"train/sub/d.tar"}},
+		{cmn.HTTPListAutoindex, "v", 0, []string{"val/e.tar", "val/f.tar"}},
+		{"manifest.txt", "", 2, []string{"README.md", "train/c.tar", "train/sub/d.tar", "val/f.tar"}},
+		{ts.URL + "/data/manifest.txt", "train", 0, []string{"train/c.tar", "train/sub/d.tar"}},
+		{"manifest.json", "", 1, []string{"train/c.tar", "val/f.tar"}},
+	}
+	for _, test := range tests {
+		var (
+			listed []string
+			bck    = &meta.Bck{Name: "test", Provider: apc.HTTP, Props: &cmn.BucketProps{
+				Extra: cmn.ExtraProps{HTTP: cmn.ExtraPropsHTTP{OrigURLBck: ts.URL + "/data", Listing: test.listing}},
+			}}
+			msg = &apc.LsoMsg{Prefix: test.prefix}
+		)
+		for {
+			lst := &cmn.LsoResult{}
+			msg.PageSize = test.pageSize
+			_, err := hp.ListObjects(bck, msg, lst)
+			tassert.CheckFatal(t, err)
+			for _, en := range lst.Entries {
+				listed = append(listed, en.Name)
+			}
+			if lst.ContinuationToken == "" {
+				break
+			}
+			msg.ContinuationToken = lst.ContinuationToken
+		}
+		tassert.Errorf(t, strings.Join(listed, ",") == strings.Join(test.expected, ","),
+			"%q, prefix %q, page %d: expected %v, got %v", test.listing, test.prefix, test.pageSize, test.expected, listed)
+	}
+
+	// not listable
+	bck := &meta.Bck{Name: "test", Provider: apc.HTTP, Props: &cmn.BucketProps{
+		Extra: cmn.ExtraProps{HTTP: cmn.ExtraPropsHTTP{OrigURLBck: ts.URL + "/data"}},
+	}}
+	_, err := hp.ListObjects(bck, &apc.LsoMsg{}, &cmn.LsoResult{})
+	tassert.Errorf(t, err != nil, "expected error listing %s without 'extra.http.listing'", bck)
+}
@@ -0,0 +1,361 @@
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/OneOfOne/xxhash"
+)
+
+// Multipart upload (MPU) to remote backends
+//
+// Objects larger than `backend.multipart.part_size` (see cmn.BackendConfMPU) get uploaded
+// in parts by multiple concurrent workers, with each part retried up to `max_retries` times.
+// Provider-specific primitives (start, upload part, complete, abort) are implemented by:
+// - aws:   S3 multipart upload;
+// - gcp:   parts are uploaded as temporary objects that are then composed into one;
+// - azure: parts are (uncommitted) blocks of the block blob.
+//
+// Every upload in progress is journaled - a small jsp-formatted file under fname.BackendMpuDir
+// on the object's mountpath that gets updated upon each uploaded part. When the same content
+// (same size and checksum) gets uploaded again - e.g., when write-back retries after target
+// restart - the upload resumes, skipping already uploaded parts. Uploads that remain
+// incomplete for longer than `backend.multipart.abandoned_time` get aborted (see MpuCleanup).
+
+const (
+	mpuMaxParts = 10000 // (S3 limit)
+	mpuBackoff  = time.Second
+)
+
+type (
+	mpuProvider interface {
+		mpuStart(lom *cluster.LOM) (uploadID string, errCode int, err error)
+		mpuPart(lom *cluster.LOM, uploadID string, num int, r *io.SectionReader) (etag string, errCode int, err error)
+		mpuComplete(lom *cluster.LOM, uploadID string, parts []mpuPart) (errCode int, err error)
+		mpuAbort(bck *cmn.Bck, objName, uploadID string) (errCode int, err error)
+	}
+	mpuPart struct {
+		ETag string `json:"etag"`
+		Num  int    `json:"n"`
+	}
+	mpuRec struct {
+		Bck       cmn.Bck   `json:"bck"` // remote bucket
+		ObjName   string    `json:"name"`
+		UploadID  string    `json:"id"`
+		CksumType string    `json:"cksum_type"`
+		CksumVal  string    `json:"cksum_value"`
+		Parts     []mpuPart `json:"parts"`
+		Size      int64     `json:"size"`
+		PartSize  int64     `json:"part_size"`
+		Ctime     int64     `json:"ctime"`
+		path      string
+		mu        sync.Mutex
+	}
+	mpuUpload struct {
+		mp   mpuProvider
+		lom  *cluster.LOM
+		r    io.ReaderAt
+		rec  *mpuRec
+		conf *cmn.BackendConfMPU
+	}
+)
+
+var (
+	mpuBusy    sync.Map // journal record path => (upload in progress)
+	mpuJspOpts = jsp.CksumSign(cmn.MetaverMPU)
+)
+
+// returns true if the object is to be uploaded in parts (and `r` can be read in parallel)
+func mpuEnabled(r io.Reader, lom *cluster.LOM) (io.ReaderAt, *cmn.BackendConfMPU, bool) {
+	conf := &cmn.GCO.Get().Backend.MPU
+	if !conf.Enabled() || lom.SizeBytes(true) <= int64(conf.PartSize) {
+		return nil, nil, false
+	}
+	ra, ok := r.(io.ReaderAt)
+	return ra, conf, ok
+}
+
+// upload (or resume uploading) the object in parts
+func mpuPut(mp mpuProvider, ra io.ReaderAt, lom *cluster.LOM, conf *cmn.BackendConfMPU) (errCode int, err error) {
+	var (
+		u    = &mpuUpload{mp: mp, lom: lom, r: ra, conf: conf}
+		path = mpuPath(lom.Mountpath().Path, lom.Uname())
+	)
+	if _, busy := mpuBusy.LoadOrStore(path, u); busy {
+		// concurrent PUT of the same object - upload without journaling
+		u.rec = u.newRec("")
+		if errCode, err = u.start(); err == nil {
+			errCode, err = u.do()
+		}
+		if err != nil {
+			u.abort()
+		}
+		return
+	}
+	defer mpuBusy.Delete(path)
+
+	if u.rec = u.loadRec(path); u.rec == nil {
+		u.rec = u.newRec(path)
+		if errCode, err = u.start(); err != nil {
+			return
+		}
+		if err = u.rec.persist(); err != nil {
+			u.abort()
+			return http.StatusInternalServerError, err
+		}
+	} else if n := len(u.rec.Parts); n > 0 {
+		u.rec.Ctime = time.Now().UnixNano() // (resumed)
+		glog.Infof("%s: resuming multipart upload %q (%d part%s already uploaded)", lom, u.rec.UploadID, n, cos.Plural(n))
+	}
+	if errCode, err = u.do(); err == nil || errCode == http.StatusNotFound {
+		// done, or the upload does not exist anymore (e.g., aborted)
+		u.rec.remove()
+	}
+	return
+}
+
+func (u *mpuUpload) newRec(path string) *mpuRec {
+	var (
+		lom      = u.lom
+		size     = lom.SizeBytes(true)
+		partSize = int64(u.conf.PartSize)
+		rec      = &mpuRec{Bck: *lom.Bck().RemoteBck(), ObjName: lom.ObjName, Size: size, path: path}
+	)
+	if (size+partSize-1)/partSize > mpuMaxParts {
+		partSize = (size + mpuMaxParts - 1) / mpuMaxParts
+	}
+	rec.PartSize = partSize
+	rec.CksumType, rec.CksumVal = lom.Checksum().Get()
+	rec.Ctime = time.Now().UnixNano()
+	return rec
+}
+
+// load the journaled record if it describes the same upload (the same content);
+// otherwise, abort the old upload (if any)
+func (u *mpuUpload) loadRec(path string) *mpuRec {
+	var (
+		lom = u.lom
+		rec = &mpuRec{path: path}
+	)
+	if _, err := jsp.LoadMeta(path, rec); err != nil {
+		if !os.IsNotExist(err) {
+			glog.Errorf("%s: failed to load multipart upload record (removing): %v", lom, err)
+			rec.remove()
+		}
+		return nil
+	}
+	cksum := lom.Checksum()
+	if !cksum.IsEmpty() && rec.ObjName == lom.ObjName && rec.Bck.Equal(lom.Bck().RemoteBck()) &&
+		rec.Size == lom.SizeBytes(true) && rec.CksumType == cksum.Ty() && rec.CksumVal == cksum.Val() {
+		partSize := u.newRec("").PartSize
+		if rec.PartSize == partSize {
+			return rec
+		}
+	}
+	// content or configuration has changed
+	if _, err := u.mp.mpuAbort(&rec.Bck, rec.ObjName, rec.UploadID); err != nil {
+		glog.Warningf("%s: failed to abort stale multipart upload %q: %v", lom, rec.UploadID, err)
+	}
+	rec.remove()
+	return nil
+}
+
+func (u *mpuUpload) start() (errCode int, err error) {
+	u.rec.UploadID, errCode, err = u.mp.mpuStart(u.lom)
+	return
+}
+
+func (u *mpuUpload) abort() {
+	if _, err := u.mp.mpuAbort(&u.rec.Bck, u.rec.ObjName, u.rec.UploadID); err != nil {
+		glog.Warningf("%s: failed to abort multipart upload %q: %v", u.lom, u.rec.UploadID, err)
+	}
+}
+
+// upload remaining parts and complete
+func (u *mpuUpload) do() (errCode int, err error) {
+	var (
+		rec      = u.rec
+		numParts = int((rec.Size + rec.PartSize - 1) / rec.PartSize)
+		done     = make(map[int]bool, len(rec.Parts))
+		todo     = make(chan int, numParts)
+		wg       = &sync.WaitGroup{}
+		mu       = &sync.Mutex{}
+	)
+	for _, p := range rec.Parts {
+		done[p.Num] = true
+	}
+	for num := 1; num <= numParts; num++ {
+		if !done[num] {
+			todo <- num
+		}
+	}
+	close(todo)
+
+	numWorkers := u.conf.Workers()
+	if n := len(todo); n < numWorkers {
+		numWorkers = n
+	}
+	for i := 0; i < numWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for num := range todo {
+				mu.Lock()
+				failed := err != nil
+				mu.Unlock()
+				if failed {
+					return
+				}
+				code, errP := u.upload(num)
+				if errP == nil {
+					continue
+				}
+				mu.Lock()
+				if err == nil {
+					errCode, err = code, errP
+				}
+				mu.Unlock()
+				return
+			}
+		}()
+	}
+	wg.Wait()
+	if err != nil {
+		return
+	}
+
+	sort.Slice(rec.Parts, func(i, j int) bool { return rec.Parts[i].Num < rec.Parts[j].Num })
+	return u.mp.mpuComplete(u.lom, rec.UploadID, rec.Parts)
+}
+
+func (u *mpuUpload) upload(num int) (errCode int, err error) {
+	var (
+		etag string
+		rec  = u.rec
+		off  = int64(num-1) * rec.PartSize
+		size = rec.PartSize
+	)
+	if off+size > rec.Size {
+		size = rec.Size - off
+	}
+	for i, retries := 0, u.conf.Retries(); i < retries; i++ {
+		if i > 0 {
+			glog.Warningf("%s: failed to upload part %d of %q: %v(%d) - retrying...", u.lom, num, rec.UploadID, err, errCode)
+			time.Sleep(mpuBackoff * time.Duration(i))
+		}
+		etag, errCode, err = u.mp.mpuPart(u.lom, rec.UploadID, num, io.NewSectionReader(u.r, off, size))
+		if err == nil {
+			rec.add(mpuPart{Num: num, ETag: etag})
+			return
+		}
+		if errCode == http.StatusNotFound || errCode == http.StatusForbidden || errCode == http.StatusUnauthorized {
+			break
+		}
+	}
+	return
+}
+
+////////////
+// mpuRec //
+////////////
+
+func (*mpuRec) JspOpts() jsp.Options { return mpuJspOpts }
+
+func (rec *mpuRec) add(part mpuPart) {
+	rec.mu.Lock()
+	rec.Parts = append(rec.Parts, part)
+	if rec.path != "" {
+		if err := rec.persist(); err != nil {
+			glog.Errorf("failed to update multipart upload record %q: %v", rec.path, err)
+		}
+	}
+	rec.mu.Unlock()
+}
+
+func (rec *mpuRec) persist() error {
+	if err := cos.CreateDir(filepath.Dir(rec.path)); err != nil {
+		return err
+	}
+	return jsp.SaveMeta(rec.path, rec, nil /*wto*/)
+}
+
+func (rec *mpuRec) remove() {
+	if rec.path != "" {
+		if err := cos.RemoveFile(rec.path); err != nil {
+			glog.Errorf("failed to remove multipart upload record %q: %v", rec.path, err)
+		}
+	}
+}
+
+func mpuPath(mpath, uname string) string {
+	h := xxhash.Checksum64S(cos.UnsafeB(uname), cos.MLCG32)
+	return filepath.Join(mpath, fname.BackendMpuDir, strconv.FormatUint(h, 16))
+}
+
+//
+// abandoned uploads
+//
+
+// MpuCleanup aborts and removes multipart uploads that have been neither completed
+// nor resumed for longer than `maxAge`; returns the number of removed uploads
+func MpuCleanup(backends map[string]cluster.BackendProvider, maxAge time.Duration) (n int) {
+	now := time.Now()
+	for mpath := range fs.GetAvail() {
+		dir := filepath.Join(mpath, fname.BackendMpuDir)
+		dentries, err := os.ReadDir(dir)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				glog.Errorf("failed to read %q: %v", dir, err)
+			}
+			continue
+		}
+		for _, dent := range dentries {
+			if dent.IsDir() || strings.Contains(dent.Name(), ".tmp.") {
+				continue
+			}
+			rec := &mpuRec{path: filepath.Join(dir, dent.Name())}
+			if _, err := jsp.LoadMeta(rec.path, rec); err != nil {
+				glog.Errorf("failed to load multipart upload record %q (removing): %v", rec.path, err)
+				rec.remove()
+				continue
+			}
+			if now.Sub(time.Unix(0, rec.Ctime)) < maxAge {
+				continue
+			}
+			if _, busy := mpuBusy.Load(rec.path); busy {
+				continue
+			}
+			if mp, ok := backends[rec.Bck.Provider].(mpuProvider); ok {
+				if _, err := mp.mpuAbort(&rec.Bck, rec.ObjName, rec.UploadID); err != nil {
+					glog.Warningf("failed to abort multipart upload %s (%q): %v", rec.Bck.Cname(rec.ObjName),
+						rec.UploadID, err)
+				}
+			}
+			rec.remove()
+			n++
+		}
+	}
+	return
+}
+
+// GCP (see above) and Azure: client-generated upload ID
+func mpuGenID() string { return fmt.Sprintf("%016x", cos.NowRand().Uint64()) }
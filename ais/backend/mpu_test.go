@@ -0,0 +1,195 @@
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	cmock "github.com/NVIDIA/aistore/cluster/mock"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// in-memory multipart uploads; fails uploading part `failNum` with `failCode`
+type testMpu struct {
+	data     []byte
+	uploads  map[string]map[int][]byte // upload ID => parts
+	aborted  []string
+	attempts map[int]int // part number => num attempts
+	failNum  int
+	failCode int
+	started  int
+	mu       sync.Mutex
+}
+
+func newTestMpu(data []byte) *testMpu {
+	return &testMpu{data: data, uploads: make(map[string]map[int][]byte), attempts: make(map[int]int)}
+}
+
+func (mp *testMpu) mpuStart(*cluster.LOM) (string, int, error) {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+	mp.started++
+	id := "upload-" + strconv.Itoa(mp.started)
+	mp.uploads[id] = make(map[int][]byte)
+	return id, 0, nil
+}
+
+func (mp *testMpu) mpuPart(_ *cluster.LOM, uploadID string, num int, r *io.SectionReader) (string, int, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return "", 0, err
+	}
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+	mp.attempts[num]++
+	if num == mp.failNum {
+		return "", mp.failCode, errors.New("failed to upload part " + strconv.Itoa(num))
+	}
+	parts, ok := mp.uploads[uploadID]
+	if !ok {
+		return "", http.StatusNotFound, errors.New("no such upload " + uploadID)
+	}
+	parts[num] = b
+	return "etag-" + strconv.Itoa(num), 0, nil
+}
+
+func (mp *testMpu) mpuComplete(_ *cluster.LOM, uploadID string, parts []mpuPart) (int, error) {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+	uploaded := mp.uploads[uploadID]
+	var b []byte
+	for i, p := range parts {
+		if p.Num != i+1 || p.ETag != "etag-"+strconv.Itoa(p.Num) {
+			return 0, errors.New("invalid part " + strconv.Itoa(p.Num))
+		}
+		b = append(b, uploaded[p.Num]...)
+	}
+	if !bytes.Equal(b, mp.data) {
+		return 0, errors.New("completed upload " + uploadID + ": content differs")
+	}
+	delete(mp.uploads, uploadID)
+	return 0, nil
+}
+
+func (mp *testMpu) mpuAbort(_ *cmn.Bck, _, uploadID string) (int, error) {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+	mp.aborted = append(mp.aborted, uploadID)
+	delete(mp.uploads, uploadID)
+	return 0, nil
+}
+
+func (mp *testMpu) numUploaded(uploadID string) int {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+	return len(mp.uploads[uploadID])
+}
+
+// single-mountpath target with a given bucket
+func testLOM(t *testing.T, mpath string, bck *meta.Bck, objName string) *cluster.LOM {
+	config := cmn.GCO.BeginUpdate()
+	config.TestFSP.Count = 1
+	cmn.GCO.CommitUpdate(config)
+	fs.TestNew(nil)
+	fs.TestDisableValidation()
+	_, err := fs.Add(mpath, "daeID")
+	tassert.CheckFatal(t, err)
+	_ = cmock.NewTarget(cmock.NewBaseBownerMock(bck))
+
+	lom := cluster.AllocLOM(objName)
+	tassert.CheckFatal(t, lom.InitBck(bck.Bucket()))
+	return lom
+}
+
+func TestMultipartUpload(t *testing.T) {
+	var (
+		mpath = t.TempDir()
+		bck   = meta.NewBck("mpu", apc.AWS, cmn.NsGlobal, &cmn.BucketProps{Cksum: cmn.CksumConf{Type: cos.ChecksumXXHash}})
+		conf  = &cmn.BackendConfMPU{PartSize: cos.KiB, NumWorkers: 1, MaxRetries: 2}
+		data  = make([]byte, 6*cos.KiB+100) // 7 parts
+	)
+	lom := testLOM(t, mpath, bck, "obj")
+	defer cluster.FreeLOM(lom)
+	rand.Read(data)
+	lom.SetSize(int64(len(data)))
+	lom.SetCksum(cos.NewCksum(cos.ChecksumXXHash, "0123456789abcdef"))
+	path := mpuPath(lom.Mountpath().Path, lom.Uname())
+
+	t.Run("resume", func(t *testing.T) {
+		mp := newTestMpu(data)
+
+		// fail on part 4 (not retriable) - parts 1 through 3 remain uploaded and journaled
+		mp.failNum, mp.failCode = 4, http.StatusForbidden
+		errCode, err := mpuPut(mp, bytes.NewReader(data), lom, conf)
+		tassert.Fatalf(t, err != nil && errCode == http.StatusForbidden, "expected %d error, got %v(%d)",
+			http.StatusForbidden, err, errCode)
+		tassert.Errorf(t, mp.attempts[4] == 1, "expected no retries, got %d attempts", mp.attempts[4])
+		tassert.Errorf(t, mp.numUploaded("upload-1") == 3, "expected 3 uploaded parts, got %d", mp.numUploaded("upload-1"))
+		_, err = os.Stat(path)
+		tassert.Fatalf(t, err == nil, "expected upload record %q: %v", path, err)
+
+		// resume the same upload
+		mp.failNum = 0
+		_, err = mpuPut(mp, bytes.NewReader(data), lom, conf)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, mp.started == 1, "expected the upload to resume, got %d uploads", mp.started)
+		for num := 1; num <= 3; num++ {
+			tassert.Errorf(t, mp.attempts[num] == 1, "part %d: expected 1 attempt, got %d", num, mp.attempts[num])
+		}
+		tassert.Errorf(t, len(mp.uploads) == 0, "expected no uploads in progress, got %d", len(mp.uploads))
+		_, err = os.Stat(path)
+		tassert.Errorf(t, os.IsNotExist(err), "expected upload record %q to be removed: %v", path, err)
+	})
+
+	t.Run("content-changed", func(t *testing.T) {
+		mp := newTestMpu(data)
+		mp.failNum, mp.failCode = 2, http.StatusForbidden
+		_, err := mpuPut(mp, bytes.NewReader(data), lom, conf)
+		tassert.Fatalf(t, err != nil, "expected error")
+
+		// same size, different checksum: abort the old upload and start over
+		lom.SetCksum(cos.NewCksum(cos.ChecksumXXHash, "fedcba9876543210"))
+		mp.failNum = 0
+		_, err = mpuPut(mp, bytes.NewReader(data), lom, conf)
+		tassert.CheckFatal(t, err)
+		tassert.Errorf(t, mp.started == 2, "expected a new upload, got %d", mp.started)
+		tassert.Errorf(t, len(mp.aborted) == 1 && mp.aborted[0] == "upload-1", "expected upload-1 aborted, got %v",
+			mp.aborted)
+		tassert.Errorf(t, mp.attempts[1] == 2, "part 1: expected 2 attempts, got %d", mp.attempts[1])
+		tassert.Errorf(t, len(mp.uploads) == 0, "expected no uploads in progress, got %d", len(mp.uploads))
+	})
+
+	t.Run("retries-exhausted", func(t *testing.T) {
+		mp := newTestMpu(data)
+		mp.failNum, mp.failCode = 5, http.StatusInternalServerError
+		errCode, err := mpuPut(mp, bytes.NewReader(data), lom, conf)
+		tassert.Fatalf(t, err != nil && errCode == http.StatusInternalServerError, "expected %d error, got %v(%d)",
+			http.StatusInternalServerError, err, errCode)
+		tassert.Errorf(t, mp.attempts[5] == conf.Retries(), "expected %d attempts, got %d", conf.Retries(), mp.attempts[5])
+		tassert.Errorf(t, mp.attempts[6] == 0, "expected no attempts to upload part 6, got %d", mp.attempts[6])
+
+		// journaled - to be resumed or, eventually, cleaned up
+		_, err = os.Stat(path)
+		tassert.Fatalf(t, err == nil, "expected upload record %q: %v", path, err)
+		n := MpuCleanup(map[string]cluster.BackendProvider{}, 0)
+		tassert.Errorf(t, n == 1, "expected 1 abandoned upload, got %d", n)
+		_, err = os.Stat(path)
+		tassert.Errorf(t, os.IsNotExist(err), "expected upload record %q to be removed: %v", path, err)
+	})
+}
@@ -0,0 +1,79 @@
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// offlineBP stands in for a remote backend that is currently unreachable
+// (see ais/tgtbhealth.go) - all requests fail fast with 503
+type offlineBP struct {
+	statsT   cos.StatsUpdater
+	provider string
+}
+
+// interface guard
+var _ cluster.BackendProvider = (*offlineBP)(nil)
+
+func NewOffline(provider string, statsT cos.StatsUpdater) cluster.BackendProvider {
+	return &offlineBP{provider: provider, statsT: statsT}
+}
+
+func (bp *offlineBP) err(bck *cmn.Bck) (int, error) {
+	if bp.statsT != nil {
+		bp.statsT.Inc(stats.ErrBackendOfflineCount)
+	}
+	return http.StatusServiceUnavailable, cmn.NewErrRemoteBckOffline(bck)
+}
+
+func (bp *offlineBP) Provider() string { return bp.provider }
+func (*offlineBP) MaxPageSize() uint   { return apc.DefaultPageSizeCloud }
+
+func (bp *offlineBP) CreateBucket(bck *meta.Bck) (int, error) { return bp.err(bck.Bucket()) }
+
+func (bp *offlineBP) HeadBucket(_ ctx, bck *meta.Bck) (cos.StrKVs, int, error) {
+	errCode, err := bp.err(bck.Bucket())
+	return nil, errCode, err
+}
+
+func (bp *offlineBP) ListObjects(bck *meta.Bck, _ *apc.LsoMsg, _ *cmn.LsoResult) (int, error) {
+	return bp.err(bck.Bucket())
+}
+
+func (bp *offlineBP) ListBuckets(cmn.QueryBcks) (cmn.Bcks, int, error) {
+	errCode, err := bp.err(&cmn.Bck{Provider: bp.provider})
+	return nil, errCode, err
+}
+
+func (bp *offlineBP) HeadObj(_ ctx, lom *cluster.LOM) (*cmn.ObjAttrs, int, error) {
+	errCode, err := bp.err(lom.Bucket())
+	return nil, errCode, err
+}
+
+func (bp *offlineBP) GetObj(_ ctx, lom *cluster.LOM, _ cmn.OWT) (int, error) {
+	return bp.err(lom.Bucket())
+}
+
+func (bp *offlineBP) GetObjReader(_ context.Context, lom *cluster.LOM) (io.ReadCloser, *cos.Cksum, int, error) {
+	errCode, err := bp.err(lom.Bucket())
+	return nil, nil, errCode, err
+}
+
+func (bp *offlineBP) PutObj(r io.ReadCloser, lom *cluster.LOM) (int, error) {
+	cos.Close(r)
+	return bp.err(lom.Bucket())
+}
+
+func (bp *offlineBP) DeleteObj(lom *cluster.LOM) (int, error) { return bp.err(lom.Bucket()) }
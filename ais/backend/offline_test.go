@@ -0,0 +1,77 @@
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+type testCounters map[string]int64
+
+func (c testCounters) Inc(name string)          { c[name]++ }
+func (c testCounters) Add(name string, v int64) { c[name] += v }
+func (c testCounters) Get(name string) int64    { return c[name] }
+func (c testCounters) AddMany(nvs ...cos.NamedVal64) {
+	for _, nv := range nvs {
+		c[nv.Name] += nv.Value
+	}
+}
+
+func TestOfflineBackend(t *testing.T) {
+	var (
+		counters = testCounters{}
+		bp       = NewOffline(apc.AWS, counters)
+		bck      = meta.NewBck("abc", apc.AWS, cmn.NsGlobal, &cmn.BucketProps{})
+		lom      = testLOM(t, t.TempDir(), bck, "obj")
+		ctx      = context.Background()
+	)
+	defer cluster.FreeLOM(lom)
+	tassert.Errorf(t, bp.Provider() == apc.AWS, "expected provider %q, got %q", apc.AWS, bp.Provider())
+
+	calls := map[string]func() (int, error){
+		"create-bucket": func() (int, error) { return bp.CreateBucket(bck) },
+		"head-bucket": func() (int, error) {
+			_, code, err := bp.HeadBucket(ctx, bck)
+			return code, err
+		},
+		"list-objects": func() (int, error) { return bp.ListObjects(bck, &apc.LsoMsg{}, &cmn.LsoResult{}) },
+		"list-buckets": func() (int, error) {
+			_, code, err := bp.ListBuckets(cmn.QueryBcks{Provider: apc.AWS})
+			return code, err
+		},
+		"head-object": func() (int, error) {
+			_, code, err := bp.HeadObj(ctx, lom)
+			return code, err
+		},
+		"get-object": func() (int, error) { return bp.GetObj(ctx, lom, cmn.OwtGet) },
+		"get-object-reader": func() (int, error) {
+			_, _, code, err := bp.GetObjReader(ctx, lom)
+			return code, err
+		},
+		"put-object":    func() (int, error) { return bp.PutObj(io.NopCloser(strings.NewReader("abc")), lom) },
+		"delete-object": func() (int, error) { return bp.DeleteObj(lom) },
+	}
+	for name, call := range calls {
+		code, err := call()
+		tassert.Errorf(t, code == http.StatusServiceUnavailable, "%s: expected status %d, got %d",
+			name, http.StatusServiceUnavailable, code)
+		_, ok := err.(*cmn.ErrRemoteBucketOffline)
+		tassert.Errorf(t, ok, "%s: expected remote bucket offline error, got %v", name, err)
+	}
+	n := counters.Get(stats.ErrBackendOfflineCount)
+	tassert.Errorf(t, n == int64(len(calls)), "expected %d failed-fast requests, got %d", len(calls), n)
+}
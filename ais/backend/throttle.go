@@ -0,0 +1,233 @@
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Rate limiting and throttling of requests to remote backends (see cmn.BackendConfRateLimit):
+// - configured limits are cluster-wide, with each target enforcing its (1/num-active-targets) share
+//   via token buckets - one per provider and/or bucket;
+// - throttling responses (429, 503) are retried with jittered exponential backoff while, at the
+//   same time, temporarily reducing the effective rate of the respective token buckets.
+
+const (
+	throttleMinBackoff = 100 * time.Millisecond
+	throttleRecover    = 10 * time.Second // time to recover the rate after the max cut
+	throttleMinFactor  = 1.0 / 16         // max cut
+	throttleRefresh    = 10 * time.Second // max time to notice cluster membership changes
+)
+
+type (
+	tbucket struct {
+		rate   float64 // this target's share (requests/s)
+		tokens float64 // negative - outstanding reservations
+		factor float64 // (0, 1] - adaptive, reduced upon throttling
+		last   int64   // last refill (mono time)
+		mu     sync.Mutex
+	}
+	throttler struct {
+		t         cluster.Node
+		statsT    cos.StatsUpdater
+		tbs       map[string]*tbucket
+		ver       int64 // config version
+		nat       int   // num active targets
+		refreshed int64
+		mu        sync.RWMutex
+	}
+)
+
+var thr throttler
+
+func InitThrottle(t cluster.Node, statsT cos.StatsUpdater) {
+	thr.t, thr.statsT = t, statsT
+}
+
+// execute remote request `cb` subject to configured rate limits;
+// retry upon throttling responses (from the remote backend)
+func throttled(ctx context.Context, bck *cmn.Bck, cb func() (int, error)) (errCode int, err error) {
+	var (
+		config  = cmn.GCO.Get()
+		rl      = &config.Backend.RateLimit
+		tbs     = thr.get(config, bck)
+		retries = rl.Retries()
+		backoff = throttleMinBackoff
+		waited  time.Duration
+	)
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	for i := 0; ; i++ {
+		if d := reserve(tbs); d > 0 {
+			if err = sleepCtx(ctx, d); err != nil {
+				break
+			}
+			waited += d
+		}
+		errCode, err = cb()
+		if err == nil || !isThrottle(errCode) {
+			break
+		}
+		for _, tb := range tbs {
+			tb.cut()
+		}
+		if i >= retries {
+			thr.inc(stats.ErrThrottleCount)
+			break
+		}
+		d := backoff/2 + time.Duration(rand.Int63n(int64(backoff)))
+		if err = sleepCtx(ctx, d); err != nil {
+			break
+		}
+		waited += d
+		backoff = cos.MinDuration(backoff*2, rl.Backoff())
+	}
+	if waited > 0 && thr.statsT != nil {
+		thr.statsT.AddMany(
+			cos.NamedVal64{Name: stats.ThrottleCount, Value: 1},
+			cos.NamedVal64{Name: stats.ThrottleLatency, Value: int64(waited)},
+		)
+	}
+	return
+}
+
+// rate limiting only - for requests that cannot be retried (e.g., PUT of a non-replayable reader)
+func rateLimit(bck *cmn.Bck) {
+	if d := reserve(thr.get(cmn.GCO.Get(), bck)); d > 0 {
+		time.Sleep(d)
+		if thr.statsT != nil {
+			thr.statsT.AddMany(
+				cos.NamedVal64{Name: stats.ThrottleCount, Value: 1},
+				cos.NamedVal64{Name: stats.ThrottleLatency, Value: int64(d)},
+			)
+		}
+	}
+}
+
+func isThrottle(errCode int) bool {
+	return errCode == http.StatusTooManyRequests || errCode == http.StatusServiceUnavailable
+}
+
+func reserve(tbs []*tbucket) (d time.Duration) {
+	now := mono.NanoTime()
+	for _, tb := range tbs {
+		d = cos.MaxDuration(d, tb.reserve(now))
+	}
+	return
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		timer.Stop()
+		return ctx.Err()
+	}
+}
+
+///////////////
+// throttler //
+///////////////
+
+// returns (up to two) token buckets: per provider and per bucket
+func (th *throttler) get(config *cmn.Config, bck *cmn.Bck) (tbs []*tbucket) {
+	limits := config.Backend.RateLimit.Limits()
+	if len(limits) == 0 {
+		return nil
+	}
+	th.mu.RLock()
+	if th.ver != config.Version || mono.Since(th.refreshed) > throttleRefresh {
+		th.mu.RUnlock()
+		th.refresh(config, limits)
+		th.mu.RLock()
+	}
+	if tb, ok := th.tbs[bck.Provider]; ok {
+		tbs = append(tbs, tb)
+	}
+	if tb, ok := th.tbs[bck.MakeUname("")]; ok {
+		tbs = append(tbs, tb)
+	}
+	th.mu.RUnlock()
+	return
+}
+
+func (th *throttler) refresh(config *cmn.Config, limits map[string]float64) {
+	nat := 1
+	if th.t != nil {
+		if n := th.t.Sowner().Get().CountActiveTs(); n > 1 {
+			nat = n
+		}
+	}
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	th.refreshed = mono.NanoTime()
+	if th.ver == config.Version && th.nat == nat {
+		return
+	}
+	tbs := make(map[string]*tbucket, len(limits))
+	for key, rps := range limits {
+		rate := rps / float64(nat)
+		if tb, ok := th.tbs[key]; ok {
+			tb.mu.Lock()
+			tb.rate = rate
+			tb.mu.Unlock()
+			tbs[key] = tb
+		} else {
+			tbs[key] = &tbucket{rate: rate, tokens: math.Max(rate, 1), factor: 1, last: th.refreshed}
+		}
+	}
+	th.tbs, th.ver, th.nat = tbs, config.Version, nat
+}
+
+func (th *throttler) inc(name string) {
+	if th.statsT != nil {
+		th.statsT.Inc(name)
+	}
+}
+
+/////////////
+// tbucket //
+/////////////
+
+// reserve a single token and return the time to wait for it
+func (tb *tbucket) reserve(now int64) (d time.Duration) {
+	tb.mu.Lock()
+	elapsed := time.Duration(now - tb.last).Seconds()
+	if elapsed > 0 {
+		tb.last = now
+		if tb.factor < 1 {
+			tb.factor = math.Min(tb.factor+elapsed/throttleRecover.Seconds(), 1)
+		}
+		// burst: up to one second worth of requests
+		tb.tokens = math.Min(tb.tokens+elapsed*tb.rate*tb.factor, math.Max(tb.rate, 1))
+	}
+	tb.tokens--
+	if tb.tokens < 0 {
+		d = time.Duration(-tb.tokens / (tb.rate * tb.factor) * float64(time.Second))
+	}
+	tb.mu.Unlock()
+	return
+}
+
+// reduce the effective rate upon throttling response
+func (tb *tbucket) cut() {
+	tb.mu.Lock()
+	tb.factor = math.Max(tb.factor/2, throttleMinFactor)
+	tb.mu.Unlock()
+}
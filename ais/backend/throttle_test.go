@@ -0,0 +1,100 @@
+// Package backend contains implementation of various backend providers.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package backend
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestThrottleTokenBucket(t *testing.T) {
+	var (
+		now = time.Now().UnixNano()
+		tb  = &tbucket{rate: 10, tokens: 10, factor: 1, last: now}
+	)
+	// burst
+	for i := 0; i < 10; i++ {
+		tassert.Fatalf(t, tb.reserve(now) == 0, "request %d: expected no wait", i)
+	}
+	d := tb.reserve(now)
+	tassert.Fatalf(t, d == 100*time.Millisecond, "expected 100ms, got %v", d)
+
+	// after one second: exactly one second worth of tokens minus outstanding reservation
+	now += int64(time.Second)
+	for i := 0; i < 9; i++ {
+		tassert.Fatalf(t, tb.reserve(now) == 0, "request %d: expected no wait", i)
+	}
+	tassert.Fatalf(t, tb.reserve(now) > 0, "expected to wait")
+
+	// throttling cuts the rate in half, and then recovers
+	tb.cut()
+	tassert.Fatalf(t, tb.factor == 0.5, "expected factor 0.5, got %f", tb.factor)
+	for i := 0; i < 10; i++ {
+		tb.cut()
+	}
+	tassert.Fatalf(t, tb.factor == throttleMinFactor, "expected min factor, got %f", tb.factor)
+	now += int64(throttleRecover)
+	tb.reserve(now)
+	tassert.Fatalf(t, tb.factor == 1, "expected full recovery, got %f", tb.factor)
+}
+
+func TestThrottleRetry(t *testing.T) {
+	config := cmn.GCO.BeginUpdate()
+	config.Backend.RateLimit = cmn.BackendConfRateLimit{
+		RPS:        map[string]float64{"s3": 1000, "s3://abc": 500},
+		MaxRetries: 2,
+		MaxBackoff: cos.Duration(time.Millisecond),
+	}
+	err := config.Backend.RateLimit.Validate()
+	cmn.GCO.CommitUpdate(config)
+	tassert.CheckFatal(t, err)
+
+	var (
+		bck   = &cmn.Bck{Name: "abc", Provider: apc.AWS}
+		calls int
+		errT  = errors.New("slow down")
+	)
+	tbs := thr.get(cmn.GCO.Get(), bck)
+	tassert.Fatalf(t, len(tbs) == 2, "expected provider and bucket limits, got %d", len(tbs))
+	tbs = thr.get(cmn.GCO.Get(), &cmn.Bck{Name: "xyz", Provider: apc.AWS})
+	tassert.Fatalf(t, len(tbs) == 1 && tbs[0].rate == 1000, "expected provider limit, got %+v", tbs)
+	tbs = thr.get(cmn.GCO.Get(), &cmn.Bck{Name: "abc", Provider: apc.GCP})
+	tassert.Fatalf(t, len(tbs) == 0, "expected no limits, got %d", len(tbs))
+
+	// succeeds upon retry
+	errCode, err := throttled(context.Background(), bck, func() (int, error) {
+		if calls++; calls < 3 {
+			return http.StatusServiceUnavailable, errT
+		}
+		return 0, nil
+	})
+	tassert.Fatalf(t, err == nil && errCode == 0 && calls == 3, "expected success after 3 calls, got %d (%d, %v)",
+		calls, errCode, err)
+
+	// max retries
+	calls = 0
+	errCode, err = throttled(context.Background(), bck, func() (int, error) {
+		calls++
+		return http.StatusTooManyRequests, errT
+	})
+	tassert.Fatalf(t, err == errT && errCode == http.StatusTooManyRequests && calls == 3,
+		"expected failure after 3 calls, got %d (%d, %v)", calls, errCode, err)
+
+	// not retrying other errors
+	calls = 0
+	errCode, _ = throttled(context.Background(), bck, func() (int, error) {
+		calls++
+		return http.StatusNotFound, errT
+	})
+	tassert.Fatalf(t, errCode == http.StatusNotFound && calls == 1, "expected single call, got %d", calls)
+}
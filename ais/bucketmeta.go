@@ -522,6 +522,14 @@ func defaultBckProps(args bckPropsArgs) (props *cmn.BucketProps) {
 		}
 		// Use HDFS props.
 		props.Extra.HDFS = args.bck.Props.Extra.HDFS
+	case args.bck.IsFile():
+		if args.hdr != nil {
+			props = mergeRemoteBckProps(props, args.hdr)
+		}
+		if args.bck.Props == nil {
+			return // (ditto)
+		}
+		props.Extra.File = args.bck.Props.Extra.File
 	case args.bck.IsRemote():
 		debug.Assert(args.hdr != nil)
 		props.Versioning.Enabled = false
@@ -540,6 +548,7 @@ func mergeRemoteBckProps(props *cmn.BucketProps, header http.Header) *cmn.Bucket
 	case apc.AWS:
 		props.Extra.AWS.CloudRegion = header.Get(apc.HdrS3Region)
 		props.Extra.AWS.Endpoint = header.Get(apc.HdrS3Endpoint)
+		props.Extra.AWS.Profile = header.Get(apc.HdrS3Profile)
 	case apc.HTTP:
 		props.Extra.HTTP.OrigURLBck = header.Get(apc.HdrOrigURLBck)
 	}
@@ -850,9 +850,9 @@ func _reMirror(bprops, nprops *cmn.BucketProps) bool {
 func _reEC(bprops, nprops *cmn.BucketProps, bck *meta.Bck, smap *smapX) (targetCnt int, yes bool) {
 	if !nprops.EC.Enabled {
 		if bprops.EC.Enabled {
-			// abort running ec-encode xaction, if exists
-			flt := xreg.Flt{Kind: apc.ActECEncode, Bck: bck}
-			xreg.DoAbort(flt, errors.New("ec-disabled"))
+			// abort running ec-encode (ec-reencode) xaction, if exists
+			xreg.DoAbort(xreg.Flt{Kind: apc.ActECEncode, Bck: bck}, errors.New("ec-disabled"))
+			xreg.DoAbort(xreg.Flt{Kind: apc.ActECReencode, Bck: bck}, errors.New("ec-disabled"))
 		}
 		return
 	}
@@ -865,3 +865,9 @@ func _reEC(bprops, nprops *cmn.BucketProps, bck *meta.Bck, smap *smapX) (targetC
 	}
 	return
 }
+
+// erasure coded bucket with a different number of data and/or parity slices
+func _reEncode(bprops, nprops *cmn.BucketProps) bool {
+	return bprops.EC.Enabled && nprops.EC.Enabled &&
+		(bprops.EC.DataSlices != nprops.EC.DataSlices || bprops.EC.ParitySlices != nprops.EC.ParitySlices)
+}
@@ -399,6 +399,8 @@ func (h *htrun) initNetworks() {
 		PubNet:     pubAddr,
 		ControlNet: intraControlAddr,
 		DataNet:    intraDataAddr,
+		Rack:       config.Topology.Rack,
+		Zone:       config.Topology.Zone,
 	}
 }
 
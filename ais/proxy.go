@@ -199,6 +199,7 @@ func (p *proxy) Run() error {
 	p.notifs.init(p)
 	p.ic.init(p)
 	p.qm.init()
+	p.regScrubHK()
 
 	//
 	// REST API: register proxy handlers and start listening
@@ -653,7 +654,7 @@ func (p *proxy) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiRe
 	}
 	appendTyProvided := apireq.dpq.appendTy != "" // apc.QparamAppendType
 	if !appendTyProvided {
-		perms = apc.AcePUT
+		perms = bypassGovAce(r.Header, apc.AcePUT)
 	} else {
 		hi, err := parseAppendHandle(apireq.dpq.appendHdl) // apc.QparamAppendHandle
 		if err != nil {
@@ -723,7 +724,7 @@ func (p *proxy) httpobjdelete(w http.ResponseWriter, r *http.Request) {
 		bckArgs.p = p
 		bckArgs.w = w
 		bckArgs.r = r
-		bckArgs.perms = apc.AceObjDELETE
+		bckArgs.perms = bypassGovAce(r.Header, apc.AceObjDELETE)
 		bckArgs.createAIS = false
 	}
 	bck, objName, err := p._parseReqTry(w, r, bckArgs)
@@ -779,6 +780,12 @@ func (p *proxy) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *ap
 	}
 
 	// 3. action
+	if msg.Action == apc.ActEvictRemoteBck || msg.Action == apc.ActDestroyBck {
+		if err := bck.Props.ObjLock.CheckBck(bck.Cname(""), msg.Action); err != nil {
+			p.writeErr(w, r, err, http.StatusForbidden)
+			return
+		}
+	}
 	switch msg.Action {
 	case apc.ActEvictRemoteBck:
 		if !bck.IsRemote() {
@@ -786,8 +793,8 @@ func (p *proxy) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *ap
 			return
 		}
 		keepMD := cos.IsParseBool(apireq.query.Get(apc.QparamKeepRemote))
-		// HDFS buckets will always keep metadata so they can re-register later
-		if bck.IsHDFS() || keepMD {
+		// HDFS and file buckets will always keep metadata so they can re-register later
+		if bck.IsHDFS() || bck.IsFile() || keepMD {
 			if err := p.destroyBucketData(msg, bck); err != nil {
 				p.writeErr(w, r, err)
 			}
@@ -1121,6 +1128,10 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			p.writeErrf(w, r, "cannot rename bucket %q to itself (%q)", bckFrom, bckTo)
 			return
 		}
+		if err := bckFrom.Props.ObjLock.CheckBck(bckFrom.Cname(""), msg.Action); err != nil {
+			p.writeErr(w, r, err, http.StatusForbidden)
+			return
+		}
 		bckFrom.Provider, bckTo.Provider = apc.AIS, apc.AIS
 		if _, present := p.owner.bmd.get().Get(bckTo); present {
 			err := cmn.NewErrBckAlreadyExists(bckTo.Bucket())
@@ -1180,7 +1191,7 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 		if v := query.Get(apc.QparamFltPresence); v != "" {
 			fltPresence, _ = strconv.Atoi(v)
 		}
-		if !apc.IsFltPresent(fltPresence) && bck.IsRemote() && !bck.IsHTTP() {
+		if !apc.IsFltPresent(fltPresence) && bck.IsRemote() && (!bck.IsHTTP() || bck.Props.Extra.HTTP.Listable()) {
 			if fltPresence == apc.FltExistsOutside {
 				// TODO: upon request
 				err = fmt.Errorf("(flt %d=\"outside\") not implemented yet", fltPresence)
@@ -1260,6 +1271,18 @@ func (p *proxy) _bckpost(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg
 			p.writeErr(w, r, err)
 			return
 		}
+	case apc.ActSyncBck:
+		if !bck.IsRemote() || bck.IsRemoteAIS() || (bck.IsHTTP() && !bck.Props.Extra.HTTP.Listable()) {
+			p.writeErrf(w, r, "cannot %s %s: expecting listable cloud or http bucket", msg.Action, bck)
+			return
+		}
+		if err := p.checkAccess(w, r, bck, apc.AceObjDELETE); err != nil {
+			return
+		}
+		if xid, err = p.listrange(r.Method, bucket, msg, query); err != nil {
+			p.writeErr(w, r, err)
+			return
+		}
 	case apc.ActInvalListCache:
 		p.qm.c.invalidate(bck.Bucket())
 		return
@@ -1328,9 +1351,18 @@ func (p *proxy) _bcr(w http.ResponseWriter, r *http.Request, query url.Values, m
 			errors.New("property 'extra.hdfs.ref_directory' must be specified when creating HDFS bucket"))
 		return
 	}
+	if bck.IsFile() && msg.Value == nil {
+		p.writeErr(w, r,
+			errors.New("property 'extra.file.ref_directory' must be specified when creating file bucket"))
+		return
+	}
 	// remote: check existence and get (cloud) props
 	if bck.IsRemote() {
-		rhdr, statusCode, err := p.headRemoteBck(bck.RemoteBck(), nil)
+		var q url.Values
+		if bck.Provider == apc.AWS && msg.Value != nil {
+			q = s3CreateQuery(msg.Value) // with the specified (if any) endpoint and credentials profile
+		}
+		rhdr, statusCode, err := p.headRemoteBck(bck.RemoteBck(), q)
 		if err != nil {
 			if bck.IsCloud() {
 				statusCode = http.StatusNotImplemented
@@ -1341,6 +1373,14 @@ func (p *proxy) _bcr(w http.ResponseWriter, r *http.Request, query url.Values, m
 			p.writeErr(w, r, err, statusCode)
 			return
 		}
+		// all targets must be able to use the specified endpoint and credentials
+		if q != nil {
+			if err := p.headRemoteBckAll(bck.RemoteBck(), q); err != nil {
+				p.writeErrf(w, r, "cannot create %s using endpoint %q and credentials profile %q: %v", bck,
+					q.Get(apc.QparamS3Endpoint), q.Get(apc.QparamS3Profile), err)
+				return
+			}
+		}
 		remoteHdr = rhdr
 		// NOTE: substituting action in the message
 		msg.Action = apc.ActAddRemoteBck
@@ -1415,8 +1455,9 @@ func (p *proxy) listObjects(w http.ResponseWriter, r *http.Request, bck *meta.Bc
 	case lsmsg.WantOnlyName():
 		lsmsg.SetFlag(apc.LsNameOnly)
 	}
-	// ht:// backend doesn't have `ListObjects`; can only locally list archived content
-	if bck.IsHTTP() || lsmsg.IsFlagSet(apc.LsArchDir) {
+	// ht:// backend lists objects only when configured to (see cmn.ExtraPropsHTTP);
+	// can only locally list archived content
+	if (bck.IsHTTP() && !bck.Props.Extra.HTTP.Listable()) || lsmsg.IsFlagSet(apc.LsArchDir) {
 		lsmsg.SetFlag(apc.LsObjCached)
 	}
 
@@ -1553,7 +1594,7 @@ func (p *proxy) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *apiR
 	}
 	switch msg.Action {
 	case apc.ActRenameObject:
-		if err := p.checkAccess(w, r, bck, apc.AceObjMOVE); err != nil {
+		if err := p.checkAccess(w, r, bck, bypassGovAce(r.Header, apc.AceObjMOVE)); err != nil {
 			return
 		}
 		if bck.IsRemote() {
@@ -1991,7 +2032,7 @@ func (p *proxy) listBuckets(w http.ResponseWriter, r *http.Request, qbck *cmn.Qu
 		bmd     = p.owner.bmd.get()
 		present bool
 	)
-	if qbck.IsAIS() || qbck.IsHTTP() || qbck.IsHDFS() {
+	if qbck.IsAIS() || qbck.IsHTTP() || qbck.IsHDFS() || qbck.IsFile() {
 		bcks := bmd.Select(qbck)
 		p.writeJSON(w, r, bcks, "list-buckets")
 		return
@@ -3156,6 +3197,34 @@ func (p *proxy) headRemoteBck(bck *cmn.Bck, q url.Values) (header http.Header, s
 	return
 }
 
+// same as above except that each and every target executes the HEAD - to make sure that
+// all of them can access the bucket (e.g., using the specified endpoint and credentials)
+func (p *proxy) headRemoteBckAll(bck *cmn.Bck, q url.Values) (err error) {
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{Method: http.MethodHead, Path: apc.URLPathBuckets.Join(bck.Name), Query: bck.AddToQuery(q)}
+	args.to = cluster.Targets
+	args.timeout = apc.DefaultTimeout
+	results := p.bcastGroup(args)
+	freeBcArgs(args)
+	for _, res := range results {
+		if res.err == nil {
+			continue
+		}
+		switch res.status {
+		case http.StatusNotFound:
+			err = cmn.NewErrRemoteBckNotFound(bck)
+		case http.StatusGone:
+			err = cmn.NewErrRemoteBckOffline(bck)
+		default:
+			err = res.err
+		}
+		err = fmt.Errorf("%s: %v", res.si, err)
+		break
+	}
+	freeBcastRes(results)
+	return
+}
+
 //////////////////
 // reverseProxy //
 //////////////////
@@ -236,6 +236,15 @@ func (p *proxy) checkAccess(w http.ResponseWriter, r *http.Request, bck *meta.Bc
 	return
 }
 
+// object lock: bypassing governance-mode retention additionally requires permission
+// to update bucket props (the same permission that allows to disable object lock)
+func bypassGovAce(hdr http.Header, ace apc.AccessAttrs) apc.AccessAttrs {
+	if cos.IsParseBool(hdr.Get(apc.HdrBypassGovernance)) || cos.IsParseBool(hdr.Get(cos.S3HdrBypassGovernance)) {
+		ace |= apc.AcePATCH
+	}
+	return ace
+}
+
 func aceErrToCode(err error) (status int) {
 	switch err {
 	case nil:
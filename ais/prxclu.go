@@ -639,6 +639,11 @@ func (p *proxy) rereg(nsi, osi *meta.Snode) bool {
 	if !p.NodeStarted() {
 		return true
 	}
+	if !osi.SameTopology(nsi) {
+		glog.Warningf("%s: %s changed topology: zone %q => %q, rack %q => %q", p, nsi.StringEx(),
+			osi.Zone, nsi.Zone, osi.Rack, nsi.Rack)
+		return true // NOTE: update cluster map and rebalance (see mustRebalance)
+	}
 	if osi.Equals(nsi) {
 		glog.Infof("%s: %s is already *in*", p, nsi.StringEx())
 		return false
@@ -946,6 +951,11 @@ func (p *proxy) cluputJSON(w http.ResponseWriter, r *http.Request) {
 		p.xstart(w, r, msg)
 	case apc.ActXactStop:
 		p.xstop(w, r, msg)
+	case apc.ActRebPause, apc.ActRebResume:
+		args := allocBcArgs()
+		args.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathDae.S, Body: cos.MustMarshal(msg)}
+		p.bcastReqGroup(w, r, args, cluster.Targets)
+		freeBcArgs(args)
 	case apc.ActSendOwnershipTbl:
 		p.sendOwnTbl(w, r, msg)
 	default:
@@ -1055,27 +1065,42 @@ func (p *proxy) xstart(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg)
 	}
 
 	// all the rest `startable` (see xaction/api.go)
-	body := cos.MustMarshal(apc.ActMsg{Action: msg.Action, Value: xargs})
+	if err := p._xstart(&xargs); err != nil {
+		p.writeErr(w, r, err)
+		return
+	}
+	w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(xargs.ID)))
+	w.Write([]byte(xargs.ID))
+}
+
+// start xaction on all targets (with the same ID) and register it with IC;
+// upon failure (e.g., when already running on some target), stop the ones that did start
+// and do not register
+func (p *proxy) _xstart(xargs *xact.ArgsMsg) (err error) {
+	body := cos.MustMarshal(apc.ActMsg{Action: apc.ActXactStart, Value: xargs})
 	args := allocBcArgs()
 	args.req = cmn.HreqArgs{Method: http.MethodPut, Path: apc.URLPathXactions.S, Body: body}
 	args.to = cluster.Targets
 	results := p.bcastGroup(args)
-	freeBcArgs(args)
 	for _, res := range results {
-		if res.err == nil {
-			continue
+		if res.err != nil {
+			err = res.toErr()
+			break
 		}
-		p.writeErr(w, r, res.toErr())
-		freeBcastRes(results)
-		return
 	}
 	freeBcastRes(results)
+	if err != nil {
+		stop := xact.ArgsMsg{ID: xargs.ID, Kind: xargs.Kind}
+		args.req.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActXactStop, Value: stop})
+		freeBcastRes(p.bcastGroup(args))
+		freeBcArgs(args)
+		return
+	}
+	freeBcArgs(args)
 	smap := p.owner.smap.get()
 	nl := xact.NewXactNL(xargs.ID, xargs.Kind, &smap.Smap, nil)
 	p.ic.registerEqual(regIC{smap: smap, nl: nl})
-
-	w.Header().Set(cos.HdrContentLength, strconv.Itoa(len(xargs.ID)))
-	w.Write([]byte(xargs.ID))
+	return
 }
 
 func (p *proxy) xstop(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg) {
@@ -1957,6 +1982,10 @@ func mustRebalance(ctx *smapModifier, cur *smapX) bool {
 		if !tsi.InMaintOrDecomm() && prev.GetActiveNode(tsi.ID()) == nil {
 			return true
 		}
+		// changed rack and/or zone (see cluster.HrwTargetList)
+		if osi := prev.GetNode(tsi.ID()); osi != nil && !osi.SameTopology(tsi) {
+			return true
+		}
 	}
 	for _, tsi := range prev.Tmap {
 		// removed an active one or deactivated previously active
@@ -0,0 +1,54 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// target rejoining with different rack and/or zone labels
+func TestRebalanceUponTopologyChange(tst *testing.T) {
+	config := cmn.GCO.BeginUpdate()
+	config.Rebalance.Enabled = true
+	cmn.GCO.CommitUpdate(config)
+
+	prev := newSmap()
+	prev.Primary = meta.NewSnode("primary", apc.Proxy, meta.NetInfo{}, meta.NetInfo{}, meta.NetInfo{})
+	prev.addProxy(prev.Primary)
+	for i := 0; i < 3; i++ {
+		tsi := meta.NewSnode(fmt.Sprintf("t%d", i), apc.Target, meta.NetInfo{}, meta.NetInfo{}, meta.NetInfo{})
+		tsi.Zone, tsi.Rack = "z0", fmt.Sprintf("r%d", i)
+		prev.addTarget(tsi)
+	}
+	prev.Version = 1
+
+	tests := []struct {
+		zone, rack string
+		reb        bool
+	}{
+		{"z0", "r1", false},
+		{"z0", "r0", true},
+		{"z1", "r1", true},
+		{"", "", true},
+	}
+	for _, test := range tests {
+		var (
+			ctx   = &smapModifier{smap: prev}
+			clone = prev.clone()
+			nsi   = clone.GetTarget("t1").Clone()
+		)
+		nsi.Zone, nsi.Rack = test.zone, test.rack
+		clone.putNode(nsi, 0, true /*silent*/)
+		clone.Version++
+		reb := mustRebalance(ctx, clone)
+		tassert.Errorf(tst, reb == test.reb, "(%q, %q): expected rebalance=%t, got %t", test.zone, test.rack, test.reb, reb)
+	}
+}
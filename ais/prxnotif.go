@@ -212,6 +212,7 @@ func (n *notifs) add(nl nl.Listener) (err error) {
 		return fmt.Errorf("cannot add %q with no active notifiers", nl)
 	}
 	if exists := n.nls.add(nl, false /*locked*/); exists {
+		n.merge(nl)
 		return
 	}
 	nl.SetAddedTime()
@@ -221,6 +222,20 @@ func (n *notifs) add(nl nl.Listener) (err error) {
 	return
 }
 
+// same UUID registered by different notifiers, each on its own behalf (e.g., download job
+// resumed by multiple targets upon cluster restart - see target.startdl): merge the notifiers
+func (n *notifs) merge(nl nl.Listener) {
+	existing, ok := n.nls.entry(nl.UUID())
+	if !ok || existing == nl {
+		return
+	}
+	existing.Lock()
+	if !existing.Finished() {
+		existing.AddNotifiers(nl.Notifiers())
+	}
+	existing.Unlock()
+}
+
 func (n *notifs) del(nl nl.Listener, locked bool) (ok bool) {
 	ok = n.nls.del(nl, locked /*locked*/)
 	if ok && cmn.FastV(4, cos.SmoduleAIS) {
@@ -225,6 +225,24 @@ var _ = Describe("Notifications xaction test", func() {
 		})
 	})
 
+	Describe("add", func() {
+		It("should merge notifiers registered separately under the same UUID", func() {
+			n.add(xact.NewXactNL(xid, apc.ActDownload, &smap.Smap, getNodeMap(target1ID)))
+			n.add(xact.NewXactNL(xid, apc.ActDownload, &smap.Smap, getNodeMap(target2ID)))
+			nl := n.entry(xid)
+			Expect(nl).NotTo(BeNil())
+			Expect(nl.Notifiers()).To(HaveLen(2))
+			Expect(nl.ActiveCount()).To(BeEquivalentTo(2))
+
+			// not finished until both are
+			snap := finishedXact(xid)
+			Expect(n.handleFinished(nl, targets[target1ID], cos.MustMarshal(snap), nil)).To(BeNil())
+			Expect(nl.Finished()).To(BeFalse())
+			Expect(n.handleFinished(nl, targets[target2ID], cos.MustMarshal(snap), nil)).To(BeNil())
+			Expect(nl.Finished()).To(BeTrue())
+		})
+	})
+
 	Describe("ListenSmapChanged", func() {
 		It("should mark xaction Aborted when node not in smap", func() {
 			notifiers := getNodeMap(target1ID, target2ID)
@@ -10,7 +10,6 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
-	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/3rdparty/glog"
@@ -18,6 +17,7 @@ import (
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cluster"
 	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	jsoniter "github.com/json-iterator/go"
@@ -40,6 +40,15 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
+	if len(apiItems) > 0 && s3.HandleCORS(w, r, apiItems[0], p.owner.bmd) {
+		return
+	}
+	if q := r.URL.Query(); cos.IsS3Presigned(q) {
+		if errCode, err := p.validatePresignedS3(r, q, apiItems); err != nil {
+			s3.WriteErr(w, r, err, errCode)
+			return
+		}
+	}
 
 	switch r.Method {
 	case http.MethodHead:
@@ -60,16 +69,19 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		var (
-			q            = r.URL.Query()
-			_, lifecycle = q[s3.QparamLifecycle]
-			_, policy    = q[s3.QparamPolicy]
-			_, cors      = q[s3.QparamCORS]
-			_, acl       = q[s3.QparamACL]
+			q         = r.URL.Query()
+			_, policy = q[s3.QparamPolicy]
+			_, cors   = q[s3.QparamCORS]
+			_, acl    = q[s3.QparamACL]
 		)
-		if lifecycle || policy || cors || acl {
+		if (cors || acl) && len(apiItems) > 1 {
 			p.unsupported(w, r, apiItems[0])
 			return
 		}
+		if q.Has(s3.QparamTagging) {
+			p.objTaggingS3(w, r, apiItems, apc.AceObjHEAD)
+			return
+		}
 		listMultipart := q.Has(s3.QparamMptUploads)
 		if len(apiItems) == 1 && !listMultipart {
 			_, versioning := q[s3.QparamVersioning]
@@ -77,6 +89,26 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 				p.getBckVersioningS3(w, r, apiItems[0])
 				return
 			}
+			if q.Has(s3.QparamLifecycle) {
+				p.getBckLifecycleS3(w, r, apiItems[0])
+				return
+			}
+			if policy {
+				p.getBckPolicyS3(w, r, apiItems[0])
+				return
+			}
+			if acl {
+				p.getBckACLS3(w, r, apiItems[0])
+				return
+			}
+			if cors {
+				p.getBckCORSS3(w, r, apiItems[0])
+				return
+			}
+			if q.Has(s3.QparamVersions) {
+				p.listObjVersS3(w, r, apiItems[0], q)
+				return
+			}
 			// only bucket name - list objects in the bucket
 			p.listObjectsS3(w, r, apiItems[0])
 			return
@@ -88,16 +120,40 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 			s3.WriteErr(w, r, errS3Req, 0)
 			return
 		}
+		q := r.URL.Query()
+		if q.Has(s3.QparamTagging) {
+			p.objTaggingS3(w, r, apiItems, apc.AcePUT) // (bucket tagging: not implemented)
+			return
+		}
 		if len(apiItems) == 1 {
-			q := r.URL.Query()
 			_, versioning := q[s3.QparamVersioning]
 			if versioning {
 				p.putBckVersioningS3(w, r, apiItems[0])
 				return
 			}
+			if q.Has(s3.QparamLifecycle) {
+				p.putBckLifecycleS3(w, r, apiItems[0])
+				return
+			}
+			if q.Has(s3.QparamPolicy) {
+				p.putBckPolicyS3(w, r, apiItems[0])
+				return
+			}
+			if q.Has(s3.QparamACL) {
+				p.putBckACLS3(w, r, apiItems[0])
+				return
+			}
+			if q.Has(s3.QparamCORS) {
+				p.putBckCORSS3(w, r, apiItems[0])
+				return
+			}
 			p.putBckS3(w, r, apiItems[0])
 			return
 		}
+		if q.Has(s3.QparamACL) {
+			p.unsupported(w, r, apiItems[0])
+			return
+		}
 		p.putObjS3(w, r, apiItems)
 	case http.MethodPost:
 		q := r.URL.Query()
@@ -119,13 +175,29 @@ func (p *proxy) s3Handler(w http.ResponseWriter, r *http.Request) {
 			s3.WriteErr(w, r, errS3Req, 0)
 			return
 		}
+		q := r.URL.Query()
+		if q.Has(s3.QparamTagging) {
+			p.objTaggingS3(w, r, apiItems, apc.AcePUT) // (bucket tagging: not implemented)
+			return
+		}
 		if len(apiItems) == 1 {
-			q := r.URL.Query()
 			_, multiple := q[s3.QparamMultiDelete]
 			if multiple {
 				p.delMultipleObjs(w, r, apiItems[0])
 				return
 			}
+			if q.Has(s3.QparamLifecycle) {
+				p.delBckLifecycleS3(w, r, apiItems[0])
+				return
+			}
+			if q.Has(s3.QparamPolicy) {
+				p.delBckPolicyS3(w, r, apiItems[0])
+				return
+			}
+			if q.Has(s3.QparamCORS) {
+				p.delBckCORSS3(w, r, apiItems[0])
+				return
+			}
 			p.delBckS3(w, r, apiItems[0])
 			return
 		}
@@ -154,6 +226,62 @@ func (p *proxy) bckNamesFromBMD(w http.ResponseWriter) {
 	sgl.Free()
 }
 
+// Validates SigV4 presigned GET, PUT, or HEAD request.
+// With AuthN enabled, S3 credentials are issued by AuthN on behalf of a user: access key is the
+// user ID, and session token is a (valid, non-revoked) token limited to a single bucket, object,
+// and method (see `authn.S3Scope`); the secret key is derived from the session token - see
+// `tok.S3SecretKey`. Regular (bearer) tokens are not accepted.
+// Otherwise (AuthN disabled), there is nothing to validate the signature with -
+// the request is anonymous, and only the URL's expiration is checked.
+func (p *proxy) validatePresignedS3(r *http.Request, q url.Values, items []string) (int, error) {
+	ps, err := cos.ParseS3Presigned(q)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	if len(items) == 0 {
+		return http.StatusMethodNotAllowed, fmt.Errorf("presigned %s requests to list buckets are not supported", r.Method)
+	}
+	var objName string
+	if len(items) > 1 {
+		objName = s3.ObjName(items)
+	}
+	ace, err := tok.S3Ace(r.Method, objName)
+	if err != nil {
+		return http.StatusMethodNotAllowed, err
+	}
+	config := cmn.GCO.Get()
+	if !config.Auth.Enabled {
+		if err := ps.CheckExpired(time.Now()); err != nil {
+			return http.StatusForbidden, err
+		}
+		return 0, nil
+	}
+	if ps.Token == "" {
+		return http.StatusUnauthorized, fmt.Errorf("%v (missing %s)", tok.ErrNoToken, cos.S3QparamSecurityToken)
+	}
+	tk, err := p.authn.validateToken(ps.Token)
+	if err != nil {
+		return http.StatusUnauthorized, err
+	}
+	if tk.UserID != ps.AccessKey {
+		return http.StatusForbidden, fmt.Errorf("access key %q does not match session token (%s)", ps.AccessKey, tk)
+	}
+	if err := ps.Verify(r, tok.S3SecretKey(ps.Token, config.Auth.Secret), time.Now()); err != nil {
+		return http.StatusForbidden, err
+	}
+	bck, err, errCode := meta.InitByNameOnly(items[0], p.owner.bmd)
+	if err != nil {
+		return errCode, err
+	}
+	if err := tk.CheckS3Scope(r.Method, bck.Bucket(), objName); err != nil {
+		return http.StatusForbidden, err
+	}
+	if err := tk.CheckPermissions(p.owner.smap.get().UUID, bck.Bucket(), ace); err != nil {
+		return http.StatusForbidden, err
+	}
+	return 0, nil
+}
+
 // PUT /s3/<bucket-name> (i.e., create bucket)
 func (p *proxy) putBckS3(w http.ResponseWriter, r *http.Request, bucket string) {
 	msg := apc.ActMsg{Action: apc.ActCreateBck}
@@ -357,16 +485,20 @@ func (p *proxy) putObjS3(w http.ResponseWriter, r *http.Request, items []string)
 
 // PUT /s3/<bucket-name>/<object-name> - with HeaderObjSrc in the request header
 // (compare with p.directPutObjS3)
+// Both CopyObject and UploadPartCopy get redirected to the destination's HRW target
+// that, in turn, reads the source - see `t.openCopySrc`
 func (p *proxy) copyObjS3(w http.ResponseWriter, r *http.Request, items []string) {
-	src := r.Header.Get(cos.S3HdrObjSrc)
-	src = strings.Trim(src, "/")
-	parts := strings.SplitN(src, "/", 2)
-	if len(parts) < 2 {
+	if len(items) < 2 {
 		s3.WriteErr(w, r, errS3Obj, 0)
 		return
 	}
+	bucket, objSrc, _, err := s3.ParseCopySource(r.Header.Get(cos.S3HdrObjSrc))
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
 	// src
-	bckSrc, err, errCode := meta.InitByNameOnly(parts[0], p.owner.bmd)
+	bckSrc, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
 	if err != nil {
 		s3.WriteErr(w, r, err, errCode)
 		return
@@ -389,14 +521,14 @@ func (p *proxy) copyObjS3(w http.ResponseWriter, r *http.Request, items []string
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
-	objName := strings.Trim(parts[1], "/")
-	si, err = cluster.HrwTarget(bckSrc.MakeUname(objName), &smap.Smap)
+	objName := s3.ObjName(items)
+	si, err = cluster.HrwTarget(bckDst.MakeUname(objName), &smap.Smap)
 	if err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
 	if cmn.FastV(4, cos.SmoduleAIS) {
-		glog.Infof("COPY: %s %s => %s/%v %s", r.Method, bckSrc.Cname(objName), bckDst.Cname(""), items, si)
+		glog.Infof("COPY: %s %s => %s %s", r.Method, bckSrc.Cname(objSrc), bckDst.Cname(objName), si)
 	}
 	started := time.Now()
 	redirectURL := p.redirectURL(r, si, started, cmn.NetIntraData)
@@ -420,6 +552,10 @@ func (p *proxy) directPutObjS3(w http.ResponseWriter, r *http.Request, items []s
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
+	if errCode, err := p.bypassGovS3(r, bck, apc.AcePUT); err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
 	if len(items) < 2 {
 		s3.WriteErr(w, r, errS3Obj, 0)
 		return
@@ -438,6 +574,23 @@ func (p *proxy) directPutObjS3(w http.ResponseWriter, r *http.Request, items []s
 	p.s3Redirect(w, r, si, redirectURL, bck.Name)
 }
 
+// object lock: S3 request to bypass governance-mode retention must be authorized by the
+// caller's AuthN token (see bypassGovAce); presigned requests are rejected - the
+// header is not covered by the signature, and the URL's permissions do not include it
+func (p *proxy) bypassGovS3(r *http.Request, bck *meta.Bck, ace apc.AccessAttrs) (int, error) {
+	perms := bypassGovAce(r.Header, ace)
+	if perms == ace {
+		return 0, nil
+	}
+	if cos.IsS3Presigned(r.URL.Query()) {
+		return http.StatusForbidden, fmt.Errorf("%s: presigned request cannot bypass governance-mode retention", bck)
+	}
+	if err := p.access(r.Header, bck, perms); err != nil {
+		return aceErrToCode(err), err
+	}
+	return 0, nil
+}
+
 // GET /s3/<bucket-name>/<object-name>
 func (p *proxy) getObjS3(w http.ResponseWriter, r *http.Request, items []string, q url.Values, listMultipart bool) {
 	bucket := items[0]
@@ -523,6 +676,55 @@ func (p *proxy) listMultipart(w http.ResponseWriter, r *http.Request, bck *meta.
 	sgl.Free()
 }
 
+// GET /s3/<bucket-name>?versions
+// (ais:// buckets only - see `versioning.retain`)
+func (p *proxy) listObjVersS3(w http.ResponseWriter, r *http.Request, bucket string, q url.Values) {
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if err := bck.Allow(apc.AceObjLIST); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	if !bck.IsAIS() {
+		p.unsupported(w, r, bucket)
+		return
+	}
+	// bcast & merge
+	var (
+		all  = s3.NewListVersionsResult(bck.Name, q)
+		smap = p.owner.smap.get()
+	)
+	for _, si := range smap.Tmap {
+		cargs := allocCargs()
+		cargs.si = si
+		cargs.req = cmn.HreqArgs{Method: http.MethodGet, Base: si.URL(cmn.NetPublic), Path: r.URL.Path, Query: q}
+		res := p.call(cargs)
+		b, err := res.bytes, res.err
+		freeCargs(cargs)
+		freeCR(res)
+		if err != nil {
+			s3.WriteErr(w, r, err, 0)
+			return
+		}
+		results := &s3.ListVersionsResult{}
+		if err := xml.Unmarshal(b, results); err != nil {
+			s3.WriteErr(w, r, err, 0)
+			return
+		}
+		all.Merge(results)
+	}
+	all.Finalize()
+
+	sgl := p.gmm.NewSGL(0)
+	all.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo(w)
+	sgl.Free()
+}
+
 // HEAD /s3/<bucket-name>/<object-name>
 func (p *proxy) headObjS3(w http.ResponseWriter, r *http.Request, items []string) {
 	if len(items) < 2 {
@@ -568,6 +770,10 @@ func (p *proxy) delObjS3(w http.ResponseWriter, r *http.Request, items []string)
 		s3.WriteErr(w, r, err, http.StatusForbidden)
 		return
 	}
+	if errCode, err := p.bypassGovS3(r, bck, apc.AceObjDELETE); err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
 	if len(items) < 2 {
 		s3.WriteErr(w, r, errS3Obj, 0)
 		return
@@ -586,6 +792,36 @@ func (p *proxy) delObjS3(w http.ResponseWriter, r *http.Request, items []string)
 	p.s3Redirect(w, r, si, redirectURL, bck.Name)
 }
 
+// [GET|PUT|DELETE] /s3/<bucket-name>/<object-name>?tagging
+// (bucket tagging is not supported)
+func (p *proxy) objTaggingS3(w http.ResponseWriter, r *http.Request, items []string, ace apc.AccessAttrs) {
+	if len(items) < 2 {
+		p.unsupported(w, r, items[0])
+		return
+	}
+	bck, err, errCode := meta.InitByNameOnly(items[0], p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if err := bck.Allow(ace); err != nil {
+		s3.WriteErr(w, r, err, http.StatusForbidden)
+		return
+	}
+	objName := s3.ObjName(items)
+	smap := p.owner.smap.get()
+	si, err := cluster.HrwTarget(bck.MakeUname(objName), &smap.Smap)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	if cmn.FastV(4, cos.SmoduleAIS) {
+		glog.Infof("%s %s?%s => %s", r.Method, bck.Cname(objName), s3.QparamTagging, si)
+	}
+	redirectURL := p.redirectURL(r, si, time.Now() /*started*/, cmn.NetIntraControl)
+	p.s3Redirect(w, r, si, redirectURL, bck.Name)
+}
+
 // GET /s3/<bucket-name>?versioning
 func (p *proxy) getBckVersioningS3(w http.ResponseWriter, r *http.Request, bucket string) {
 	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
@@ -601,7 +837,8 @@ func (p *proxy) getBckVersioningS3(w http.ResponseWriter, r *http.Request, bucke
 	sgl.Free()
 }
 
-// GET /s3/<bucket-name>?lifecycle|cors|policy|acl
+// [GET|PUT] /s3/<bucket-name>/<object-name>?acl|cors
+// [GET|PUT|DELETE] /s3/<bucket-name>?tagging
 func (p *proxy) unsupported(w http.ResponseWriter, r *http.Request, bucket string) {
 	if _, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd); err != nil {
 		s3.WriteErr(w, r, err, errCode)
@@ -641,3 +878,292 @@ func (p *proxy) putBckVersioningS3(w http.ResponseWriter, r *http.Request, bucke
 		s3.WriteErr(w, r, err, 0)
 	}
 }
+
+// GET /s3/<bucket-name>?lifecycle
+func (p *proxy) getBckLifecycleS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if len(bck.Props.Lifecycle.Rules) == 0 {
+		s3.WriteErr(w, r, s3.NewErrNoSuchConfig(s3.NoSuchLifecycle, bucket), http.StatusNotFound)
+		return
+	}
+	resp := s3.NewLifecycleConfiguration(&bck.Props.Lifecycle)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>?lifecycle
+func (p *proxy) putBckLifecycleS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	decoder := xml.NewDecoder(r.Body)
+	lconf := &s3.LifecycleConfiguration{}
+	if err := decoder.Decode(lconf); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	rules, err := lconf.ToProps()
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	p.setBckLifecycleS3(w, r, msg, bck, rules)
+}
+
+// DELETE /s3/<bucket-name>?lifecycle
+func (p *proxy) delBckLifecycleS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if p.setBckLifecycleS3(w, r, msg, bck, nil) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (p *proxy) setBckLifecycleS3(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg, bck *meta.Bck,
+	rules []cmn.LifecycleRule) bool {
+	propsToUpdate := cmn.BucketPropsToUpdate{
+		Lifecycle: &cmn.LifecycleConfToUpdate{Rules: &rules},
+	}
+	// make and validate new props
+	nprops, err := p.makeNewBckProps(bck, &propsToUpdate)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return false
+	}
+	if _, err := p.setBucketProps(msg, bck, nprops); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return false
+	}
+	return true
+}
+
+// GET /s3/<bucket-name>?policy
+func (p *proxy) getBckPolicyS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return
+	}
+	if bck.Props.Access == apc.AccessAll {
+		s3.WriteErr(w, r, s3.NewErrNoSuchConfig(s3.NoSuchBucketPolicy, bucket), http.StatusNotFound)
+		return
+	}
+	resp := s3.NewBucketPolicy(bucket, bck.Props.Access)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentJSON)
+	sgl.WriteTo(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>?policy
+func (p *proxy) putBckPolicyS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	policy := &s3.BucketPolicy{}
+	if err := jsoniter.NewDecoder(r.Body).Decode(policy); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	access, err := policy.ToAccess(bucket)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	if p.setBckAccessS3(w, r, msg, bck, access) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// DELETE /s3/<bucket-name>?policy
+func (p *proxy) delBckPolicyS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if p.setBckAccessS3(w, r, msg, bck, apc.AccessAll) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// GET /s3/<bucket-name>?acl
+func (p *proxy) getBckACLS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if err := p.access(r.Header, bck, apc.AceBckHEAD); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return
+	}
+	resp := s3.NewAccessControlPolicy(bck.Props.Access)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>?acl
+// (either canned ACL via `x-amz-acl` header or AccessControlPolicy in the request body)
+func (p *proxy) putBckACLS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	var access apc.AccessAttrs
+	if canned := r.Header.Get(cos.S3HdrACL); canned != "" {
+		access, err = s3.CannedACLToAccess(canned)
+	} else {
+		acp := &s3.AccessControlPolicy{}
+		if err = xml.NewDecoder(r.Body).Decode(acp); err == nil {
+			access, err = acp.ToAccess()
+		}
+	}
+	if err != nil {
+		if _, ok := err.(*cmn.ErrNotImpl); ok {
+			errCode = http.StatusNotImplemented
+		}
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	p.setBckAccessS3(w, r, msg, bck, access)
+}
+
+func (p *proxy) setBckAccessS3(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg, bck *meta.Bck,
+	access apc.AccessAttrs) bool {
+	if err := p.access(r.Header, bck, apc.AceBckSetACL); err != nil {
+		s3.WriteErr(w, r, err, aceErrToCode(err))
+		return false
+	}
+	propsToUpdate := cmn.BucketPropsToUpdate{Access: &access}
+	nprops, err := p.makeNewBckProps(bck, &propsToUpdate)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return false
+	}
+	if _, err := p.setBucketProps(msg, bck, nprops); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return false
+	}
+	return true
+}
+
+// GET /s3/<bucket-name>?cors
+func (p *proxy) getBckCORSS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if len(bck.Props.CORS.Rules) == 0 {
+		s3.WriteErr(w, r, s3.NewErrNoSuchConfig(s3.NoSuchCORSConfiguration, bucket), http.StatusNotFound)
+		return
+	}
+	resp := s3.NewCORSConfiguration(&bck.Props.CORS)
+	sgl := p.gmm.NewSGL(0)
+	resp.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>?cors
+func (p *proxy) putBckCORSS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	decoder := xml.NewDecoder(r.Body)
+	cconf := &s3.CORSConfiguration{}
+	if err := decoder.Decode(cconf); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	rules, err := cconf.ToProps()
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	p.setBckCORSS3(w, r, msg, bck, rules)
+}
+
+// DELETE /s3/<bucket-name>?cors
+func (p *proxy) delBckCORSS3(w http.ResponseWriter, r *http.Request, bucket string) {
+	msg := &apc.ActMsg{Action: apc.ActSetBprops}
+	if p.forwardCP(w, r, nil, msg.Action+"-"+bucket) {
+		return
+	}
+	bck, err, errCode := meta.InitByNameOnly(bucket, p.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if p.setBckCORSS3(w, r, msg, bck, nil) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (p *proxy) setBckCORSS3(w http.ResponseWriter, r *http.Request, msg *apc.ActMsg, bck *meta.Bck,
+	rules []cmn.CORSRule) bool {
+	propsToUpdate := cmn.BucketPropsToUpdate{
+		CORS: &cmn.CORSConfToUpdate{Rules: &rules},
+	}
+	nprops, err := p.makeNewBckProps(bck, &propsToUpdate)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return false
+	}
+	if _, err := p.setBucketProps(msg, bck, nprops); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return false
+	}
+	return true
+}
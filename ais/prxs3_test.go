@@ -0,0 +1,130 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/api/authn"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmd/authn/tok"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// bucket tagging is not implemented and must not fall through to (create|destroy) bucket
+func TestS3BucketTagging(tst *testing.T) {
+	var (
+		p     = newDiscoverServerPrimary()
+		bmd   = newBucketMD()
+		bck   = meta.NewBck("abc", apc.AIS, cmn.NsGlobal)
+		owner = newBMDOwnerPrx(cmn.GCO.Get())
+	)
+	bmd.add(bck, &cmn.BucketProps{})
+	owner.put(bmd)
+	p.owner.bmd = owner
+
+	for _, method := range []string{http.MethodGet, http.MethodPut, http.MethodDelete} {
+		r := httptest.NewRequest(method, "/s3/abc?tagging", http.NoBody)
+		w := httptest.NewRecorder()
+		p.s3Handler(w, r)
+		tassert.Errorf(tst, w.Code == http.StatusNotImplemented, "%s: expected status %d, got %d",
+			method, http.StatusNotImplemented, w.Code)
+		_, present := p.owner.bmd.get().Get(bck)
+		tassert.Fatalf(tst, present, "%s: bucket %s is gone", method, bck)
+	}
+}
+
+// bypassing governance-mode retention requires the caller's token (and permissions)
+func TestS3BypassGovernance(tst *testing.T) {
+	var (
+		p     = newDiscoverServerPrimary()
+		bmd   = newBucketMD()
+		bck   = meta.NewBck("abc", apc.AIS, cmn.NsGlobal)
+		owner = newBMDOwnerPrx(cmn.GCO.Get())
+	)
+	bmd.add(bck, &cmn.BucketProps{Access: apc.AccessAll})
+	owner.put(bmd)
+	p.owner.bmd = owner
+
+	config := cmn.GCO.BeginUpdate()
+	config.Auth.Enabled = true
+	cmn.GCO.CommitUpdate(config)
+	defer func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Auth.Enabled = false
+		cmn.GCO.CommitUpdate(config)
+	}()
+
+	for _, method := range []string{http.MethodPut, http.MethodDelete} {
+		r := httptest.NewRequest(method, "/s3/abc/obj", http.NoBody)
+		r.Header.Set(cos.S3HdrBypassGovernance, "true")
+		w := httptest.NewRecorder()
+		p.s3Handler(w, r)
+		tassert.Errorf(tst, w.Code == http.StatusUnauthorized, "%s: expected status %d, got %d",
+			method, http.StatusUnauthorized, w.Code)
+	}
+}
+
+// presigned URL must carry S3 session token limited to the request (and not the user's bearer token)
+func TestS3PresignedScope(tst *testing.T) {
+	const secret = "s3-presign-test-secret"
+	var (
+		p       = newDiscoverServerPrimary()
+		bmd     = newBucketMD()
+		bck     = meta.NewBck("abc", apc.AIS, cmn.NsGlobal)
+		owner   = newBMDOwnerPrx(cmn.GCO.Get())
+		expires = time.Now().Add(time.Hour)
+		cluID   = p.owner.smap.get().UUID
+		acls    = []*authn.BckACL{{Bck: cmn.Bck{Name: "abc", Provider: apc.AIS, Ns: cmn.Ns{UUID: cluID}}, Access: apc.AccessRW}}
+	)
+	bmd.add(bck, &cmn.BucketProps{Access: apc.AccessAll})
+	owner.put(bmd)
+	p.owner.bmd = owner
+	p.authn = newAuthManager()
+
+	config := cmn.GCO.BeginUpdate()
+	config.Auth.Enabled = true
+	config.Auth.Secret = secret
+	cmn.GCO.CommitUpdate(config)
+	defer func() {
+		config := cmn.GCO.BeginUpdate()
+		config.Auth.Enabled = false
+		config.Auth.Secret = ""
+		cmn.GCO.CommitUpdate(config)
+	}()
+
+	bearer, err := tok.IssueJWT(expires, "user", acls, nil, secret)
+	tassert.CheckFatal(tst, err)
+	scope := &authn.S3Scope{Bck: *bck.Bucket(), ObjName: "obj", Method: http.MethodGet}
+	session, err := tok.IssueS3JWT(expires, "user", scope, acls, secret)
+	tassert.CheckFatal(tst, err)
+
+	tests := []struct {
+		method string
+		token  string
+		status int
+	}{
+		{http.MethodGet, session, 0},
+		{http.MethodGet, bearer, http.StatusForbidden},  // not a session token
+		{http.MethodPut, session, http.StatusForbidden}, // out of scope
+		{http.MethodHead, session, http.StatusForbidden},
+	}
+	for _, test := range tests {
+		u := &url.URL{Scheme: "http", Host: "localhost:8080", Path: "/s3/abc/obj"}
+		err := cos.PresignS3(test.method, u, "ais", "user", tok.S3SecretKey(test.token, secret), test.token,
+			time.Minute, time.Now())
+		tassert.CheckFatal(tst, err)
+		r := httptest.NewRequest(test.method, u.String(), http.NoBody)
+		status, err := p.validatePresignedS3(r, r.URL.Query(), []string{"abc", "obj"})
+		tassert.Errorf(tst, status == test.status, "%s: expected status %d, got %d(%v)", test.method, test.status, status, err)
+	}
+}
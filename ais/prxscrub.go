@@ -0,0 +1,91 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/xact"
+)
+
+// Periodic storage scrub (see cmn.ScrubConf and xs.XactScrub) is scheduled by the primary:
+// all targets run the same (single-ID) xaction that is tracked by IC, same as upon `ais storage scrub`.
+// The time of the last scheduled scrub is persisted (fname.ScrubLast) to survive restarts;
+// a newly elected primary that has no record of it starts counting from the moment of election.
+
+// how often to check whether it's time to scrub
+const scrubCheckIval = 10 * time.Minute
+
+func (p *proxy) regScrubHK() {
+	hk.Reg(apc.ActStoreScrub+hk.NameSuffix, p.scrubHK, scrubCheckIval)
+}
+
+func (p *proxy) scrubHK() time.Duration {
+	config := cmn.GCO.Get()
+	ival := config.Scrub.Interval.D()
+	if ival == 0 {
+		return scrubCheckIval
+	}
+	smap := p.owner.smap.get()
+	if !smap.isPrimary(p.si) || daemon.stopping.Load() {
+		return scrubCheckIval
+	}
+	if err := p.pready(smap); err != nil {
+		return scrubCheckIval
+	}
+	last := readScrubLast(config)
+	if last.IsZero() {
+		writeScrubLast(config, time.Now())
+		return scrubCheckIval
+	}
+	if elapsed := time.Since(last); elapsed < ival {
+		return cos.MinDuration(ival-elapsed, scrubCheckIval)
+	}
+	onl := true
+	if nl := p.notifs.find(nlFilter{Kind: apc.ActStoreScrub, OnlyRunning: &onl}); nl != nil {
+		return scrubCheckIval // (e.g., long all-bucket run or `ais storage scrub`)
+	}
+	xargs := xact.ArgsMsg{ID: cos.GenUUID(), Kind: apc.ActStoreScrub}
+	if err := p._xstart(&xargs); err != nil {
+		// not updating the last time - will retry
+		glog.Errorf("%s: failed to start scheduled scrub: %v", p, err)
+		return scrubCheckIval
+	}
+	glog.Infof("%s: started scheduled scrub %s", p, xargs.ID)
+	writeScrubLast(config, time.Now())
+	return scrubCheckIval
+}
+
+func readScrubLast(config *cmn.Config) (last time.Time) {
+	b, err := os.ReadFile(filepath.Join(config.ConfigDir, fname.ScrubLast))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			glog.Error(err)
+		}
+		return
+	}
+	if ns, err := strconv.ParseInt(string(b), 10, 64); err == nil {
+		last = time.Unix(0, ns)
+	} else {
+		glog.Error(err)
+	}
+	return
+}
+
+func writeScrubLast(config *cmn.Config, last time.Time) {
+	b := []byte(strconv.FormatInt(last.UnixNano(), 10))
+	if err := os.WriteFile(filepath.Join(config.ConfigDir, fname.ScrubLast), b, cos.PermRWR); err != nil {
+		glog.Error(err)
+	}
+}
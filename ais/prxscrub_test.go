@@ -0,0 +1,67 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/NVIDIA/aistore/xact"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// scrub already running on one of the targets: must not be registered with IC
+// under the new ID, and must be stopped where it did start
+func TestScrubAlreadyRunning(tst *testing.T) {
+	var (
+		p    = newDiscoverServerPrimary()
+		smap = newSmap()
+		mu   sync.Mutex
+		acts = make(map[string][]string, 2)
+	)
+	for _, tid := range []string{"t1", "t2"} {
+		tid := tid
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			msg := &apc.ActMsg{}
+			if err := jsoniter.NewDecoder(r.Body).Decode(msg); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			mu.Lock()
+			acts[tid] = append(acts[tid], msg.Action)
+			mu.Unlock()
+			if tid == "t2" && msg.Action == apc.ActXactStart {
+				err := cmn.NewErrXactUsePrev("x-" + apc.ActStoreScrub + "[running]")
+				http.Error(w, err.Error(), http.StatusConflict)
+			}
+		}))
+		defer server.Close()
+		info := serverTCPAddr(server.URL)
+		smap.addTarget(meta.NewSnode(tid, apc.Target, info, info, info))
+	}
+	smap.Primary = p.si
+	smap.addProxy(p.si)
+	smap.Version = 1
+	p.owner.smap.put(smap)
+	p.notifs.nls, p.notifs.fin = newListeners(), newListeners()
+
+	xargs := xact.ArgsMsg{ID: cos.GenUUID(), Kind: apc.ActStoreScrub}
+	err := p._xstart(&xargs)
+	tassert.Fatalf(tst, err != nil, "expected error (scrub already running)")
+	tassert.Errorf(tst, p.notifs.entry(xargs.ID) == nil, "%s must not be registered with IC", xargs.ID)
+
+	mu.Lock()
+	defer mu.Unlock()
+	t1 := acts["t1"]
+	tassert.Errorf(tst, len(t1) == 2 && t1[0] == apc.ActXactStart && t1[1] == apc.ActXactStop,
+		"t1: expected start and stop, got %v", t1)
+}
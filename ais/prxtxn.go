@@ -403,6 +403,9 @@ func (p *proxy) setBucketProps(msg *apc.ActMsg, bck *meta.Bck, nprops *cmn.Bucke
 		action := apc.ActMakeNCopies
 		if ctx.needReEC {
 			action = apc.ActECEncode
+			if _reEncode(bprops, nprops) {
+				action = apc.ActECReencode
+			}
 		}
 		nl := xact.NewXactNL(c.uuid, action, &c.smap.Smap, nil, bck.Bucket())
 		nl.SetOwner(equalIC)
@@ -742,7 +745,7 @@ func (p *proxy) ecEncode(bck *meta.Bck, msg *apc.ActMsg) (xid string, err error)
 		return
 	}
 	if props.EC.Enabled {
-		// Changing data or parity slice count on the fly is unsupported yet
+		// changing data or parity slice count: set-bucket-props (with `Force`) => ec-reencode
 		err = fmt.Errorf("%s: EC is already enabled for bucket %s", p, bck)
 		return
 	}
@@ -1051,8 +1054,10 @@ func (p *proxy) makeNewBckProps(bck *meta.Bck, propsToUpdate *cmn.BucketPropsToU
 	if bprops.EC.Enabled && nprops.EC.Enabled {
 		sameSlices := bprops.EC.DataSlices == nprops.EC.DataSlices && bprops.EC.ParitySlices == nprops.EC.ParitySlices
 		sameLimit := bprops.EC.ObjSizeLimit == nprops.EC.ObjSizeLimit
-		if !sameSlices || (!sameLimit && !propsToUpdate.Force) {
-			err = fmt.Errorf("%s: once enabled, EC configuration can be only disabled but cannot change", p.si)
+		// NOTE: changing the number of slices triggers re-encoding of the entire bucket (see `_reEncode`)
+		if (!sameSlices || !sameLimit) && !propsToUpdate.Force {
+			err = fmt.Errorf("%s: once enabled, EC configuration can be only disabled but cannot change"+
+				" (hint: use force to override and re-encode %s)", p.si, bck)
 			return
 		}
 	} else if nprops.EC.Enabled {
@@ -1063,6 +1068,21 @@ func (p *proxy) makeNewBckProps(bck *meta.Bck, propsToUpdate *cmn.BucketPropsToU
 			nprops.EC.ParitySlices = 1
 		}
 	}
+	if bprops.ObjLock.Mode == cmn.ObjLockCompliance && nprops.ObjLock.Mode != cmn.ObjLockCompliance {
+		err = fmt.Errorf("%s: %s: compliance-mode object lock cannot be disabled or changed", p.si, bck)
+		return
+	}
+	if wp := propsToUpdate.WritePolicy; wp != nil && wp.Data != nil && *wp.Data == apc.WriteDelayed {
+		if bck.IsAIS() && nprops.BackendBck.IsEmpty() {
+			err = fmt.Errorf("%s: %s: write-back (data write policy %q) requires remote backend",
+				p.si, bck, apc.WriteDelayed)
+			return
+		}
+	}
+	if nprops.Replication.Enabled() && !bck.IsAIS() {
+		err = fmt.Errorf("%s: %s: only ais:// buckets can be replicated to remote AIS clusters", p.si, bck)
+		return
+	}
 	if !bprops.Mirror.Enabled && nprops.Mirror.Enabled {
 		if nprops.Mirror.Copies == 1 {
 			nprops.Mirror.Copies = cos.MaxI64(cfg.Mirror.Copies, 2)
@@ -1088,9 +1108,56 @@ func (p *proxy) makeNewBckProps(bck *meta.Bck, propsToUpdate *cmn.BucketPropsToU
 		glog.Warningf("Ignoring soft error: %v", err)
 		err = nil
 	}
+	// (when creating, validated by the caller)
+	if err == nil && len(creating) == 0 && bck.Provider == apc.AWS {
+		ba, na := &bprops.Extra.AWS, &nprops.Extra.AWS
+		if ba.Endpoint != na.Endpoint || ba.Profile != na.Profile {
+			if errH := p.headRemoteBckAll(bck.Bucket(), s3HeadQuery(na)); errH != nil {
+				err = fmt.Errorf("%s: failed to access %s using endpoint %q and credentials profile %q: %v",
+					p.si, bck, na.Endpoint, na.Profile, errH)
+			}
+		}
+	}
+	// replication destination must exist (and be accessible)
+	if err == nil && nprops.Replication.Enabled() && nprops.Replication.Dst != bprops.Replication.Dst {
+		dst, _ := nprops.Replication.DstBck() // (validated above)
+		if _, _, errH := p.headRemoteBck(&dst, nil); errH != nil {
+			err = fmt.Errorf("%s: %s: failed to access replication destination %s: %v", p.si, bck, dst.Cname(""), errH)
+		}
+	}
 	return
 }
 
+// HEAD S3 bucket using the specified endpoint and credentials profile (see target's s3HeadBck)
+func s3HeadQuery(extra *cmn.ExtraPropsAWS) url.Values {
+	q := make(url.Values, 2)
+	q.Set(apc.QparamS3Endpoint, extra.Endpoint)
+	q.Set(apc.QparamS3Profile, extra.Profile)
+	return q
+}
+
+// ditto, at creation time (nil when props-to-update specify neither)
+func s3CreateQuery(value any) url.Values {
+	var (
+		extra         cmn.ExtraPropsAWS
+		propsToUpdate cmn.BucketPropsToUpdate
+	)
+	if err := cos.MorphMarshal(value, &propsToUpdate); err != nil || propsToUpdate.Extra == nil {
+		return nil // (invalid props will fail later)
+	}
+	upd := propsToUpdate.Extra.AWS
+	if upd == nil || (upd.Endpoint == nil && upd.Profile == nil) {
+		return nil
+	}
+	if upd.Endpoint != nil {
+		extra.Endpoint = *upd.Endpoint
+	}
+	if upd.Profile != nil {
+		extra.Profile = *upd.Profile
+	}
+	return s3HeadQuery(&extra)
+}
+
 func _versioning(v bool) string {
 	if v {
 		return "enabled"
@@ -0,0 +1,150 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Bucket ACL: S3 grants to AllUsers (and AuthenticatedUsers) groups translated into
+// (and from) bucket access attributes (`apc.AccessAttrs`).
+// Note that AIS bucket permissions apply to all users, and there's no notion of
+// bucket owner - per-user permissions are managed by AuthN.
+// Therefore:
+// - grants to individual users (including the owner) are ignored;
+// - "private" (owner-only) ACL - canned or, equivalently, the one that has no group grants -
+//   is not supported: without the notion of owner, the closest AIS equivalent would be
+//   denying access to everyone (see apc.AccessNone).
+// https://docs.aws.amazon.com/AmazonS3/latest/userguide/acl-overview.html
+
+const (
+	aclGroupAllUsers  = "http://acs.amazonaws.com/groups/global/AllUsers"
+	aclGroupAuthUsers = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+	aclXsiNs          = "http://www.w3.org/2001/XMLSchema-instance"
+
+	aclRead        = "READ"
+	aclWrite       = "WRITE"
+	aclReadACP     = "READ_ACP"
+	aclWriteACP    = "WRITE_ACP"
+	aclFullControl = "FULL_CONTROL"
+
+	aclWriteAccess = apc.AccessRW &^ apc.AccessRO
+)
+
+var errPrivateACL = cmn.NewErrNotImpl("set", "private ACL (owner-only access)")
+
+type (
+	AccessControlPolicy struct {
+		XMLName xml.Name `xml:"AccessControlPolicy"`
+		Ns      string   `xml:"xmlns,attr,omitempty"`
+		Owner   BckOwner `xml:"Owner"`
+		Grants  []Grant  `xml:"AccessControlList>Grant"`
+	}
+	Grant struct {
+		Grantee    Grantee `xml:"Grantee"`
+		Permission string  `xml:"Permission"`
+	}
+	Grantee struct {
+		XsiNs       string `xml:"xmlns:xsi,attr,omitempty"`
+		XsiType     string `xml:"xsi:type,attr,omitempty"`
+		ID          string `xml:"ID,omitempty"`
+		DisplayName string `xml:"DisplayName,omitempty"`
+		URI         string `xml:"URI,omitempty"`
+	}
+)
+
+// canned ACL (`x-amz-acl` header) => bucket access
+func CannedACLToAccess(canned string) (apc.AccessAttrs, error) {
+	switch canned {
+	case "private":
+		return 0, errPrivateACL
+	case "public-read", "authenticated-read":
+		return apc.AccessRO, nil
+	case "public-read-write":
+		return apc.AccessRW, nil
+	default:
+		return 0, fmt.Errorf("canned ACL %q is not supported", canned)
+	}
+}
+
+// bucket access => ACL
+func NewAccessControlPolicy(access apc.AccessAttrs) *AccessControlPolicy {
+	owner := BckOwner{ID: "1", Name: AISServer}
+	acp := &AccessControlPolicy{Ns: s3Namespace, Owner: owner}
+	acp.Grants = append(acp.Grants, Grant{
+		Grantee:    Grantee{XsiNs: aclXsiNs, XsiType: "CanonicalUser", ID: owner.ID, DisplayName: owner.Name},
+		Permission: aclFullControl,
+	})
+	if access == apc.AccessAll {
+		acp.addGroupGrant(aclFullControl)
+		return acp
+	}
+	var read bool
+	if access.Has(apc.AccessRO) {
+		acp.addGroupGrant(aclRead)
+		read = true
+	}
+	if access.Has(aclWriteAccess) {
+		acp.addGroupGrant(aclWrite)
+	}
+	if access.Has(apc.AceBckHEAD) && !read {
+		acp.addGroupGrant(aclReadACP)
+	}
+	if access.Has(apc.AceBckSetACL) {
+		acp.addGroupGrant(aclWriteACP)
+	}
+	return acp
+}
+
+func (acp *AccessControlPolicy) addGroupGrant(perm string) {
+	acp.Grants = append(acp.Grants, Grant{
+		Grantee:    Grantee{XsiNs: aclXsiNs, XsiType: "Group", URI: aclGroupAllUsers},
+		Permission: perm,
+	})
+}
+
+func (acp *AccessControlPolicy) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(acp)
+	debug.AssertNoErr(err)
+}
+
+// ACL => bucket access
+func (acp *AccessControlPolicy) ToAccess() (access apc.AccessAttrs, err error) {
+	var group bool
+	for _, grant := range acp.Grants {
+		if grant.Grantee.URI == "" {
+			continue // individual user
+		}
+		group = true
+		if grant.Grantee.URI != aclGroupAllUsers && grant.Grantee.URI != aclGroupAuthUsers {
+			return 0, fmt.Errorf("grantee group %q is not supported", grant.Grantee.URI)
+		}
+		switch grant.Permission {
+		case aclRead:
+			access |= apc.AccessRO
+		case aclWrite:
+			access |= aclWriteAccess
+		case aclReadACP:
+			access |= apc.AceBckHEAD
+		case aclWriteACP:
+			access |= apc.AceBckSetACL
+		case aclFullControl:
+			access = apc.AccessAll
+		default:
+			return 0, fmt.Errorf("invalid ACL permission %q", grant.Permission)
+		}
+	}
+	if !group {
+		return 0, errPrivateACL
+	}
+	return access, nil
+}
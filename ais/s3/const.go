@@ -11,6 +11,7 @@ const (
 	QparamCORS              = "cors"
 	QparamPolicy            = "policy"
 	QparamACL               = "acl"
+	QparamTagging           = "tagging"
 	QparamMultiDelete       = "delete"
 	QparamMaxKeys           = "max-keys"
 	QparamPrefix            = "prefix"
@@ -18,6 +19,12 @@ const (
 	QparamStartAfter        = "start-after"
 	QparamDelimiter         = "delimiter"
 
+	// object versions
+	QparamVersions        = "versions"
+	QparamVersionID       = "versionId"
+	QparamKeyMarker       = "key-marker"
+	QparamVersionIDMarker = "version-id-marker"
+
 	// multipart
 	QparamMptUploads        = "uploads"
 	QparamMptUploadID       = "uploadId"
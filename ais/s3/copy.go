@@ -0,0 +1,139 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Server-side copy: CopyObject and UploadPartCopy.
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_CopyObject.html
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_UploadPartCopy.html
+
+// `x-amz-metadata-directive` and `x-amz-tagging-directive`
+const (
+	DirectiveCopy    = "COPY" // default
+	DirectiveReplace = "REPLACE"
+)
+
+var ErrCopyToSelf = errors.New("this copy request is illegal because it is trying to copy an object to itself " +
+	"without changing the object's metadata")
+
+type CopyPartResult struct {
+	LastModified string `xml:"LastModified"`
+	ETag         string `xml:"ETag"`
+}
+
+func (r *CopyPartResult) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+// parse `x-amz-copy-source` header: [/]<bucket>/<key>[?versionId=<version-id>] (URL-encoded)
+func ParseCopySource(src string) (bucket, objName, version string, err error) {
+	if src == "" {
+		err = fmt.Errorf("missing %s header", cos.S3HdrObjSrc)
+		return
+	}
+	if i := strings.IndexByte(src, '?'); i >= 0 {
+		var q url.Values
+		if q, err = url.ParseQuery(src[i+1:]); err != nil {
+			err = fmt.Errorf("invalid %s %q: %v", cos.S3HdrObjSrc, src, err)
+			return
+		}
+		version = q.Get(QparamVersionID)
+		src = src[:i]
+	}
+	if src, err = url.PathUnescape(src); err != nil {
+		err = fmt.Errorf("invalid %s: %v", cos.S3HdrObjSrc, err)
+		return
+	}
+	src = strings.TrimPrefix(src, "/") // in AWS examples the path starts with "/"
+	i := strings.IndexByte(src, '/')
+	if i <= 0 || i == len(src)-1 {
+		err = fmt.Errorf("invalid %s %q: expecting <bucket>/<key>", cos.S3HdrObjSrc, src)
+		return
+	}
+	bucket, objName = src[:i], src[i+1:]
+	return
+}
+
+// parse `x-amz-copy-source-range` header: bytes=<first>-<last> (both inclusive)
+func ParseCopyRange(hdr string, size int64) (offset, length int64, err error) {
+	const prefix = "bytes="
+	if !strings.HasPrefix(hdr, prefix) {
+		return 0, 0, fmt.Errorf("invalid %s %q: expecting bytes=<first>-<last>", cos.S3HdrObjSrcRange, hdr)
+	}
+	first, last, ok := strings.Cut(hdr[len(prefix):], "-")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid %s %q: expecting bytes=<first>-<last>", cos.S3HdrObjSrcRange, hdr)
+	}
+	offset, err = strconv.ParseInt(first, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid %s %q: %v", cos.S3HdrObjSrcRange, hdr, err)
+	}
+	end, err := strconv.ParseInt(last, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid %s %q: %v", cos.S3HdrObjSrcRange, hdr, err)
+	}
+	if offset < 0 || end < offset || end >= size {
+		return 0, 0, fmt.Errorf("%s %q is out of bounds (object size %d)", cos.S3HdrObjSrcRange, hdr, size)
+	}
+	return offset, end - offset + 1, nil
+}
+
+// returns true for REPLACE, false for COPY (default)
+func ParseDirective(hdr http.Header, name string) (replace bool, err error) {
+	switch v := hdr.Get(name); strings.ToUpper(v) {
+	case "", DirectiveCopy:
+		return false, nil
+	case DirectiveReplace:
+		return true, nil
+	default:
+		return false, fmt.Errorf("invalid %s %q (expecting %s or %s)", name, v, DirectiveCopy, DirectiveReplace)
+	}
+}
+
+//
+// user-defined metadata: `x-amz-meta-*` headers are stored as is (lowercased)
+// as object's custom metadata, along with `Content-Type`
+//
+
+func SetUserMD(oa *cmn.ObjAttrs, hdr http.Header) {
+	for k := range oa.CustomMD {
+		if strings.HasPrefix(k, cos.S3HdrUserMDPrefix) {
+			delete(oa.CustomMD, k)
+		}
+	}
+	oa.DelCustomKeys(cos.HdrContentType)
+	for k, vs := range hdr {
+		if k = strings.ToLower(k); strings.HasPrefix(k, cos.S3HdrUserMDPrefix) && len(k) > len(cos.S3HdrUserMDPrefix) {
+			oa.SetCustomKey(k, strings.Join(vs, ","))
+		}
+	}
+	if v := hdr.Get(cos.HdrContentType); v != "" {
+		oa.SetCustomKey(cos.HdrContentType, v)
+	}
+}
+
+func UserMDToHdr(oah cos.OAH, hdr http.Header) {
+	for k, v := range oah.GetCustomMD() {
+		if strings.HasPrefix(k, cos.S3HdrUserMDPrefix) {
+			hdr.Set(k, v)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+func TestParseCopySource(t *testing.T) {
+	tests := []struct {
+		src, bucket, objName, version string
+		fail                          bool
+	}{
+		{src: "/bck/dir/obj", bucket: "bck", objName: "dir/obj"},
+		{src: "bck/dir/obj%20name%2B1", bucket: "bck", objName: "dir/obj name+1"},
+		{src: "bck/obj?versionId=3", bucket: "bck", objName: "obj", version: "3"},
+		{src: "", fail: true},
+		{src: "/bck", fail: true},
+		{src: "/bck/", fail: true},
+		{src: "//obj", fail: true},
+	}
+	for _, test := range tests {
+		bucket, objName, version, err := ParseCopySource(test.src)
+		if test.fail {
+			if err == nil {
+				t.Errorf("%q: expected error", test.src)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: %v", test.src, err)
+			continue
+		}
+		if bucket != test.bucket || objName != test.objName || version != test.version {
+			t.Errorf("%q: got (%q, %q, %q)", test.src, bucket, objName, version)
+		}
+	}
+}
+
+func TestParseCopyRange(t *testing.T) {
+	tests := []struct {
+		hdr            string
+		offset, length int64
+		fail           bool
+	}{
+		{hdr: "bytes=0-9", offset: 0, length: 10},
+		{hdr: "bytes=10-99", offset: 10, length: 90},
+		{hdr: "bytes=99-99", offset: 99, length: 1},
+		{hdr: "bytes=10-100", fail: true}, // out of bounds
+		{hdr: "bytes=10-9", fail: true},
+		{hdr: "bytes=10-", fail: true},
+		{hdr: "bytes=-10", fail: true},
+		{hdr: "0-9", fail: true},
+	}
+	for _, test := range tests {
+		offset, length, err := ParseCopyRange(test.hdr, 100)
+		if test.fail {
+			if err == nil {
+				t.Errorf("%q: expected error", test.hdr)
+			}
+			continue
+		}
+		if err != nil || offset != test.offset || length != test.length {
+			t.Errorf("%q: got (%d, %d, %v)", test.hdr, offset, length, err)
+		}
+	}
+}
+
+func TestUserMD(t *testing.T) {
+	oa := &cmn.ObjAttrs{}
+	oa.SetCustomKey(cmn.ETag, "abc")
+	oa.SetCustomKey("x-amz-meta-stale", "x")
+	oa.SetTags(cos.StrKVs{"project": "blue"})
+
+	hdr := http.Header{}
+	hdr.Set("X-Amz-Meta-Color", "red")
+	hdr.Set(cos.HdrContentType, "text/plain")
+	hdr.Set(cos.S3HdrMetadataDirective, DirectiveReplace)
+	SetUserMD(oa, hdr)
+
+	md := oa.GetCustomMD()
+	if md["x-amz-meta-color"] != "red" || md[cos.HdrContentType] != "text/plain" {
+		t.Fatalf("unexpected user metadata: %v", md)
+	}
+	if _, ok := md["x-amz-meta-stale"]; ok {
+		t.Fatalf("expected previous user metadata to be replaced: %v", md)
+	}
+	if md[cmn.ETag] != "abc" || oa.GetTags()["project"] != "blue" {
+		t.Fatalf("expected system metadata and tags to be preserved: %v", md)
+	}
+
+	out := http.Header{}
+	UserMDToHdr(oa, out)
+	if len(out) != 1 || out.Get("x-amz-meta-color") != "red" {
+		t.Fatalf("unexpected response header: %v", out)
+	}
+
+	replace, err := ParseDirective(hdr, cos.S3HdrMetadataDirective)
+	if err != nil || !replace {
+		t.Fatalf("expected REPLACE, got %v, %v", replace, err)
+	}
+	if replace, err = ParseDirective(hdr, cos.S3HdrTaggingDirective); err != nil || replace {
+		t.Fatalf("expected (default) COPY, got %v, %v", replace, err)
+	}
+}
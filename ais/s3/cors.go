@@ -0,0 +1,161 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Bucket CORS configuration - see cmn.CORSConf
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketCors.html
+
+const NoSuchCORSConfiguration = "NoSuchCORSConfiguration" // error code
+
+const corsVary = cos.HdrOrigin + ", " + cos.HdrACRequestHeaders + ", " + cos.HdrACRequestMethod
+
+type (
+	CORSConfiguration struct {
+		XMLName xml.Name   `xml:"CORSConfiguration"`
+		Ns      string     `xml:"xmlns,attr,omitempty"`
+		Rules   []CORSRule `xml:"CORSRule"`
+	}
+	CORSRule struct {
+		ID             string   `xml:"ID,omitempty"`
+		AllowedHeaders []string `xml:"AllowedHeader"`
+		AllowedMethods []string `xml:"AllowedMethod"`
+		AllowedOrigins []string `xml:"AllowedOrigin"`
+		ExposeHeaders  []string `xml:"ExposeHeader"`
+		MaxAgeSeconds  int      `xml:"MaxAgeSeconds,omitempty"`
+	}
+)
+
+var (
+	errCORSNoOrigin   = errors.New("insufficient information: origin request header needed")
+	errCORSNoMethod   = errors.New("invalid access control request method")
+	errCORSNotAllowed = errors.New("CORSResponse: this CORS request is not allowed")
+)
+
+func NewCORSConfiguration(conf *cmn.CORSConf) *CORSConfiguration {
+	cc := &CORSConfiguration{Ns: s3Namespace, Rules: make([]CORSRule, 0, len(conf.Rules))}
+	for i := range conf.Rules {
+		in := &conf.Rules[i]
+		cc.Rules = append(cc.Rules, CORSRule{
+			ID:             in.ID,
+			AllowedHeaders: in.AllowedHeaders,
+			AllowedMethods: in.AllowedMethods,
+			AllowedOrigins: in.AllowedOrigins,
+			ExposeHeaders:  in.ExposeHeaders,
+			MaxAgeSeconds:  in.MaxAgeSeconds,
+		})
+	}
+	return cc
+}
+
+func (cc *CORSConfiguration) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(cc)
+	debug.AssertNoErr(err)
+}
+
+// convert to (native) bucket props; the latter get validated separately
+func (cc *CORSConfiguration) ToProps() ([]cmn.CORSRule, error) {
+	if len(cc.Rules) == 0 {
+		return nil, errors.New("CORS configuration must contain at least one rule")
+	}
+	rules := make([]cmn.CORSRule, 0, len(cc.Rules))
+	for i := range cc.Rules {
+		in := &cc.Rules[i]
+		rules = append(rules, cmn.CORSRule{
+			ID:             in.ID,
+			AllowedHeaders: in.AllowedHeaders,
+			AllowedMethods: in.AllowedMethods,
+			AllowedOrigins: in.AllowedOrigins,
+			ExposeHeaders:  in.ExposeHeaders,
+			MaxAgeSeconds:  in.MaxAgeSeconds,
+		})
+	}
+	return rules, nil
+}
+
+// HandleCORS is called by both proxy and target S3 handlers prior to anything else:
+//   - responds to preflight (OPTIONS) requests and returns true (request handled);
+//   - otherwise, sets `Access-Control-*` response headers if a given cross-origin
+//     request is allowed by the bucket's CORS rules.
+func HandleCORS(w http.ResponseWriter, r *http.Request, bucket string, bowner meta.Bowner) (handled bool) {
+	origin := r.Header.Get(cos.HdrOrigin)
+	preflight := r.Method == http.MethodOptions
+	if origin == "" {
+		if preflight {
+			WriteErr(w, r, errCORSNoOrigin, http.StatusBadRequest)
+		}
+		return preflight
+	}
+	bck, err, errCode := meta.InitByNameOnly(bucket, bowner)
+	if err != nil {
+		if preflight {
+			WriteErr(w, r, err, errCode)
+		}
+		return preflight
+	}
+	conf := &bck.Props.CORS
+	if !preflight {
+		if rule := conf.Match(origin, r.Method, nil); rule != nil {
+			setCORSHeaders(w.Header(), origin, rule)
+		}
+		return false
+	}
+
+	// preflight
+	method := r.Header.Get(cos.HdrACRequestMethod)
+	if method == "" {
+		WriteErr(w, r, errCORSNoMethod, http.StatusBadRequest)
+		return true
+	}
+	var reqHeaders []string
+	for _, h := range strings.Split(r.Header.Get(cos.HdrACRequestHeaders), ",") {
+		if h = strings.TrimSpace(h); h != "" {
+			reqHeaders = append(reqHeaders, h)
+		}
+	}
+	rule := conf.Match(origin, method, reqHeaders)
+	if rule == nil {
+		WriteErr(w, r, errCORSNotAllowed, http.StatusForbidden)
+		return true
+	}
+	hdr := w.Header()
+	setCORSHeaders(hdr, origin, rule)
+	hdr.Set(cos.HdrACAllowMethods, strings.Join(rule.AllowedMethods, ", "))
+	if len(reqHeaders) > 0 {
+		hdr.Set(cos.HdrACAllowHeaders, strings.Join(reqHeaders, ", "))
+	}
+	if rule.MaxAgeSeconds > 0 {
+		hdr.Set(cos.HdrACMaxAge, strconv.Itoa(rule.MaxAgeSeconds))
+	}
+	w.WriteHeader(http.StatusOK)
+	return true
+}
+
+func setCORSHeaders(hdr http.Header, origin string, rule *cmn.CORSRule) {
+	if rule.AnyOrigin() {
+		hdr.Set(cos.HdrACAllowOrigin, "*")
+	} else {
+		hdr.Set(cos.HdrACAllowOrigin, origin)
+		hdr.Set(cos.HdrACAllowCredentials, "true")
+	}
+	if len(rule.ExposeHeaders) > 0 {
+		hdr.Set(cos.HdrACExposeHeaders, strings.Join(rule.ExposeHeaders, ", "))
+	}
+	hdr.Set(cos.HdrVary, corsVary)
+}
@@ -0,0 +1,150 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cluster/mock"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+const corsXML = `<CORSConfiguration>
+  <CORSRule>
+    <ID>site</ID>
+    <AllowedOrigin>https://*.example.com</AllowedOrigin>
+    <AllowedMethod>GET</AllowedMethod>
+    <AllowedMethod>PUT</AllowedMethod>
+    <AllowedHeader>x-amz-*</AllowedHeader>
+    <AllowedHeader>Content-Type</AllowedHeader>
+    <ExposeHeader>ETag</ExposeHeader>
+    <MaxAgeSeconds>600</MaxAgeSeconds>
+  </CORSRule>
+  <CORSRule>
+    <AllowedOrigin>*</AllowedOrigin>
+    <AllowedMethod>GET</AllowedMethod>
+  </CORSRule>
+</CORSConfiguration>`
+
+func TestCORSRoundTrip(t *testing.T) {
+	in := &CORSConfiguration{}
+	if err := xml.NewDecoder(strings.NewReader(corsXML)).Decode(in); err != nil {
+		t.Fatal(err)
+	}
+	rules, err := in.ToProps()
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := &cmn.CORSConf{Rules: rules}
+	if err := conf.ValidateAsProps(); err != nil {
+		t.Fatal(err)
+	}
+
+	// matching
+	if rule := conf.Match("https://www.example.com", http.MethodPut, []string{"X-Amz-Date"}); rule == nil || rule.ID != "site" {
+		t.Fatalf("expected rule \"site\" to match, got %+v", rule)
+	}
+	if rule := conf.Match("https://www.example.com", http.MethodPut, []string{"Authorization"}); rule != nil {
+		t.Fatalf("expected no match (header not allowed), got %+v", rule)
+	}
+	if rule := conf.Match("https://other.org", http.MethodGet, nil); rule == nil || !rule.AnyOrigin() {
+		t.Fatalf("expected wildcard rule to match, got %+v", rule)
+	}
+	if rule := conf.Match("https://other.org", http.MethodDelete, nil); rule != nil {
+		t.Fatalf("expected no match (method not allowed), got %+v", rule)
+	}
+
+	// and back
+	sgl := memsys.PageMM().NewSGL(0)
+	defer sgl.Free()
+	NewCORSConfiguration(conf).MustMarshal(sgl)
+	out := &CORSConfiguration{}
+	if err := xml.NewDecoder(sgl).Decode(out); err != nil {
+		t.Fatal(err)
+	}
+	rules2, err := out.ToProps()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules2) != len(rules) {
+		t.Fatalf("expected %d rules, got %d", len(rules), len(rules2))
+	}
+	for i := range rules {
+		a, b := &rules[i], &rules2[i]
+		if a.ID != b.ID || a.MaxAgeSeconds != b.MaxAgeSeconds || len(a.AllowedOrigins) != len(b.AllowedOrigins) ||
+			len(a.AllowedMethods) != len(b.AllowedMethods) || len(a.AllowedHeaders) != len(b.AllowedHeaders) {
+			t.Fatalf("rule #%d: %+v != %+v", i, a, b)
+		}
+	}
+
+	// invalid
+	bad := &cmn.CORSConf{Rules: []cmn.CORSRule{{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"PATCH"}}}}
+	if err := bad.ValidateAsProps(); err == nil {
+		t.Fatal("expected invalid method error")
+	}
+}
+
+func TestCORSPreflight(t *testing.T) {
+	in := &CORSConfiguration{}
+	if err := xml.NewDecoder(strings.NewReader(corsXML)).Decode(in); err != nil {
+		t.Fatal(err)
+	}
+	rules, err := in.ToProps()
+	if err != nil {
+		t.Fatal(err)
+	}
+	props := &cmn.BucketProps{CORS: cmn.CORSConf{Rules: rules}}
+	bowner := mock.NewBaseBownerMock(meta.NewBck("bck", apc.AIS, cmn.NsGlobal, props))
+
+	tests := []struct {
+		origin, method, headers string
+		status                  int
+		allowOrigin             string
+	}{
+		{"https://www.example.com", http.MethodPut, "x-amz-date, content-type", http.StatusOK, "https://www.example.com"},
+		{"https://other.org", http.MethodGet, "", http.StatusOK, "*"},
+		{"https://other.org", http.MethodPut, "", http.StatusForbidden, ""},
+		{"", http.MethodGet, "", http.StatusBadRequest, ""},
+	}
+	for _, test := range tests {
+		r := httptest.NewRequest(http.MethodOptions, "/s3/bck/obj", http.NoBody)
+		if test.origin != "" {
+			r.Header.Set(cos.HdrOrigin, test.origin)
+		}
+		r.Header.Set(cos.HdrACRequestMethod, test.method)
+		if test.headers != "" {
+			r.Header.Set(cos.HdrACRequestHeaders, test.headers)
+		}
+		w := httptest.NewRecorder()
+		if !HandleCORS(w, r, "bck", bowner) {
+			t.Fatalf("%+v: expected preflight request to be handled", test)
+		}
+		if w.Code != test.status {
+			t.Fatalf("%+v: expected status %d, got %d", test, test.status, w.Code)
+		}
+		if origin := w.Header().Get(cos.HdrACAllowOrigin); origin != test.allowOrigin {
+			t.Fatalf("%+v: expected allowed origin %q, got %q", test, test.allowOrigin, origin)
+		}
+	}
+
+	// non-preflight
+	r := httptest.NewRequest(http.MethodGet, "/s3/bck/obj", http.NoBody)
+	r.Header.Set(cos.HdrOrigin, "https://www.example.com")
+	w := httptest.NewRecorder()
+	if HandleCORS(w, r, "bck", bowner) {
+		t.Fatal("expected GET request not to be handled")
+	}
+	if w.Header().Get(cos.HdrACExposeHeaders) != "ETag" {
+		t.Fatalf("expected exposed headers, got %v", w.Header())
+	}
+}
@@ -6,6 +6,7 @@ package s3
 
 import (
 	"encoding/xml"
+	"fmt"
 	"net/http"
 
 	"github.com/NVIDIA/aistore/cmn"
@@ -14,11 +15,41 @@ import (
 	"github.com/NVIDIA/aistore/memsys"
 )
 
-type Error struct {
-	Code      string
-	Message   string
-	Resource  string
-	RequestID string `xml:"RequestId"`
+type (
+	Error struct {
+		Code      string
+		Message   string
+		Resource  string
+		RequestID string `xml:"RequestId"`
+	}
+
+	// e.g. "NoSuchLifecycleConfiguration"
+	ErrNoSuchConfig struct {
+		code   string
+		bucket string
+	}
+
+	// "NoSuchVersion"
+	ErrNoSuchVersion struct {
+		cname   string
+		version string
+	}
+)
+
+func NewErrNoSuchConfig(code, bucket string) *ErrNoSuchConfig {
+	return &ErrNoSuchConfig{code: code, bucket: bucket}
+}
+
+func (e *ErrNoSuchConfig) Error() string {
+	return fmt.Sprintf("bucket %q: %s", e.bucket, e.code)
+}
+
+func NewErrNoSuchVersion(cname, version string) *ErrNoSuchVersion {
+	return &ErrNoSuchVersion{cname: cname, version: version}
+}
+
+func (e *ErrNoSuchVersion) Error() string {
+	return fmt.Sprintf("%s: version %q does not exist", e.cname, e.version)
 }
 
 func (e *Error) mustMarshal(sgl *memsys.SGL) {
@@ -27,6 +58,21 @@ func (e *Error) mustMarshal(sgl *memsys.SGL) {
 	debug.AssertNoErr(err)
 }
 
+func isErrNoSuchConfig(err error) bool {
+	_, ok := err.(*ErrNoSuchConfig)
+	return ok
+}
+
+func isErrNoSuchVersion(err error) bool {
+	_, ok := err.(*ErrNoSuchVersion)
+	return ok
+}
+
+func isErrNotImpl(err error) bool {
+	_, ok := err.(*cmn.ErrNotImpl)
+	return ok
+}
+
 func WriteErr(w http.ResponseWriter, r *http.Request, err error, errCode int) {
 	var (
 		out       Error
@@ -44,6 +90,12 @@ func WriteErr(w http.ResponseWriter, r *http.Request, err error, errCode int) {
 		out.Code = "BucketAlreadyExists"
 	case cmn.IsErrBckNotFound(err):
 		out.Code = "NoSuchBucket"
+	case isErrNoSuchConfig(err):
+		out.Code = err.(*ErrNoSuchConfig).code
+	case isErrNoSuchVersion(err):
+		out.Code = NoSuchVersion
+	case isErrNotImpl(err):
+		out.Code = "NotImplemented"
 	default:
 		out.Code = in.TypeCode
 	}
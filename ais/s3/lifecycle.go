@@ -0,0 +1,174 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Bucket lifecycle configuration: expiration rules only - see cmn.LifecycleConf
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketLifecycleConfiguration.html
+
+const (
+	lifecycleEnabled  = "Enabled"
+	lifecycleDisabled = "Disabled"
+
+	NoSuchLifecycle = "NoSuchLifecycleConfiguration" // error code
+
+	day = 24 * time.Hour
+)
+
+type (
+	LifecycleConfiguration struct {
+		XMLName xml.Name         `xml:"LifecycleConfiguration"`
+		Ns      string           `xml:"xmlns,attr,omitempty"`
+		Rules   []*LifecycleRule `xml:"Rule"`
+	}
+	LifecycleRule struct {
+		ID         string               `xml:"ID,omitempty"`
+		Filter     *LifecycleFilter     `xml:"Filter,omitempty"`
+		Prefix     *string              `xml:"Prefix,omitempty"` // deprecated (pre-`Filter`) syntax
+		Status     string               `xml:"Status"`
+		Expiration *LifecycleExpiration `xml:"Expiration,omitempty"`
+
+		// not supported
+		Transition                     *struct{} `xml:"Transition,omitempty"`
+		NoncurrentVersionTransition    *struct{} `xml:"NoncurrentVersionTransition,omitempty"`
+		NoncurrentVersionExpiration    *struct{} `xml:"NoncurrentVersionExpiration,omitempty"`
+		AbortIncompleteMultipartUpload *struct{} `xml:"AbortIncompleteMultipartUpload,omitempty"`
+	}
+	LifecycleFilter struct {
+		Prefix *string       `xml:"Prefix,omitempty"`
+		Tag    *Tag          `xml:"Tag,omitempty"`
+		And    *LifecycleAnd `xml:"And,omitempty"`
+	}
+	LifecycleAnd struct {
+		Prefix string `xml:"Prefix,omitempty"`
+		Tags   []Tag  `xml:"Tag"`
+	}
+	LifecycleExpiration struct {
+		Date string `xml:"Date,omitempty"` // ISO 8601
+		Days int    `xml:"Days,omitempty"`
+	}
+)
+
+func NewLifecycleConfiguration(conf *cmn.LifecycleConf) *LifecycleConfiguration {
+	lc := &LifecycleConfiguration{Ns: s3Namespace, Rules: make([]*LifecycleRule, 0, len(conf.Rules))}
+	for i := range conf.Rules {
+		var (
+			in     = &conf.Rules[i]
+			prefix = in.Prefix
+			out    = &LifecycleRule{ID: in.ID, Status: lifecycleDisabled, Expiration: &LifecycleExpiration{}}
+		)
+		if in.Enabled {
+			out.Status = lifecycleEnabled
+		}
+		switch {
+		case len(in.Tags) == 0:
+			out.Filter = &LifecycleFilter{Prefix: &prefix}
+		case len(in.Tags) == 1 && prefix == "":
+			for k, v := range in.Tags {
+				out.Filter = &LifecycleFilter{Tag: &Tag{Key: k, Value: v}}
+			}
+		default:
+			and := &LifecycleAnd{Prefix: prefix, Tags: make([]Tag, 0, len(in.Tags))}
+			for k, v := range in.Tags {
+				and.Tags = append(and.Tags, Tag{Key: k, Value: v})
+			}
+			sort.Slice(and.Tags, func(i, j int) bool { return and.Tags[i].Key < and.Tags[j].Key })
+			out.Filter = &LifecycleFilter{And: and}
+		}
+		if in.Age != 0 {
+			// S3 expiration granularity is one day
+			out.Expiration.Days = int((in.Age.D() + day - 1) / day)
+		}
+		if in.Date != 0 {
+			out.Expiration.Date = cos.FormatTime(time.Unix(0, in.Date).UTC(), cos.ISO8601)
+		}
+		lc.Rules = append(lc.Rules, out)
+	}
+	return lc
+}
+
+func (lc *LifecycleConfiguration) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(lc)
+	debug.AssertNoErr(err)
+}
+
+// convert to (native) bucket props; the latter get validated separately
+func (lc *LifecycleConfiguration) ToProps() ([]cmn.LifecycleRule, error) {
+	if len(lc.Rules) == 0 {
+		return nil, errors.New("lifecycle configuration must contain at least one rule")
+	}
+	rules := make([]cmn.LifecycleRule, 0, len(lc.Rules))
+	for _, in := range lc.Rules {
+		out, err := in.toProps()
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, out)
+	}
+	return rules, nil
+}
+
+func (r *LifecycleRule) toProps() (out cmn.LifecycleRule, err error) {
+	out.ID = r.ID
+	switch r.Status {
+	case lifecycleEnabled:
+		out.Enabled = true
+	case lifecycleDisabled:
+	default:
+		return out, fmt.Errorf("lifecycle rule %q: invalid status %q", r.ID, r.Status)
+	}
+	if r.Transition != nil || r.NoncurrentVersionTransition != nil || r.NoncurrentVersionExpiration != nil ||
+		r.AbortIncompleteMultipartUpload != nil {
+		return out, fmt.Errorf("lifecycle rule %q: only expiration actions are currently supported", r.ID)
+	}
+	if r.Expiration == nil {
+		return out, fmt.Errorf("lifecycle rule %q: missing expiration", r.ID)
+	}
+
+	// filter
+	switch {
+	case r.Filter == nil:
+		if r.Prefix != nil {
+			out.Prefix = *r.Prefix
+		}
+	case r.Filter.And != nil:
+		out.Prefix = r.Filter.And.Prefix
+		out.Tags = make(cos.StrKVs, len(r.Filter.And.Tags))
+		for _, tag := range r.Filter.And.Tags {
+			out.Tags[tag.Key] = tag.Value
+		}
+	case r.Filter.Tag != nil:
+		out.Tags = cos.StrKVs{r.Filter.Tag.Key: r.Filter.Tag.Value}
+	case r.Filter.Prefix != nil:
+		out.Prefix = *r.Filter.Prefix
+	}
+
+	// expiration
+	if r.Expiration.Days < 0 {
+		return out, fmt.Errorf("lifecycle rule %q: invalid expiration days %d", r.ID, r.Expiration.Days)
+	}
+	out.Age = cos.Duration(time.Duration(r.Expiration.Days) * day)
+	if r.Expiration.Date != "" {
+		date, err := time.Parse(time.RFC3339, r.Expiration.Date)
+		if err != nil {
+			return out, fmt.Errorf("lifecycle rule %q: invalid expiration date %q: %v", r.ID, r.Expiration.Date, err)
+		}
+		out.Date = date.UnixNano()
+	}
+	return out, nil
+}
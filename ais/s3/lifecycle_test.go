@@ -0,0 +1,106 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+const lifecycleXML = `<LifecycleConfiguration>
+  <Rule>
+    <ID>tmp</ID>
+    <Filter><Prefix>tmp/</Prefix></Filter>
+    <Status>Enabled</Status>
+    <Expiration><Days>7</Days></Expiration>
+  </Rule>
+  <Rule>
+    <ID>scratch</ID>
+    <Filter><And><Prefix>scratch/</Prefix><Tag><Key>kind</Key><Value>scratch</Value></Tag></And></Filter>
+    <Status>Disabled</Status>
+    <Expiration><Date>2023-01-01T00:00:00.000Z</Date></Expiration>
+  </Rule>
+</LifecycleConfiguration>`
+
+func TestLifecycleRoundTrip(t *testing.T) {
+	in := &LifecycleConfiguration{}
+	if err := xml.NewDecoder(strings.NewReader(lifecycleXML)).Decode(in); err != nil {
+		t.Fatal(err)
+	}
+	rules, err := in.ToProps()
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := &cmn.LifecycleConf{Rules: rules}
+	if err := conf.ValidateAsProps(); err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 2 || rules[0].Prefix != "tmp/" || rules[0].Age.D() != 7*day || !rules[0].Enabled {
+		t.Fatalf("unexpected rule: %+v", rules[0])
+	}
+	if rules[1].Prefix != "scratch/" || rules[1].Tags["kind"] != "scratch" || rules[1].Date == 0 || rules[1].Enabled {
+		t.Fatalf("unexpected rule: %+v", rules[1])
+	}
+
+	// expiration
+	var (
+		now   = time.Now()
+		mtime = now.Add(-8 * day)
+	)
+	if rule := conf.Expired("tmp/obj", nil, mtime, now); rule == nil || rule.ID != "tmp" {
+		t.Fatalf("expected tmp/obj to expire, got %+v", rule)
+	}
+	if rule := conf.Expired("tmp/obj", nil, now.Add(-time.Hour), now); rule != nil {
+		t.Fatalf("expected tmp/obj not to expire, got %+v", rule)
+	}
+	if rule := conf.Expired("scratch/obj", cos.StrKVs{cmn.TagObjMDPrefix + "kind": "scratch"}, mtime, now); rule != nil {
+		t.Fatalf("disabled rule %+v must not apply", rule)
+	}
+
+	// and back
+	sgl := memsys.PageMM().NewSGL(0)
+	defer sgl.Free()
+	NewLifecycleConfiguration(conf).MustMarshal(sgl)
+	out := &LifecycleConfiguration{}
+	if err := xml.NewDecoder(sgl).Decode(out); err != nil {
+		t.Fatal(err)
+	}
+	rules2, err := out.ToProps()
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf2 := &cmn.LifecycleConf{Rules: rules2}
+	if len(rules2) != len(rules) {
+		t.Fatalf("expected %d rules, got %d", len(rules), len(rules2))
+	}
+	for i := range rules {
+		a, b := &rules[i], &rules2[i]
+		if a.ID != b.ID || a.Prefix != b.Prefix || a.Age != b.Age || a.Date != b.Date || a.Enabled != b.Enabled ||
+			len(a.Tags) != len(b.Tags) {
+			t.Fatalf("rule #%d: %+v != %+v", i, a, b)
+		}
+	}
+	if !conf2.IsEnabled() {
+		t.Fatal("expected lifecycle to be enabled")
+	}
+}
+
+func TestLifecycleUnsupported(t *testing.T) {
+	const unsupp = `<LifecycleConfiguration><Rule><ID>x</ID><Status>Enabled</Status>
+<Transition><Days>30</Days><StorageClass>GLACIER</StorageClass></Transition></Rule></LifecycleConfiguration>`
+	in := &LifecycleConfiguration{}
+	if err := xml.NewDecoder(strings.NewReader(unsupp)).Decode(in); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := in.ToProps(); err == nil {
+		t.Fatal("expected transition rule to fail")
+	}
+}
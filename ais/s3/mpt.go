@@ -15,22 +15,25 @@ import (
 
 	"github.com/NVIDIA/aistore/3rdparty/glog"
 	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/debug"
 )
 
 // NOTE: xattr stores only the (*) marked attributes
+// (the entire upload state is also persisted - see mptmd.go)
 type (
 	MptPart struct {
-		MD5  string // MD5 of the part (*)
-		FQN  string // FQN of the corresponding workfile
-		Size int64  // part size in bytes (*)
-		Num  int64  // part number (*)
+		MD5  string `json:"md5"`  // MD5 of the part (*)
+		FQN  string `json:"fqn"`  // FQN of the corresponding workfile
+		Size int64  `json:"size"` // part size in bytes (*)
+		Num  int64  `json:"num"`  // part number (*)
 	}
 	mpt struct {
-		bckName string
-		objName string
-		parts   []*MptPart // by part number
-		ctime   time.Time  // InitUpload time
+		Bck     cmn.Bck    `json:"bck"`
+		ObjName string     `json:"obj"`
+		Parts   []*MptPart `json:"parts"` // by part number
+		Ctime   time.Time  `json:"ctime"` // InitUpload time
+		mpath   string     // mountpath that stores the upload state (and the parts' workfiles)
 	}
 	uploads map[string]*mpt // by upload ID
 )
@@ -40,18 +43,27 @@ var (
 	mu  sync.RWMutex
 )
 
-func Init() { ups = make(uploads) }
+// load (and resume) multipart uploads that were active prior to restart
+func Init() {
+	ups = make(uploads)
+	loadAll()
+}
 
 // Start miltipart upload
-func InitUpload(id, bckName, objName string) {
+func InitUpload(id string, lom *cluster.LOM) (err error) {
+	mpt := &mpt{
+		Bck:     *lom.Bucket(),
+		ObjName: lom.ObjName,
+		Parts:   make([]*MptPart, 0, iniCapParts),
+		Ctime:   time.Now(),
+		mpath:   lom.Mountpath().Path,
+	}
 	mu.Lock()
-	ups[id] = &mpt{
-		bckName: bckName,
-		objName: objName,
-		parts:   make([]*MptPart, 0, iniCapParts),
-		ctime:   time.Now(),
+	if err = mpt.persist(id); err == nil {
+		ups[id] = mpt
 	}
 	mu.Unlock()
+	return
 }
 
 // Add part to an active upload.
@@ -63,7 +75,8 @@ func AddPart(id string, npart *MptPart) (err error) {
 	if !ok {
 		err = fmt.Errorf("upload %q not found (%s, %d)", id, npart.FQN, npart.Num)
 	} else {
-		mpt.parts = append(mpt.parts, npart)
+		mpt.Parts = append(mpt.Parts, npart)
+		err = mpt.persist(id)
 	}
 	mu.Unlock()
 	return
@@ -110,7 +123,7 @@ func ObjSize(id string) (size int64, err error) {
 	if !ok {
 		err = fmt.Errorf("upload %q not found", id)
 	} else {
-		for _, part := range mpt.parts {
+		for _, part := range mpt.Parts {
 			size += part.Size
 		}
 	}
@@ -131,12 +144,14 @@ func FinishUpload(id, fqn string, aborted bool) (exists bool) {
 	delete(ups, id)
 	mu.Unlock()
 
+	unpersist(mpt.mpath, id)
+
 	if !aborted {
 		if err := storeMptXattr(fqn, mpt); err != nil {
 			glog.Warningf("fqn %s, id %s: %v", fqn, id, err)
 		}
 	}
-	for _, part := range mpt.parts {
+	for _, part := range mpt.Parts {
 		if err := os.Remove(part.FQN); err != nil && !os.IsNotExist(err) {
 			glog.Error(err)
 		}
@@ -148,7 +163,7 @@ func ListUploads(bckName, idMarker string, maxUploads int) (result *ListMptUploa
 	mu.RLock()
 	results := make([]UploadInfoResult, 0, len(ups))
 	for id, mpt := range ups {
-		results = append(results, UploadInfoResult{Key: mpt.objName, UploadID: id, Initiated: mpt.ctime})
+		results = append(results, UploadInfoResult{Key: mpt.ObjName, UploadID: id, Initiated: mpt.Ctime})
 	}
 	mu.RUnlock()
 
@@ -185,11 +200,11 @@ func ListParts(id string, lom *cluster.LOM) (parts []*PartInfo, err error, errCo
 			mu.RUnlock()
 			return
 		}
-		mpt.bckName, mpt.objName = lom.Bck().Name, lom.ObjName
-		mpt.ctime = lom.Atime()
+		mpt.Bck, mpt.ObjName = *lom.Bucket(), lom.ObjName
+		mpt.Ctime = lom.Atime()
 	}
-	parts = make([]*PartInfo, 0, len(mpt.parts))
-	for _, part := range mpt.parts {
+	parts = make([]*PartInfo, 0, len(mpt.Parts))
+	for _, part := range mpt.Parts {
 		parts = append(parts, &PartInfo{ETag: part.MD5, PartNumber: part.Num, Size: part.Size})
 	}
 	mu.RUnlock()
@@ -0,0 +1,122 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+// Persistent multipart upload state:
+// - one jsp-formatted file per upload ID, stored under fname.MptDir
+//   on the same mountpath as the (future) object and its parts' workfiles;
+// - updated upon every InitUpload and AddPart, removed upon FinishUpload;
+// - loaded at startup (see Init), which also makes the respective parts'
+//   workfiles exempt from space cleanup (see IsActiveUpload);
+// - finally, uploads that were neither completed nor aborted are
+//   garbage-collected by space cleanup (see CleanupAbandoned).
+
+// interface guard
+var _ jsp.Opts = (*mpt)(nil)
+
+var mptJspOpts = jsp.CksumSign(cmn.MetaverMpt)
+
+func (*mpt) JspOpts() jsp.Options { return mptJspOpts }
+
+func mptPath(mpath, id string) string { return filepath.Join(mpath, fname.MptDir, id) }
+
+// is called under lock
+func (mpt *mpt) persist(id string) error {
+	return jsp.SaveMeta(mptPath(mpt.mpath, id), mpt, nil /*wto*/)
+}
+
+func unpersist(mpath, id string) {
+	if err := cos.RemoveFile(mptPath(mpath, id)); err != nil {
+		glog.Errorf("upload %q: failed to remove persistent state: %v", id, err)
+	}
+}
+
+func loadAll() {
+	var cnt int
+	for mpath := range fs.GetAvail() {
+		dir := filepath.Join(mpath, fname.MptDir)
+		dentries, err := os.ReadDir(dir)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				glog.Errorf("failed to read %q: %v", dir, err)
+			}
+			continue
+		}
+		for _, dent := range dentries {
+			if dent.IsDir() || strings.Contains(dent.Name(), ".tmp.") {
+				continue
+			}
+			var (
+				id  = dent.Name()
+				mpt = &mpt{mpath: mpath}
+			)
+			if _, err := jsp.LoadMeta(mptPath(mpath, id), mpt); err != nil {
+				glog.Errorf("upload %q: failed to load persistent state (removing): %v", id, err)
+				unpersist(mpath, id)
+				continue
+			}
+			ups[id] = mpt
+			cnt++
+		}
+	}
+	if cnt > 0 {
+		glog.Infof("loaded %d active multipart upload%s", cnt, cos.Plural(cnt))
+	}
+}
+
+// Returns true if the workfile (basename) belongs to an active upload.
+// Workfile naming: <upload-id>.<part-number>.<obj-name>.<tie>.<pid> (see putMptPart).
+func IsActiveUpload(workfileBase string) (active bool) {
+	i := strings.IndexByte(workfileBase, '.')
+	if i <= 0 {
+		return
+	}
+	mu.RLock()
+	_, active = ups[workfileBase[:i]]
+	mu.RUnlock()
+	return
+}
+
+// Remove multipart uploads that were started on a given mountpath more than `maxAge` ago
+// and have been neither completed nor aborted since. Returns the number of removed uploads
+// and the total size of their parts.
+func CleanupAbandoned(mpath string, maxAge time.Duration) (cnt int, size int64) {
+	var (
+		ids []string
+		now = time.Now()
+	)
+	mu.RLock()
+	for id, mpt := range ups {
+		if mpt.mpath == mpath && now.Sub(mpt.Ctime) > maxAge {
+			ids = append(ids, id)
+			for _, part := range mpt.Parts {
+				size += part.Size
+			}
+		}
+	}
+	mu.RUnlock()
+
+	for _, id := range ids {
+		if FinishUpload(id, "", true /*aborted*/) {
+			glog.Infof("%s: removed abandoned upload %q", mpath, id)
+			cnt++
+		}
+	}
+	return
+}
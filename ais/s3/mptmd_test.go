@@ -0,0 +1,80 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/trand"
+)
+
+func TestMptPersistLoad(t *testing.T) {
+	mpath := t.TempDir()
+	cos.InitShortID(0)
+	fs.TestNew(nil)
+	if _, err := fs.Add(mpath, "daeID"); err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Remove(mpath)
+
+	const nump = 3
+	var (
+		id  = cos.GenUUID()
+		in  = &mpt{Bck: cmn.Bck{Name: "bck", Provider: apc.AIS}, ObjName: "obj", Ctime: time.Now(), mpath: mpath}
+		wfq = make([]string, 0, nump)
+	)
+	Init()
+	for i := int64(1); i <= nump; i++ {
+		wfqn := mpath + "/" + id + "." + trand.String(4)
+		if err := os.WriteFile(wfqn, []byte("part"), cos.PermRWR); err != nil {
+			t.Fatal(err)
+		}
+		wfq = append(wfq, wfqn)
+		in.Parts = append(in.Parts, &MptPart{Num: i, MD5: trand.String(8), Size: 4, FQN: wfqn})
+	}
+	if err := in.persist(id); err != nil {
+		t.Fatal(err)
+	}
+
+	// simulate restart
+	Init()
+	if !IsActiveUpload(id + ".1.obj.tie.pid") {
+		t.Fatalf("upload %q is expected to be active", id)
+	}
+	size, err := ObjSize(id)
+	if err != nil || size != nump*4 {
+		t.Fatalf("expected size %d, got %d (err: %v)", nump*4, size, err)
+	}
+	out := ups[id]
+	if out.Bck.Name != in.Bck.Name || out.ObjName != in.ObjName || len(out.Parts) != nump || out.mpath != mpath {
+		t.Fatalf("in %+v != out %+v", in, out)
+	}
+
+	// not yet abandoned
+	if cnt, _ := CleanupAbandoned(mpath, time.Hour); cnt != 0 {
+		t.Fatalf("expected no abandoned uploads, got %d", cnt)
+	}
+	time.Sleep(10 * time.Millisecond)
+	if cnt, size := CleanupAbandoned(mpath, time.Millisecond); cnt != 1 || size != nump*4 {
+		t.Fatalf("expected 1 abandoned upload of size %d, got (%d, %d)", nump*4, cnt, size)
+	}
+	if IsActiveUpload(id + ".1.obj.tie.pid") {
+		t.Fatalf("upload %q is expected to be removed", id)
+	}
+	for _, wfqn := range wfq {
+		if err := cos.Stat(wfqn); !os.IsNotExist(err) {
+			t.Fatalf("workfile %q is expected to be removed (err: %v)", wfqn, err)
+		}
+	}
+	if err := cos.Stat(mptPath(mpath, id)); !os.IsNotExist(err) {
+		t.Fatalf("persistent state of %q is expected to be removed (err: %v)", id, err)
+	}
+}
@@ -0,0 +1,38 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Object lock (WORM): S3 request headers are translated into their native
+// counterparts - see cmn.ObjLockConf.
+// https://docs.aws.amazon.com/AmazonS3/latest/userguide/object-lock.html
+
+func ObjLockFromHdr(hdr http.Header) {
+	if v := hdr.Get(cos.S3HdrObjLockUntil); v != "" {
+		hdr.Set(apc.HdrObjRetainUntil, v)
+	}
+	if v := hdr.Get(cos.S3HdrBypassGovernance); v != "" {
+		hdr.Set(apc.HdrBypassGovernance, v)
+	}
+}
+
+func ObjLockToHdr(conf *cmn.ObjLockConf, oah cos.OAH, hdr http.Header) {
+	if !conf.Enabled() {
+		return
+	}
+	if until := cmn.RetainUntil(oah); !until.IsZero() {
+		hdr.Set(cos.S3HdrObjLockMode, strings.ToUpper(conf.Mode))
+		hdr.Set(cos.S3HdrObjLockUntil, until.UTC().Format(time.RFC3339))
+	}
+}
@@ -0,0 +1,241 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Bucket policy: a subset of S3 bucket policy language translated into (and from)
+// bucket access attributes (`apc.AccessAttrs`). Limitations:
+// - principal must be "*" (anonymous/everyone) - per-user permissions are AuthN's business;
+// - resources must refer to the bucket itself and/or all its objects ("<bucket>/*");
+// - conditions and Not* elements are not supported.
+// Access = (union of all "Allow" statements, or everything if there are none) minus
+// (union of all "Deny" statements).
+// https://docs.aws.amazon.com/AmazonS3/latest/userguide/bucket-policies.html
+
+const (
+	NoSuchBucketPolicy = "NoSuchBucketPolicy" // error code
+
+	policyVersion = "2012-10-17"
+	policyAllow   = "Allow"
+	policyDeny    = "Deny"
+	arnPrefix     = "arn:aws:s3:::"
+	aisActPrefix  = "ais:" // AIS permissions that have no S3 counterpart, e.g. "ais:APPEND"
+)
+
+type (
+	BucketPolicy struct {
+		Version   string            `json:"Version"`
+		ID        string            `json:"Id,omitempty"`
+		Statement []PolicyStatement `json:"Statement"`
+	}
+	PolicyStatement struct {
+		Sid          string   `json:"Sid,omitempty"`
+		Effect       string   `json:"Effect"`
+		Principal    any      `json:"Principal,omitempty"`
+		NotPrincipal any      `json:"NotPrincipal,omitempty"`
+		Action       strOrArr `json:"Action,omitempty"`
+		NotAction    strOrArr `json:"NotAction,omitempty"`
+		Resource     strOrArr `json:"Resource,omitempty"`
+		NotResource  strOrArr `json:"NotResource,omitempty"`
+		Condition    any      `json:"Condition,omitempty"`
+	}
+	// policy elements can be either a single string or an array of strings
+	strOrArr []string
+)
+
+// ordered: when converting access => policy the first action that covers
+// not-yet-covered permissions wins
+var policyActions = []struct {
+	name string
+	ace  apc.AccessAttrs
+}{
+	{"s3:GetObject", apc.AceGET | apc.AceObjHEAD},
+	{"s3:PutObject", apc.AcePUT},
+	{"s3:DeleteObject", apc.AceObjDELETE},
+	{"s3:ListBucket", apc.AceObjLIST | apc.AceBckHEAD},
+	{"s3:ListAllMyBuckets", apc.AceListBuckets},
+	{"s3:GetObjectVersion", apc.AceGET | apc.AceObjHEAD},
+	{"s3:DeleteObjectVersion", apc.AceObjDELETE},
+	{"s3:ListBucketVersions", apc.AceObjLIST},
+	{"s3:PutBucketPolicy", apc.AceBckSetACL},
+	{"s3:PutBucketAcl", apc.AceBckSetACL},
+	{"s3:PutBucketVersioning", apc.AcePATCH},
+	{"s3:PutLifecycleConfiguration", apc.AcePATCH},
+	{"s3:PutBucketCORS", apc.AcePATCH},
+	{"s3:GetBucketPolicy", apc.AceBckHEAD},
+	{"s3:GetBucketAcl", apc.AceBckHEAD},
+	{"s3:GetBucketVersioning", apc.AceBckHEAD},
+	{"s3:GetLifecycleConfiguration", apc.AceBckHEAD},
+	{"s3:GetBucketCORS", apc.AceBckHEAD},
+	{"s3:CreateBucket", apc.AceCreateBucket},
+	{"s3:DeleteBucket", apc.AceDestroyBucket},
+}
+
+func (s *strOrArr) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := jsoniter.Unmarshal(b, &str); err == nil {
+		*s = strOrArr{str}
+		return nil
+	}
+	var arr []string
+	if err := jsoniter.Unmarshal(b, &arr); err != nil {
+		return fmt.Errorf("expecting string or array of strings, got %s", string(b))
+	}
+	*s = arr
+	return nil
+}
+
+// convert bucket access attributes to policy
+func NewBucketPolicy(bucket string, access apc.AccessAttrs) *BucketPolicy {
+	var (
+		actions = make([]string, 0, 8)
+		covered apc.AccessAttrs
+		stmt    = PolicyStatement{
+			Sid:       "ais-access",
+			Effect:    policyAllow,
+			Principal: "*",
+			Resource:  strOrArr{arnPrefix + bucket, arnPrefix + bucket + "/*"},
+		}
+	)
+	switch access {
+	case apc.AccessAll:
+		actions = append(actions, "s3:*")
+	case apc.AccessNone:
+		stmt.Effect = policyDeny
+		actions = append(actions, "s3:*")
+	default:
+		for _, a := range policyActions {
+			if access.Has(a.ace) && !covered.Has(a.ace) {
+				actions = append(actions, a.name)
+				covered |= a.ace
+			}
+		}
+		for ace := apc.AceGET; ace < apc.AceMax; ace <<= 1 {
+			if access.Has(ace) && !covered.Has(ace) {
+				actions = append(actions, aisActPrefix+apc.AccessOp(ace))
+			}
+		}
+	}
+	stmt.Action = actions
+	return &BucketPolicy{Version: policyVersion, Statement: []PolicyStatement{stmt}}
+}
+
+func (bp *BucketPolicy) MustMarshal(sgl *memsys.SGL) {
+	err := jsoniter.NewEncoder(sgl).Encode(bp)
+	debug.AssertNoErr(err)
+}
+
+// convert policy to bucket access attributes
+func (bp *BucketPolicy) ToAccess(bucket string) (apc.AccessAttrs, error) {
+	var (
+		allow, deny apc.AccessAttrs
+		hasAllow    bool
+	)
+	if len(bp.Statement) == 0 {
+		return 0, errors.New("bucket policy must contain at least one statement")
+	}
+	for i := range bp.Statement {
+		stmt := &bp.Statement[i]
+		ace, err := stmt.toAccess(bucket)
+		if err != nil {
+			return 0, err
+		}
+		switch stmt.Effect {
+		case policyAllow:
+			allow |= ace
+			hasAllow = true
+		case policyDeny:
+			deny |= ace
+		default:
+			return 0, fmt.Errorf("policy statement %q: invalid effect %q", stmt.Sid, stmt.Effect)
+		}
+	}
+	if !hasAllow {
+		allow = apc.AccessAll
+	}
+	return allow &^ deny, nil
+}
+
+func (stmt *PolicyStatement) toAccess(bucket string) (ace apc.AccessAttrs, err error) {
+	if stmt.NotPrincipal != nil || stmt.NotAction != nil || stmt.NotResource != nil {
+		return 0, fmt.Errorf("policy statement %q: NotPrincipal, NotAction, and NotResource are not supported", stmt.Sid)
+	}
+	if stmt.Condition != nil {
+		return 0, fmt.Errorf("policy statement %q: conditions are not supported", stmt.Sid)
+	}
+	if !isAnyPrincipal(stmt.Principal) {
+		return 0, fmt.Errorf("policy statement %q: only \"*\" principal is supported (use AuthN to manage per-user permissions)",
+			stmt.Sid)
+	}
+	for _, res := range stmt.Resource {
+		name := strings.TrimPrefix(res, arnPrefix)
+		if name != bucket && name != bucket+"/*" {
+			return 0, fmt.Errorf("policy statement %q: unsupported resource %q (expecting %q or %q)",
+				stmt.Sid, res, arnPrefix+bucket, arnPrefix+bucket+"/*")
+		}
+	}
+	if len(stmt.Action) == 0 {
+		return 0, fmt.Errorf("policy statement %q: missing action", stmt.Sid)
+	}
+	for _, action := range stmt.Action {
+		a, err := actionToAccess(action)
+		if err != nil {
+			return 0, fmt.Errorf("policy statement %q: %v", stmt.Sid, err)
+		}
+		ace |= a
+	}
+	return ace, nil
+}
+
+func isAnyPrincipal(principal any) bool {
+	switch p := principal.(type) {
+	case string:
+		return p == "*"
+	case map[string]any:
+		if len(p) != 1 {
+			return false
+		}
+		aws, ok := p["AWS"]
+		if !ok {
+			return false
+		}
+		if s, ok := aws.(string); ok {
+			return s == "*"
+		}
+		if arr, ok := aws.([]any); ok && len(arr) == 1 {
+			return arr[0] == "*"
+		}
+	}
+	return false
+}
+
+func actionToAccess(action string) (apc.AccessAttrs, error) {
+	if action == "*" || action == "s3:*" {
+		return apc.AccessAll, nil
+	}
+	if strings.HasPrefix(action, aisActPrefix) {
+		ace, err := apc.StrToAccess(strings.TrimPrefix(action, aisActPrefix))
+		if err != nil {
+			return 0, fmt.Errorf("unsupported action %q", action)
+		}
+		return ace, nil
+	}
+	for _, a := range policyActions {
+		if a.name == action {
+			return a.ace, nil
+		}
+	}
+	return 0, fmt.Errorf("unsupported action %q", action)
+}
@@ -0,0 +1,180 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/memsys"
+	jsoniter "github.com/json-iterator/go"
+)
+
+const policyJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "read",
+      "Effect": "Allow",
+      "Principal": {"AWS": "*"},
+      "Action": ["s3:GetObject", "s3:ListBucket"],
+      "Resource": ["arn:aws:s3:::bck", "arn:aws:s3:::bck/*"]
+    },
+    {
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": "ais:APPEND",
+      "Resource": "arn:aws:s3:::bck/*"
+    }
+  ]
+}`
+
+func TestPolicyRoundTrip(t *testing.T) {
+	const expected = apc.AceGET | apc.AceObjHEAD | apc.AceObjLIST | apc.AceBckHEAD | apc.AceAPPEND
+
+	in := &BucketPolicy{}
+	if err := jsoniter.Unmarshal([]byte(policyJSON), in); err != nil {
+		t.Fatal(err)
+	}
+	access, err := in.ToAccess("bck")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if access != expected {
+		t.Fatalf("expected access %q, got %q", expected.Describe(), access.Describe())
+	}
+
+	for _, access := range []apc.AccessAttrs{expected, apc.AccessRO, apc.AccessRW, apc.AccessNone, apc.AccessAll} {
+		sgl := memsys.PageMM().NewSGL(0)
+		NewBucketPolicy("bck", access).MustMarshal(sgl)
+		out := &BucketPolicy{}
+		err := jsoniter.NewDecoder(sgl).Decode(out)
+		sgl.Free()
+		if err != nil {
+			t.Fatal(err)
+		}
+		access2, err := out.ToAccess("bck")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if access2 != access {
+			t.Fatalf("expected access %q, got %q", access.Describe(), access2.Describe())
+		}
+	}
+}
+
+func TestPolicyDeny(t *testing.T) {
+	const deny = `{"Statement": [{"Effect": "Deny", "Principal": "*", "Action": "s3:DeleteObject"}]}`
+	in := &BucketPolicy{}
+	if err := jsoniter.Unmarshal([]byte(deny), in); err != nil {
+		t.Fatal(err)
+	}
+	access, err := in.ToAccess("bck")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if access.Has(apc.AceObjDELETE) || !access.Has(apc.AccessRO|apc.AcePUT) {
+		t.Fatalf("unexpected access %q", access.Describe())
+	}
+
+	const other = `{"Statement": [{"Effect": "Allow", "Principal": "*", "Action": "s3:GetObject",
+"Resource": "arn:aws:s3:::other/*"}]}`
+	in = &BucketPolicy{}
+	if err := jsoniter.Unmarshal([]byte(other), in); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := in.ToAccess("bck"); err == nil {
+		t.Fatal("expected error (resource refers to another bucket)")
+	}
+}
+
+func TestACLRoundTrip(t *testing.T) {
+	for _, access := range []apc.AccessAttrs{apc.AccessRO, apc.AccessRW, apc.AccessAll,
+		apc.AccessRO | apc.AceBckSetACL} {
+		sgl := memsys.PageMM().NewSGL(0)
+		NewAccessControlPolicy(access).MustMarshal(sgl)
+		out := &AccessControlPolicy{}
+		err := xml.NewDecoder(sgl).Decode(out)
+		sgl.Free()
+		if err != nil {
+			t.Fatal(err)
+		}
+		access2, err := out.ToAccess()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if access2 != access {
+			t.Fatalf("expected access %q, got %q", access.Describe(), access2.Describe())
+		}
+	}
+
+	const acl = `<AccessControlPolicy><AccessControlList><Grant>
+<Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="Group">
+<URI>http://acs.amazonaws.com/groups/global/AllUsers</URI></Grantee>
+<Permission>READ</Permission></Grant></AccessControlList></AccessControlPolicy>`
+	in := &AccessControlPolicy{}
+	if err := xml.NewDecoder(strings.NewReader(acl)).Decode(in); err != nil {
+		t.Fatal(err)
+	}
+	if access, err := in.ToAccess(); err != nil || access != apc.AccessRO {
+		t.Fatalf("expected read-only access, got %q (err: %v)", access.Describe(), err)
+	}
+}
+
+// owner-only (and individual users) ACL: same as canned "private" - must not be denying access to everyone
+func TestPrivateACL(t *testing.T) {
+	const acl = `<AccessControlPolicy><AccessControlList>
+<Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser">
+<ID>owner</ID></Grantee><Permission>FULL_CONTROL</Permission></Grant>
+<Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser">
+<ID>user</ID></Grantee><Permission>READ</Permission></Grant>
+</AccessControlList></AccessControlPolicy>`
+	in := &AccessControlPolicy{}
+	if err := xml.NewDecoder(strings.NewReader(acl)).Decode(in); err != nil {
+		t.Fatal(err)
+	}
+	sgl := memsys.PageMM().NewSGL(0)
+	defer sgl.Free()
+	NewAccessControlPolicy(apc.AccessNone).MustMarshal(sgl)
+	none := &AccessControlPolicy{}
+	if err := xml.NewDecoder(sgl).Decode(none); err != nil {
+		t.Fatal(err)
+	}
+	for _, acp := range []*AccessControlPolicy{in, none} {
+		if access, err := acp.ToAccess(); err == nil {
+			t.Fatalf("expected error, got access %q", access.Describe())
+		} else if _, ok := err.(*cmn.ErrNotImpl); !ok {
+			t.Fatalf("expected not-implemented error, got %v", err)
+		}
+	}
+}
+
+func TestCannedACL(t *testing.T) {
+	tests := []struct {
+		canned string
+		access apc.AccessAttrs
+	}{
+		{"public-read", apc.AccessRO},
+		{"authenticated-read", apc.AccessRO},
+		{"public-read-write", apc.AccessRW},
+	}
+	for _, test := range tests {
+		if access, err := CannedACLToAccess(test.canned); err != nil || access != test.access {
+			t.Fatalf("%s: expected access %q, got %q (err: %v)", test.canned, test.access.Describe(), access.Describe(), err)
+		}
+	}
+	// owner-only: must not be granting access to everyone
+	if _, err := CannedACLToAccess("private"); err == nil {
+		t.Fatal("expected error (canned private ACL)")
+	} else if _, ok := err.(*cmn.ErrNotImpl); !ok {
+		t.Fatalf("expected not-implemented error, got %v", err)
+	}
+	if _, err := CannedACLToAccess("bucket-owner-full-control"); err == nil {
+		t.Fatal("expected error (unsupported canned ACL)")
+	}
+}
@@ -0,0 +1,95 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// Object tagging: tags are stored as object's custom metadata - see cmn.TagObjMDPrefix
+// https://docs.aws.amazon.com/AmazonS3/latest/userguide/object-tagging.html
+
+// limits (same as S3)
+const (
+	MaxObjTags   = 10
+	maxTagKeyLen = 128
+	maxTagValLen = 256
+)
+
+type (
+	Tagging struct {
+		XMLName xml.Name `xml:"Tagging"`
+		Ns      string   `xml:"xmlns,attr,omitempty"`
+		TagSet  []Tag    `xml:"TagSet>Tag"`
+	}
+	Tag struct {
+		Key   string `xml:"Key"`
+		Value string `xml:"Value"`
+	}
+)
+
+func NewTagging(tags cos.StrKVs) *Tagging {
+	tagging := &Tagging{Ns: s3Namespace, TagSet: make([]Tag, 0, len(tags))}
+	for k, v := range tags {
+		tagging.TagSet = append(tagging.TagSet, Tag{Key: k, Value: v})
+	}
+	sort.Slice(tagging.TagSet, func(i, j int) bool { return tagging.TagSet[i].Key < tagging.TagSet[j].Key })
+	return tagging
+}
+
+func (tagging *Tagging) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(tagging)
+	debug.AssertNoErr(err)
+}
+
+func (tagging *Tagging) ToTags() (cos.StrKVs, error) {
+	tags := make(cos.StrKVs, len(tagging.TagSet))
+	for _, tag := range tagging.TagSet {
+		if _, ok := tags[tag.Key]; ok {
+			return nil, fmt.Errorf("duplicate tag key %q", tag.Key)
+		}
+		tags[tag.Key] = tag.Value
+	}
+	return tags, validateTags(tags)
+}
+
+// parse `x-amz-tagging` header (URL-encoded, e.g. "key1=value1&key2=value2")
+func ParseTaggingHdr(hdr string) (cos.StrKVs, error) {
+	q, err := url.ParseQuery(hdr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s header %q: %v", cos.S3HdrTagging, hdr, err)
+	}
+	tags := make(cos.StrKVs, len(q))
+	for k, vs := range q {
+		if len(vs) > 1 {
+			return nil, fmt.Errorf("duplicate tag key %q", k)
+		}
+		tags[k] = vs[0]
+	}
+	return tags, validateTags(tags)
+}
+
+func validateTags(tags cos.StrKVs) error {
+	if len(tags) > MaxObjTags {
+		return fmt.Errorf("too many tags (%d, max %d)", len(tags), MaxObjTags)
+	}
+	for k, v := range tags {
+		if k == "" || len(k) > maxTagKeyLen {
+			return fmt.Errorf("invalid tag key %q (must be 1 to %d characters long)", k, maxTagKeyLen)
+		}
+		if len(v) > maxTagValLen {
+			return fmt.Errorf("tag %q: value is too long (%d, max %d)", k, len(v), maxTagValLen)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+const taggingXML = `<Tagging><TagSet>
+<Tag><Key>project</Key><Value>blue</Value></Tag>
+<Tag><Key>class</Key><Value></Value></Tag>
+</TagSet></Tagging>`
+
+func TestTaggingRoundTrip(t *testing.T) {
+	in := &Tagging{}
+	if err := xml.NewDecoder(strings.NewReader(taggingXML)).Decode(in); err != nil {
+		t.Fatal(err)
+	}
+	tags, err := in.ToTags()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tags) != 2 || tags["project"] != "blue" {
+		t.Fatalf("unexpected tags: %v", tags)
+	}
+
+	// stored along with other custom metadata
+	oa := &cmn.ObjAttrs{}
+	oa.SetCustomKey(cmn.ETag, "abc")
+	oa.SetTags(cos.StrKVs{"stale": "x"})
+	oa.SetTags(tags)
+	if len(oa.GetCustomMD()) != 3 {
+		t.Fatalf("unexpected custom metadata: %v", oa.GetCustomMD())
+	}
+	if s := cmn.Tags2S(oa.GetTags()); s != "class=&project=blue" {
+		t.Fatalf("unexpected tags %q", s)
+	}
+
+	// and back
+	sgl := memsys.PageMM().NewSGL(0)
+	defer sgl.Free()
+	NewTagging(oa.GetTags()).MustMarshal(sgl)
+	out := &Tagging{}
+	if err := xml.NewDecoder(sgl).Decode(out); err != nil {
+		t.Fatal(err)
+	}
+	tags2, err := out.ToTags()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tags2) != len(tags) || tags2["project"] != "blue" {
+		t.Fatalf("expected %v, got %v", tags, tags2)
+	}
+
+	oa.SetTags(nil)
+	if len(oa.GetTags()) != 0 || len(oa.GetCustomMD()) != 1 {
+		t.Fatalf("expected tags to be removed: %v", oa.GetCustomMD())
+	}
+}
+
+func TestTaggingHdr(t *testing.T) {
+	tags, err := ParseTaggingHdr("project=blue&owner=data%20team")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tags) != 2 || tags["owner"] != "data team" {
+		t.Fatalf("unexpected tags: %v", tags)
+	}
+	if _, err := ParseTaggingHdr("a=1&a=2"); err == nil {
+		t.Fatal("expected duplicate key error")
+	}
+	var sb strings.Builder
+	for i := 0; i <= MaxObjTags; i++ {
+		sb.WriteString("k" + strconv.Itoa(i) + "=v&")
+	}
+	if _, err := ParseTaggingHdr(sb.String()); err == nil {
+		t.Fatal("expected too many tags error")
+	}
+}
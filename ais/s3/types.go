@@ -13,7 +13,6 @@ import (
 	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
-	"github.com/NVIDIA/aistore/cluster"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
@@ -187,20 +186,20 @@ func (r *ListObjectResult) FillFromAisBckList(bckList *cmn.LsoResult, lsmsg *apc
 	}
 }
 
-func lomMD5(lom *cluster.LOM) string {
-	if v, exists := lom.GetCustomKey(cmn.SourceObjMD); exists && v == apc.AWS {
-		if v, exists := lom.GetCustomKey(cmn.MD5ObjMD); exists {
+func lomMD5(oah cos.OAH) string {
+	if v, exists := oah.GetCustomKey(cmn.SourceObjMD); exists && v == apc.AWS {
+		if v, exists := oah.GetCustomKey(cmn.MD5ObjMD); exists {
 			return v
 		}
 	}
-	if cksum := lom.Checksum(); cksum.Type() == cos.ChecksumMD5 {
+	if cksum := oah.Checksum(); cksum.Type() == cos.ChecksumMD5 {
 		return cksum.Value()
 	}
 	return ""
 }
 
-func SetETag(header http.Header, lom *cluster.LOM) {
-	if md5val := lomMD5(lom); md5val != "" {
+func SetETag(header http.Header, oah cos.OAH) {
+	if md5val := lomMD5(oah); md5val != "" {
 		header.Set(cos.S3CksumHeader, md5val)
 	}
 }
@@ -0,0 +1,148 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+// List object versions and get (head, delete) a given version.
+// Prior versions are retained by ais:// buckets with `versioning.retain` > 0;
+// ais object version (a decimal number) is returned as S3 `VersionId`.
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_ListObjectVersions.html
+
+const (
+	NoSuchVersion = "NoSuchVersion" // error code
+
+	NullVersionID = "null" // S3 "version" of unversioned objects
+)
+
+type (
+	ListVersionsResult struct {
+		XMLName             xml.Name      `xml:"ListVersionsResult"`
+		Ns                  string        `xml:"xmlns,attr"`
+		Name                string        `xml:"Name"`
+		Prefix              string        `xml:"Prefix"`
+		KeyMarker           string        `xml:"KeyMarker"`
+		VersionIDMarker     string        `xml:"VersionIdMarker"`
+		NextKeyMarker       string        `xml:"NextKeyMarker,omitempty"`
+		NextVersionIDMarker string        `xml:"NextVersionIdMarker,omitempty"`
+		MaxKeys             int           `xml:"MaxKeys"`
+		IsTruncated         bool          `xml:"IsTruncated"`
+		Versions            []*ObjVersion `xml:"Version"`
+	}
+	ObjVersion struct {
+		Key          string `xml:"Key"`
+		VersionID    string `xml:"VersionId"`
+		IsLatest     bool   `xml:"IsLatest"`
+		LastModified string `xml:"LastModified"`
+		ETag         string `xml:"ETag"`
+		Size         int64  `xml:"Size"`
+		Class        string `xml:"StorageClass"`
+	}
+)
+
+func NewListVersionsResult(bucket string, q url.Values) *ListVersionsResult {
+	r := &ListVersionsResult{
+		Ns:              s3Namespace,
+		Name:            bucket,
+		Prefix:          q.Get(QparamPrefix),
+		KeyMarker:       q.Get(QparamKeyMarker),
+		VersionIDMarker: q.Get(QparamVersionIDMarker),
+		MaxKeys:         1000,
+		Versions:        make([]*ObjVersion, 0, 16),
+	}
+	if n, err := strconv.Atoi(q.Get(QparamMaxKeys)); err == nil && n > 0 && n < r.MaxKeys {
+		r.MaxKeys = n
+	}
+	return r
+}
+
+// Returns false if a given object (all its versions) is to be skipped
+// based on the request's prefix and key marker.
+func (r *ListVersionsResult) Wanted(objName string) bool {
+	if !strings.HasPrefix(objName, r.Prefix) {
+		return false
+	}
+	return objName >= r.KeyMarker
+}
+
+func (r *ListVersionsResult) Add(objName, version string, oah cos.OAH, latest bool) {
+	if version == "" {
+		version = NullVersionID
+	}
+	r.Versions = append(r.Versions, &ObjVersion{
+		Key:          objName,
+		VersionID:    version,
+		IsLatest:     latest,
+		LastModified: cos.FormatNanoTime(oah.AtimeUnix(), cos.ISO8601),
+		ETag:         lomMD5(oah),
+		Size:         oah.SizeBytes(),
+	})
+}
+
+// Merge other (partial) results, e.g. received from other targets.
+func (r *ListVersionsResult) Merge(other *ListVersionsResult) {
+	r.Versions = append(r.Versions, other.Versions...)
+	r.IsTruncated = r.IsTruncated || other.IsTruncated
+}
+
+// Sort (by name and then newest to oldest), apply markers, and truncate
+// to `max-keys` setting next markers if need be. Can be called multiple times.
+func (r *ListVersionsResult) Finalize() {
+	sort.Slice(r.Versions, func(i, j int) bool { return r.Versions[i].less(r.Versions[j]) })
+	if r.KeyMarker != "" {
+		var (
+			marker = &ObjVersion{Key: r.KeyMarker, VersionID: r.VersionIDMarker}
+			i      int
+		)
+		if r.VersionIDMarker == "" {
+			// skip the marker key altogether
+			for i < len(r.Versions) && r.Versions[i].Key <= r.KeyMarker {
+				i++
+			}
+		} else {
+			for i < len(r.Versions) && !marker.less(r.Versions[i]) {
+				i++
+			}
+		}
+		r.Versions = r.Versions[i:]
+	}
+	if len(r.Versions) > r.MaxKeys {
+		r.Versions = r.Versions[:r.MaxKeys]
+		r.IsTruncated = true
+	}
+	if r.IsTruncated && len(r.Versions) > 0 {
+		last := r.Versions[len(r.Versions)-1]
+		r.NextKeyMarker, r.NextVersionIDMarker = last.Key, last.VersionID
+	}
+}
+
+func (r *ListVersionsResult) MustMarshal(sgl *memsys.SGL) {
+	sgl.Write([]byte(xml.Header))
+	err := xml.NewEncoder(sgl).Encode(r)
+	debug.AssertNoErr(err)
+}
+
+// by name, and then by version in descending order ("null" being the oldest)
+func (v *ObjVersion) less(other *ObjVersion) bool {
+	if v.Key != other.Key {
+		return v.Key < other.Key
+	}
+	return verNum(v.VersionID) > verNum(other.VersionID)
+}
+
+func verNum(version string) uint64 {
+	n, _ := strconv.ParseUint(version, 10, 64)
+	return n
+}
@@ -0,0 +1,79 @@
+// Package s3 provides Amazon S3 compatibility layer
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package s3
+
+import (
+	"encoding/xml"
+	"net/url"
+	"testing"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/memsys"
+)
+
+func TestListVersions(t *testing.T) {
+	var (
+		q     = url.Values{QparamMaxKeys: []string{"3"}}
+		t1    = NewListVersionsResult("bck", q)
+		t2    = NewListVersionsResult("bck", q)
+		oa    = &cmn.ObjAttrs{Size: 1}
+		names = func(r *ListVersionsResult) (out []string) {
+			for _, v := range r.Versions {
+				out = append(out, v.Key+"@"+v.VersionID)
+			}
+			return
+		}
+	)
+	// two targets
+	t1.Add("b", "10", oa, true)
+	t1.Add("b", "9", oa, false)
+	t1.Add("b", "2", oa, false)
+	t2.Add("a", "", oa, true)
+	t2.Add("c", "1", oa, true)
+	t1.Finalize()
+	t2.Finalize()
+
+	// proxy
+	all := NewListVersionsResult("bck", q)
+	for _, r := range []*ListVersionsResult{t1, t2} {
+		sgl := memsys.PageMM().NewSGL(0)
+		r.MustMarshal(sgl)
+		res := &ListVersionsResult{}
+		err := xml.NewDecoder(sgl).Decode(res)
+		sgl.Free()
+		if err != nil {
+			t.Fatal(err)
+		}
+		all.Merge(res)
+	}
+	all.Finalize()
+	if s := names(all); len(s) != 3 || s[0] != "a@null" || s[1] != "b@10" || s[2] != "b@9" {
+		t.Fatalf("unexpected versions: %v", s)
+	}
+	if !all.IsTruncated || all.NextKeyMarker != "b" || all.NextVersionIDMarker != "9" {
+		t.Fatalf("unexpected truncation: %v, %q, %q", all.IsTruncated, all.NextKeyMarker, all.NextVersionIDMarker)
+	}
+
+	// next page
+	q.Set(QparamKeyMarker, all.NextKeyMarker)
+	q.Set(QparamVersionIDMarker, all.NextVersionIDMarker)
+	next := NewListVersionsResult("bck", q)
+	next.Merge(t1)
+	next.Merge(t2)
+	next.Finalize()
+	if s := names(next); len(s) != 2 || s[0] != "b@2" || s[1] != "c@1" || next.IsTruncated {
+		t.Fatalf("unexpected next page: %v (truncated %v)", s, next.IsTruncated)
+	}
+
+	// key marker only
+	q.Del(QparamVersionIDMarker)
+	next = NewListVersionsResult("bck", q)
+	next.Merge(t1)
+	next.Merge(t2)
+	next.Finalize()
+	if s := names(next); len(s) != 1 || s[0] != "c@1" {
+		t.Fatalf("unexpected versions after key marker: %v", s)
+	}
+}
@@ -46,8 +46,8 @@ func loadMptXattr(fqn string) (out *mpt, err error) {
 }
 
 func storeMptXattr(fqn string, mpt *mpt) (err error) {
-	sort.Slice(mpt.parts, func(i, j int) bool {
-		return mpt.parts[i].Num < mpt.parts[j].Num
+	sort.Slice(mpt.Parts, func(i, j int) bool {
+		return mpt.Parts[i].Num < mpt.Parts[j].Num
 	})
 	b := mpt.pack()
 	return fs.SetXattr(fqn, mptXattrID, b)
@@ -59,7 +59,7 @@ func storeMptXattr(fqn string, mpt *mpt) (err error) {
 
 func (mpt *mpt) _offSorted(name string, num int64) (off, size int64, err error) {
 	var prev = int64(-1)
-	for _, part := range mpt.parts {
+	for _, part := range mpt.Parts {
 		debug.Assert(part.Num > prev) // must ascend
 		if part.Num == num {
 			size = part.Size
@@ -72,7 +72,7 @@ func (mpt *mpt) _offSorted(name string, num int64) (off, size int64, err error)
 }
 
 func (mpt *mpt) packedSize() (size int) {
-	for _, part := range mpt.parts {
+	for _, part := range mpt.Parts {
 		size += cos.SizeofI64 // num
 		size += cos.SizeofLen + len(part.MD5)
 		size += cos.SizeofI64 // part.Size
@@ -82,7 +82,7 @@ func (mpt *mpt) packedSize() (size int) {
 
 func (mpt *mpt) pack() []byte {
 	packer := cos.NewPacker(nil, mpt.packedSize())
-	for _, part := range mpt.parts {
+	for _, part := range mpt.Parts {
 		packer.WriteInt64(part.Num)
 		packer.WriteString(part.MD5)
 		packer.WriteInt64(part.Size)
@@ -92,8 +92,8 @@ func (mpt *mpt) pack() []byte {
 
 func (mpt *mpt) unpack(b []byte) (err error) {
 	unpacker := cos.NewUnpacker(b)
-	debug.Assert(mpt.parts == nil)
-	mpt.parts = make([]*MptPart, 0, iniCapParts)
+	debug.Assert(mpt.Parts == nil)
+	mpt.Parts = make([]*MptPart, 0, iniCapParts)
 	for unpacker.Len() > 0 {
 		part := &MptPart{}
 		if part.Num, err = unpacker.ReadInt64(); err != nil {
@@ -105,13 +105,13 @@ func (mpt *mpt) unpack(b []byte) (err error) {
 		if part.Size, err = unpacker.ReadInt64(); err != nil {
 			break
 		}
-		mpt.parts = append(mpt.parts, part)
+		mpt.Parts = append(mpt.Parts, part)
 	}
 	return
 }
 
 func (mpt *mpt) getPart(num int64) *MptPart {
-	for _, part := range mpt.parts {
+	for _, part := range mpt.Parts {
 		if part.Num == num {
 			return part
 		}
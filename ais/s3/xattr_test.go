@@ -13,22 +13,22 @@ import (
 func TestPackUnpack(t *testing.T) {
 	const nump = 10
 	var (
-		in  = mpt{parts: make([]*MptPart, 0)}
+		in  = mpt{Parts: make([]*MptPart, 0)}
 		out = &mpt{}
 	)
 	for i := int64(0); i < nump; i++ {
-		in.parts = append(in.parts, &MptPart{Num: 111 + i*i, MD5: trand.String(8), Size: 1024 + i})
+		in.Parts = append(in.Parts, &MptPart{Num: 111 + i*i, MD5: trand.String(8), Size: 1024 + i})
 	}
 	b := in.pack()
 	if err := out.unpack(b); err != nil {
 		t.Fatal(err)
 	}
-	if len(in.parts) != len(out.parts) {
-		t.Fatalf("in != out: %d, %d", len(in.parts), len(out.parts))
+	if len(in.Parts) != len(out.Parts) {
+		t.Fatalf("in != out: %d, %d", len(in.Parts), len(out.Parts))
 	}
 	for i := 0; i < nump; i++ {
-		if *in.parts[i] != *out.parts[i] {
-			t.Fatalf("in %v != out %v", *in.parts[i], *out.parts[i])
+		if *in.Parts[i] != *out.Parts[i] {
+			t.Fatalf("in %v != out %v", *in.Parts[i], *out.Parts[i])
 		}
 	}
 }
@@ -49,6 +49,7 @@ import (
 	"github.com/NVIDIA/aistore/volume"
 	"github.com/NVIDIA/aistore/xact"
 	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/NVIDIA/aistore/xact/xs"
 )
 
 const dbName = "ais.db"
@@ -72,6 +73,7 @@ type (
 		res          *res.Res
 		transactions transactions
 		regstate     regstate
+		bhealth      bhealthMgr
 	}
 )
 
@@ -91,6 +93,7 @@ func (*target) rebMarked() xact.Marked { return xreg.GetRebMarked() } // as htex
 func (t *target) initBackends() {
 	config := cmn.GCO.Get()
 	backend.Init(config)
+	backend.InitThrottle(t, t.statsT)
 
 	aisBackend := backend.NewAIS(t)
 	t.backend[apc.AIS] = aisBackend                  // always present
@@ -131,6 +134,8 @@ func (t *target) _initBuiltin() error {
 			add, err = backend.NewAzure(t)
 		case apc.HDFS:
 			add, err = backend.NewHDFS(t)
+		case apc.File:
+			add, err = backend.NewFile(t)
 		case apc.AIS, apc.HTTP:
 			continue
 		default:
@@ -301,13 +306,16 @@ func (t *target) Run() error {
 		glog.Errorln("")
 	}
 
-	// register object type and workfile type
+	// register object, workfile, and prior object version types
 	if err := fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{}); err != nil {
 		cos.ExitLog(err)
 	}
 	if err := fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{}); err != nil {
 		cos.ExitLog(err)
 	}
+	if err := fs.CSM.Reg(fs.ObjVerType, &fs.ObjVerContentResolver{}); err != nil {
+		cos.ExitLog(err)
+	}
 
 	// Init meta-owners and load local instances
 	if prev := t.owner.bmd.init(); prev {
@@ -351,7 +359,10 @@ func (t *target) Run() error {
 		return err
 	}
 
-	dload.SetDB(db)
+	dload.Init(db)
+	if len(dload.Unfinished()) > 0 {
+		go t.goresumedl()
+	}
 
 	archive.Init(config.Features)
 
@@ -368,6 +379,11 @@ func (t *target) Run() error {
 	mirror.Init()
 
 	xreg.RegWithHK()
+	t.regLifecycleHK()
+	t.initWriteBack()
+	t.initReplicate()
+	t.initBackendHealth()
+	t.regMpuCleanupHK()
 
 	marked := xreg.GetResilverMarked()
 	if marked.Interrupted || daemon.resilver.required {
@@ -749,6 +765,14 @@ func (t *target) httpobjput(w http.ResponseWriter, r *http.Request, apireq *apiR
 		t.writeErr(w, r, errdb)
 		return
 	}
+	// object lock: appending (below) modifies existing object (for PUT, see poi.objLock)
+	if apireq.dpq.archpath != "" || apireq.dpq.appendTy != "" {
+		bypass := cos.IsParseBool(r.Header.Get(apc.HdrBypassGovernance))
+		if err := lom.Bprops().ObjLock.Check(lom.Cname(), lom, bypass, started); err != nil {
+			t.writeErr(w, r, err, http.StatusForbidden)
+			return
+		}
+	}
 
 	// do
 	var (
@@ -807,15 +831,18 @@ func (t *target) httpobjdelete(w http.ResponseWriter, r *http.Request, apireq *a
 		return
 	}
 
-	evict := msg.Action == apc.ActEvictObjects
-	lom := cluster.AllocLOM(apireq.items[1])
+	var (
+		evict  = msg.Action == apc.ActEvictObjects
+		bypass = cos.IsParseBool(r.Header.Get(apc.HdrBypassGovernance))
+		lom    = cluster.AllocLOM(apireq.items[1])
+	)
 	if err := lom.InitBck(apireq.bck.Bucket()); err != nil {
 		t.writeErr(w, r, err)
 		cluster.FreeLOM(lom)
 		return
 	}
 
-	errCode, err := t.DeleteObject(lom, evict)
+	errCode, err := t.delObject(lom, evict, bypass)
 	if err == nil {
 		// EC cleanup if EC is enabled
 		ec.ECM.CleanupObject(lom)
@@ -850,11 +877,15 @@ func (t *target) httpobjpost(w http.ResponseWriter, r *http.Request, apireq *api
 	lom := cluster.AllocLOM(apireq.items[1])
 	err = lom.InitBck(apireq.bck.Bucket())
 	if err == nil {
-		err = t.objMv(lom, msg)
+		err = t.objMv(lom, msg, cos.IsParseBool(r.Header.Get(apc.HdrBypassGovernance)))
 	}
-	if err == nil {
+	switch {
+	case err == nil:
 		t.statsT.Inc(stats.RenameCount)
-	} else {
+	case cmn.IsErrObjLocked(err):
+		t.statsT.IncErr(stats.RenameCount)
+		t.writeErr(w, r, err, http.StatusForbidden)
+	default:
 		t.statsT.IncErr(stats.RenameCount)
 		t.writeErr(w, r, err)
 	}
@@ -1146,16 +1177,22 @@ func (t *target) sendECCT(w http.ResponseWriter, r *http.Request, bck *meta.Bck,
 // NOTE: Should be called only if the local copy exists.
 func (t *target) CompareObjects(ctx context.Context, lom *cluster.LOM) (equal bool, errCode int, err error) {
 	var objAttrs *cmn.ObjAttrs
+	if t.bhealth.isOffline(lom.Bck()) {
+		return true, 0, nil // serving cached objects only (see tgtbhealth.go)
+	}
 	objAttrs, errCode, err = t.Backend(lom.Bck()).HeadObj(ctx, lom)
 	if err != nil {
 		err = cmn.NewErrFailedTo(t, "head metadata of", lom, err)
 		return
 	}
-	if lom.Bck().IsHDFS() {
+	switch {
+	case lom.Bck().IsHDFS():
 		equal = true // no versioning in HDFS
-		return
+	case lom.Bck().IsFile():
+		equal = lom.Version(true) == objAttrs.Ver // (see backend.fileVersion)
+	default:
+		equal = lom.Equal(objAttrs)
 	}
-	equal = lom.Equal(objAttrs)
 	return
 }
 
@@ -1272,10 +1309,14 @@ func (t *target) putMirror(lom *cluster.LOM) {
 	xputlrep.Repl(lom)
 }
 
-func (t *target) DeleteObject(lom *cluster.LOM, evict bool) (code int, err error) {
+func (t *target) DeleteObject(lom *cluster.LOM, evict bool) (int, error) {
+	return t.delObject(lom, evict, false /*bypass governance*/)
+}
+
+func (t *target) delObject(lom *cluster.LOM, evict, bypass bool) (code int, err error) {
 	var isback bool
 	lom.Lock(true)
-	code, err, isback = t.delobj(lom, evict)
+	code, err, isback = t.delobj(lom, evict, bypass)
 	lom.Unlock(true)
 
 	// special corner-case retry (quote):
@@ -1290,20 +1331,30 @@ func (t *target) DeleteObject(lom *cluster.LOM, evict bool) (code int, err error
 	}
 	if err == nil {
 		t.statsT.Inc(stats.DeleteCount)
+		if !evict {
+			t.replicate(lom, xs.ReplDel)
+		}
 	} else {
 		t.statsT.IncErr(stats.DeleteCount) // TODO: count GET/PUT/DELETE remote errors separately..
 	}
 	return
 }
 
-func (t *target) delobj(lom *cluster.LOM, evict bool) (int, error, bool) {
+func (t *target) delobj(lom *cluster.LOM, evict, bypass bool) (int, error, bool) {
 	var (
 		aisErr, backendErr         error
 		aisErrCode, backendErrCode int
 		delFromAIS, delFromBackend bool
+		wbPending                  bool
 	)
 	delFromBackend = lom.Bck().IsRemote() && !evict
 	if err := lom.Load(false /*cache it*/, true /*locked*/); err == nil {
+		if err := lom.Bprops().ObjLock.Check(lom.Cname(), lom, bypass, time.Now()); err != nil {
+			return http.StatusForbidden, err, false
+		}
+		if _, wbPending = lom.GetCustomKey(cmn.WriteBackObjMD); wbPending && evict {
+			return http.StatusConflict, fmt.Errorf("cannot evict %s: pending write-back", lom), false
+		}
 		delFromAIS = true
 	} else if !cmn.IsObjNotExist(err) {
 		return 0, err, false
@@ -1316,6 +1367,9 @@ func (t *target) delobj(lom *cluster.LOM, evict bool) (int, error, bool) {
 
 	if delFromBackend {
 		backendErrCode, backendErr = t.Backend(lom.Bck()).DeleteObj(lom)
+		if wbPending && backendErrCode == http.StatusNotFound {
+			backendErrCode, backendErr = 0, nil // not written back yet
+		}
 	}
 	if delFromAIS {
 		size := lom.SizeBytes()
@@ -1335,6 +1389,11 @@ func (t *target) delobj(lom *cluster.LOM, evict bool) (int, error, bool) {
 				cos.NamedVal64{Name: stats.LruEvictCount, Value: 1},
 				cos.NamedVal64{Name: stats.LruEvictSize, Value: size},
 			)
+		} else if lom.Bck().IsAIS() && lom.VersionConf().Retain > 0 {
+			// no delete markers: prior versions go away with the object
+			if err := lom.DelAllVers(); err != nil {
+				glog.Errorf("%s: failed to delete prior versions of %s: %v", t, lom, err)
+			}
 		}
 	}
 	if backendErr != nil {
@@ -1344,7 +1403,7 @@ func (t *target) delobj(lom *cluster.LOM, evict bool) (int, error, bool) {
 }
 
 // rename obj
-func (t *target) objMv(lom *cluster.LOM, msg *apc.ActMsg) error {
+func (t *target) objMv(lom *cluster.LOM, msg *apc.ActMsg, bypass bool) error {
 	if lom.Bck().IsRemote() {
 		return fmt.Errorf("%s: cannot rename object %s from a remote bucket", t.si, lom)
 	}
@@ -1354,6 +1413,14 @@ func (t *target) objMv(lom *cluster.LOM, msg *apc.ActMsg) error {
 	if msg.Name == lom.ObjName {
 		return fmt.Errorf("%s: cannot rename/move object %s onto itself", t.si, lom)
 	}
+	if conf := &lom.Bprops().ObjLock; conf.Enabled() {
+		if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+			return err
+		}
+		if err := conf.Check(lom.Cname(), lom, bypass, time.Now()); err != nil {
+			return err
+		}
+	}
 
 	buf, slab := t.gmm.Alloc()
 	coi := allocCOI()
@@ -1376,6 +1443,7 @@ func (t *target) objMv(lom *cluster.LOM, msg *apc.ActMsg) error {
 		glog.Warningf("%s: failed to delete renamed object %s (new name %s): %v", t, lom, msg.Name, err)
 	}
 	lom.Unlock(true)
+	t.replicate(lom, xs.ReplDel)
 	return nil
 }
 
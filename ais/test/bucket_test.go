@@ -3341,3 +3341,46 @@ func TestListObjectsNoRecursion(t *testing.T) {
 		tassert.Errorf(t, false, "[%s] Invalid number of objects %d (expected %d)", tst.prefix, len(lst.Entries), tst.count)
 	}
 }
+
+func TestBucketObjLockDestroyRename(t *testing.T) {
+	var (
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+		bck        = cmn.Bck{Name: "objlock-" + trand.String(6), Provider: apc.AIS}
+		dstBck     = cmn.Bck{Name: "objlock-dst-" + trand.String(6), Provider: apc.AIS}
+		objName    = "obj"
+		retention  = cos.Duration(time.Hour)
+		disabled   cos.Duration
+	)
+	tools.CreateBucketWithCleanup(t, proxyURL, bck, &cmn.BucketPropsToUpdate{
+		ObjLock: &cmn.ObjLockConfToUpdate{Mode: api.String(cmn.ObjLockGovernance), Retention: &retention},
+	})
+	t.Cleanup(func() {
+		// (governance) disable object lock so that the bucket can be destroyed
+		_, err := api.SetBucketProps(baseParams, bck, &cmn.BucketPropsToUpdate{
+			ObjLock: &cmn.ObjLockConfToUpdate{Mode: api.String(""), Retention: &disabled},
+		})
+		tassert.CheckError(t, err)
+	})
+	r, _ := readers.NewRandReader(cos.KiB, cos.ChecksumNone)
+	_, err := api.PutObject(api.PutArgs{BaseParams: baseParams, Bck: bck, ObjName: objName, Reader: r})
+	tassert.CheckFatal(t, err)
+
+	err = api.DestroyBucket(baseParams, bck)
+	checkObjLocked(t, err, "destroy")
+
+	_, err = api.RenameBucket(baseParams, bck, dstBck)
+	checkObjLocked(t, err, "rename")
+
+	exists, err := api.QueryBuckets(baseParams, cmn.QueryBcks(bck), apc.FltPresent)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, exists, "expected %s to exist", bck)
+	_, err = api.HeadObject(baseParams, bck, objName, apc.FltPresent)
+	tassert.CheckFatal(t, err)
+}
+
+func checkObjLocked(t *testing.T, err error, action string) {
+	tassert.Fatalf(t, err != nil, "expected %s to fail (object lock enabled)", action)
+	herr := cmn.Err2HTTPErr(err)
+	tassert.Fatalf(t, herr != nil && herr.Status == http.StatusForbidden, "expected 403 status, got %v", err)
+}
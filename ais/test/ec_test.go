@@ -10,6 +10,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"testing"
@@ -776,6 +777,170 @@ func createDamageRestoreECFile(t *testing.T, baseParams api.BaseParams, bck cmn.
 	}
 }
 
+// Overwrites the beginning of a given file with random bytes (the size does not change)
+func ecCorruptFile(t *testing.T, fqn string) {
+	fh, err := os.OpenFile(fqn, os.O_WRONLY, cos.PermRWR)
+	tassert.CheckFatal(t, err)
+	b := make([]byte, 16)
+	rand.Read(b)
+	_, err = fh.WriteAt(b, 0)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, fh.Close())
+}
+
+// Removes or corrupts slices and main replicas of erasure coded objects, and
+// checks that ec-scrub finds and repairs all of them
+func TestECScrub(t *testing.T) {
+	var (
+		bck = cmn.Bck{
+			Name:     testBucketName + "-ec-scrub",
+			Provider: apc.AIS,
+		}
+		proxyURL   = tools.RandomProxyURL()
+		baseParams = tools.BaseAPIParams(proxyURL)
+	)
+	o := ecOptions{
+		minTargets: 4,
+		dataCnt:    2,
+		parityCnt:  1,
+		objCount:   4,
+		pattern:    "obj-scrub-%04d",
+		silent:     testing.Short(),
+	}.init(t, proxyURL)
+	initMountpaths(t, proxyURL)
+	newLocalBckWithProps(t, baseParams, bck, defaultECBckProps(o), o)
+
+	var (
+		totalCnt  = 2 + o.sliceTotal()*2
+		objSize   = int64(ecMinBigSize * 2)
+		sliceSize = ec.SliceSize(objSize, o.dataCnt)
+	)
+	for i := 0; i < o.objCount; i++ {
+		objName := fmt.Sprintf(o.pattern, i)
+		foundParts, mainObjPath := createECFile(t, baseParams, bck, objName, o)
+		var slicePath string
+		for fqn := range foundParts {
+			ct, err := cluster.NewCTFromFQN(fqn, nil)
+			tassert.CheckFatal(t, err)
+			if ct.ContentType() == fs.ECSliceType {
+				slicePath = fqn
+				break
+			}
+		}
+		tassert.Fatalf(t, slicePath != "", "no slices of %s", objName)
+		switch i {
+		case 0:
+			tlog.LogfCond(!o.silent, "Removing slice %s\n", slicePath)
+			tassert.CheckFatal(t, os.Remove(slicePath))
+		case 1:
+			tlog.LogfCond(!o.silent, "Corrupting slice %s\n", slicePath)
+			ecCorruptFile(t, slicePath)
+		case 2:
+			tlog.LogfCond(!o.silent, "Corrupting main replica %s\n", mainObjPath)
+			ecCorruptFile(t, mainObjPath)
+		default:
+			// intact
+		}
+	}
+
+	xid, err := api.StartXaction(baseParams, xact.ArgsMsg{Kind: apc.ActECScrub, Bck: bck})
+	tassert.CheckFatal(t, err)
+	xargs := xact.ArgsMsg{ID: xid, Kind: apc.ActECScrub, Timeout: rebalanceTimeout}
+	_, err = api.WaitForXactionIC(baseParams, xargs)
+	tassert.CheckFatal(t, err)
+
+	xs, err := api.QueryXactionSnaps(baseParams, xargs)
+	tassert.CheckFatal(t, err)
+	totals := make(map[string]int64, 3)
+	for _, snaps := range xs {
+		for _, snap := range snaps {
+			ext, ok := snap.Ext.(map[string]any)
+			if !ok {
+				continue
+			}
+			for _, name := range []string{"healthy", "repaired", "unrecoverable"} {
+				if v, ok := ext[name].(string); ok {
+					n, _ := strconv.ParseInt(v, 10, 64)
+					totals[name] += n
+				}
+			}
+		}
+	}
+	tlog.Logf("ec-scrub: %v\n", totals)
+	tassert.Errorf(t, totals["healthy"] == 1 && totals["repaired"] == 3 && totals["unrecoverable"] == 0,
+		"expected 1 healthy and 3 repaired objects, got %v", totals)
+
+	for i := 0; i < o.objCount; i++ {
+		objPath := ecTestDir + fmt.Sprintf(o.pattern, i)
+		foundParts, _ := waitForECFinishes(t, totalCnt, objSize, sliceSize, true, bck, objPath)
+		ecCheckSlices(t, foundParts, bck, objPath, objSize, sliceSize, totalCnt)
+		_, err := api.GetObjectWithValidation(baseParams, bck, objPath, nil)
+		tassert.CheckError(t, err)
+	}
+}
+
+func TestECReencode(t *testing.T) {
+	var (
+		bck = cmn.Bck{
+			Name:     testBucketName + "-ec-reencode",
+			Provider: apc.AIS,
+		}
+		proxyURL   = tools.RandomProxyURL()
+		baseParams = tools.BaseAPIParams(proxyURL)
+	)
+	o := ecOptions{
+		minTargets: 4,
+		dataCnt:    2,
+		parityCnt:  1,
+		objCount:   8,
+		pattern:    "obj-reenc-%04d",
+		silent:     testing.Short(),
+	}.init(t, proxyURL)
+	initMountpaths(t, proxyURL)
+	newLocalBckWithProps(t, baseParams, bck, defaultECBckProps(o), o)
+
+	for i := 0; i < o.objCount; i++ {
+		createECFile(t, baseParams, bck, fmt.Sprintf(o.pattern, i), o)
+	}
+
+	// d=2, p=1 => d=1, p=1 (one less target per object)
+	props := &cmn.BucketPropsToUpdate{EC: &cmn.ECConfToUpdate{DataSlices: api.Int(1)}}
+	_, err := api.SetBucketProps(baseParams, bck, props)
+	tassert.Fatalf(t, err != nil, "expected changing EC data slices to fail without force")
+
+	props.Force = true
+	xid, err := api.SetBucketProps(baseParams, bck, props)
+	tassert.CheckFatal(t, err)
+	xargs := xact.ArgsMsg{ID: xid, Kind: apc.ActECReencode, Timeout: rebalanceTimeout}
+	_, err = api.WaitForXactionIC(baseParams, xargs)
+	tassert.CheckFatal(t, err)
+
+	o.dataCnt = 1
+	var (
+		totalCnt  = 2 + o.sliceTotal()*2
+		objSize   = int64(ecMinBigSize * 2)
+		sliceSize = ec.SliceSize(objSize, o.dataCnt)
+	)
+	for i := 0; i < o.objCount; i++ {
+		objPath := ecTestDir + fmt.Sprintf(o.pattern, i)
+		foundParts, _ := waitForECFinishes(t, totalCnt, objSize, sliceSize, true, bck, objPath)
+		ecCheckSlices(t, foundParts, bck, objPath, objSize, sliceSize, totalCnt)
+		_, err := api.GetObjectWithValidation(baseParams, bck, objPath, nil)
+		tassert.CheckError(t, err)
+	}
+
+	// restarting is a no-op: all objects have been already re-encoded
+	xid, err = api.StartXaction(baseParams, xact.ArgsMsg{Kind: apc.ActECReencode, Bck: bck})
+	tassert.CheckFatal(t, err)
+	xargs = xact.ArgsMsg{ID: xid, Kind: apc.ActECReencode, Timeout: rebalanceTimeout}
+	_, err = api.WaitForXactionIC(baseParams, xargs)
+	tassert.CheckFatal(t, err)
+	snaps, err := api.QueryXactionSnaps(baseParams, xargs)
+	tassert.CheckFatal(t, err)
+	objs, _, _ := snaps.ObjCounts(xid)
+	tassert.Errorf(t, objs == 0, "expected no objects to be re-encoded upon restart, got %d", objs)
+}
+
 // Simple stress testing EC for remote buckets
 func TestECRestoreObjAndSliceRemote(t *testing.T) {
 	var (
@@ -131,6 +131,87 @@ func TestMaintenanceListObjects(t *testing.T) {
 	}
 }
 
+func TestMaintenanceRebalancePauseResume(t *testing.T) {
+	tools.CheckSkip(t, tools.SkipTestArgs{Long: true, MinTargets: 3})
+
+	var (
+		bck = cmn.Bck{Name: "maint-reb-pause", Provider: apc.AIS}
+		m   = &ioContext{
+			t:         t,
+			num:       1500,
+			fileSize:  cos.KiB,
+			fixedSize: true,
+			bck:       bck,
+			proxyURL:  proxyURL,
+		}
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+	)
+
+	m.initWithCleanupAndSaveState()
+	tools.CreateBucketWithCleanup(t, proxyURL, bck, nil)
+	m.puts()
+
+	// slow it down, to make sure that rebalance is still running when paused
+	tools.SetClusterConfig(t, cos.StrKVs{"rebalance.bandwidth": "64KiB"})
+	t.Cleanup(func() {
+		tools.SetClusterConfig(t, cos.StrKVs{"rebalance.bandwidth": "0"})
+	})
+
+	tsi, _ := m.smap.GetRandTarget()
+	tlog.Logf("Put target %s under maintenance\n", tsi.StringEx())
+	actVal := &apc.ActValRmNode{DaemonID: tsi.ID(), SkipRebalance: false}
+	rebID, err := api.StartMaintenance(baseParams, actVal)
+	tassert.CheckFatal(t, err)
+
+	defer func() {
+		rebID, err = api.StopMaintenance(baseParams, actVal)
+		tassert.CheckFatal(t, err)
+		_, err = tools.WaitForClusterState(proxyURL, "target is back",
+			m.smap.Version, m.smap.CountActivePs(), m.smap.CountTargets())
+		args := xact.ArgsMsg{ID: rebID, Timeout: rebalanceTimeout}
+		_, err = api.WaitForXactionIC(baseParams, args)
+		tassert.CheckFatal(t, err)
+	}()
+
+	m.smap, err = tools.WaitForClusterState(proxyURL, "target in maintenance",
+		m.smap.Version, m.smap.CountActivePs(), m.smap.CountActiveTs()-1)
+	tassert.CheckFatal(t, err)
+
+	time.Sleep(2 * time.Second)
+	tlog.Logf("Pause %s[%s]\n", apc.ActRebalance, rebID)
+	tassert.CheckFatal(t, api.PauseRebalance(baseParams))
+
+	// paused: no more objects sent
+	args := xact.ArgsMsg{ID: rebID, Kind: apc.ActRebalance}
+	time.Sleep(2 * time.Second) // (in-flight)
+	xs, err := api.QueryXactionSnaps(baseParams, args)
+	tassert.CheckFatal(t, err)
+	_, before, _ := xs.ObjCounts(rebID)
+	time.Sleep(5 * time.Second)
+	xs, err = api.QueryXactionSnaps(baseParams, args)
+	tassert.CheckFatal(t, err)
+	_, after, _ := xs.ObjCounts(rebID)
+	tassert.Errorf(t, after == before, "paused rebalance keeps sending: %d => %d objects", before, after)
+	aborted, err := xs.IsAborted(rebID)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, !aborted, "paused rebalance must not be aborted")
+
+	tlog.Logf("Resume %s[%s] (sent %d objects so far)\n", apc.ActRebalance, rebID, after)
+	tassert.CheckFatal(t, api.ResumeRebalance(baseParams))
+	tools.SetClusterConfig(t, cos.StrKVs{"rebalance.bandwidth": "0"})
+
+	args.Timeout = rebalanceTimeout
+	_, err = api.WaitForXactionIC(baseParams, args)
+	tassert.CheckFatal(t, err)
+
+	msg := &apc.LsoMsg{}
+	lst, err := api.ListObjects(baseParams, bck, msg, 0)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(lst.Entries) == m.num, "list-object should return %d objects - returned %d",
+		m.num, len(lst.Entries))
+}
+
 func TestMaintenanceMD(t *testing.T) {
 	// NOTE: this test requires local deployment as it checks local filesystem for VMDs.
 	tools.CheckSkip(t, tools.SkipTestArgs{MinTargets: 3, RequiredDeployment: tools.ClusterTypeLocal})
@@ -266,6 +266,80 @@ func TestGetCorruptFileAfterPut(t *testing.T) {
 	tassert.Errorf(t, err != nil, "error is nil, expected error getting corrupted object")
 }
 
+func TestStoreScrub(t *testing.T) {
+	var (
+		m = ioContext{
+			t:        t,
+			num:      4,
+			fileSize: cos.KiB,
+		}
+
+		proxyURL   = tools.RandomProxyURL(t)
+		baseParams = tools.BaseAPIParams(proxyURL)
+	)
+	tools.CheckSkip(t, tools.SkipTestArgs{MinMountpaths: 2})
+	if docker.IsRunning() {
+		t.Skipf("%q requires direct access to mountpaths, doesn't work with docker", t.Name())
+	}
+
+	m.initWithCleanup()
+	initMountpaths(t, proxyURL)
+
+	props := &cmn.BucketPropsToUpdate{
+		Mirror: &cmn.MirrorConfToUpdate{Enabled: api.Bool(true), Copies: api.Int64(2)},
+	}
+	tools.CreateBucketWithCleanup(t, proxyURL, m.bck, props)
+
+	m.puts()
+	// wait for pending writes (of the copies)
+	api.WaitForXactionIdle(baseParams, xact.ArgsMsg{Kind: apc.ActPutCopies, Bck: m.bck})
+
+	// corrupt one of the replicas (main or copy - whichever comes first)
+	objName := m.objNames[0]
+	fqn := findObjOnDisk(m.bck, objName)
+	tlog.Logf("Corrupting object data %q: %s\n", objName, fqn)
+	err := os.WriteFile(fqn, []byte("this file has been corrupted"), cos.PermRWR)
+	tassert.CheckFatal(t, err)
+
+	xid, err := api.StartXaction(baseParams, xact.ArgsMsg{Kind: apc.ActStoreScrub, Bck: m.bck})
+	tassert.CheckFatal(t, err)
+	xargs := xact.ArgsMsg{ID: xid, Kind: apc.ActStoreScrub, Timeout: rebalanceTimeout}
+	_, err = api.WaitForXactionIC(baseParams, xargs)
+	tassert.CheckFatal(t, err)
+
+	xs, err := api.QueryXactionSnaps(baseParams, xargs)
+	tassert.CheckFatal(t, err)
+	totals := make(map[string]int64, 3)
+	for _, snaps := range xs {
+		for _, snap := range snaps {
+			ext, ok := snap.Ext.(map[string]any)
+			if !ok {
+				continue
+			}
+			for _, name := range []string{"corrupted", "restored", "unrecoverable"} {
+				if v, ok := ext[name].(string); ok {
+					n, _ := strconv.ParseInt(v, 10, 64)
+					totals[name] += n
+				}
+			}
+		}
+	}
+	tlog.Logf("scrub: %v\n", totals)
+	tassert.Errorf(t, totals["corrupted"] == 1 && totals["restored"] == 1 && totals["unrecoverable"] == 0,
+		"expected 1 corrupted and 1 restored replica, got %v", totals)
+
+	_, err = api.GetObjectWithValidation(baseParams, m.bck, objName, nil)
+	tassert.CheckError(t, err)
+
+	msg := &apc.LsoMsg{}
+	msg.AddProps(apc.GetPropsName, apc.GetPropsCopies)
+	objList, err := api.ListObjects(baseParams, m.bck, msg, 0)
+	tassert.CheckFatal(t, err)
+	for _, en := range objList.Entries {
+		tassert.Errorf(t, en.Copies == 2, "%s: expecting copies = %d, got %d", en.Name, 2, en.Copies)
+	}
+}
+
 func TestRegressionBuckets(t *testing.T) {
 	var (
 		bck = cmn.Bck{
@@ -176,7 +176,7 @@ func (t *target) listBuckets(w http.ResponseWriter, r *http.Request, qbck *cmn.Q
 
 func (t *target) blist(qbck *cmn.QueryBcks, config *cmn.Config, bmd *bucketMD) (bcks cmn.Bcks, errCode int, err error) {
 	debug.Assert(!qbck.IsAIS())
-	if qbck.IsCloud() || qbck.IsHDFS() { // must be configured
+	if qbck.IsCloud() || qbck.IsHDFS() || qbck.IsFile() { // must be configured
 		if config.Backend.Get(qbck.Provider) == nil {
 			err = &cmn.ErrMissingBackend{Provider: qbck.Provider}
 			return
@@ -188,7 +188,7 @@ func (t *target) blist(qbck *cmn.QueryBcks, config *cmn.Config, bmd *bucketMD) (
 			// otherwise go ahead and try to list below
 		}
 	}
-	if qbck.IsHDFS() { // excepting HDFS (that cannot list buckets)
+	if qbck.IsHDFS() || qbck.IsFile() { // excepting HDFS and file (that cannot list buckets)
 		bcks = bmd.Select(qbck)
 		return
 	}
@@ -345,12 +345,16 @@ func (t *target) httpbckdelete(w http.ResponseWriter, r *http.Request, apireq *a
 	switch msg.Action {
 	case apc.ActEvictRemoteBck:
 		keepMD := cos.IsParseBool(apireq.query.Get(apc.QparamKeepRemote))
-		// HDFS buckets will always keep metadata so they can re-register later
-		if apireq.bck.IsHDFS() || keepMD {
+		// HDFS and file buckets will always keep metadata so they can re-register later
+		if apireq.bck.IsHDFS() || apireq.bck.IsFile() || keepMD {
 			nlp := newBckNLP(apireq.bck)
 			nlp.Lock()
 			defer nlp.Unlock()
 
+			if err := apireq.bck.Props.ObjLock.CheckBck(apireq.bck.Cname(""), msg.Action); err != nil {
+				t.writeErr(w, r, err, http.StatusForbidden)
+				return
+			}
 			err := fs.DestroyBucket(msg.Action, apireq.bck.Bucket(), apireq.bck.Props.BID)
 			if err != nil {
 				t.writeErr(w, r, err)
@@ -430,6 +434,23 @@ func (t *target) httpbckpost(w http.ResponseWriter, r *http.Request, apireq *api
 		rns := xreg.RenewPrefetch(msg.UUID, t, apireq.bck, lrMsg)
 		xctn := rns.Entry.Get()
 		go xctn.Run(nil)
+	case apc.ActSyncBck:
+		smsg := &apc.SyncBckMsg{}
+		if err := cos.MorphMarshal(msg.Value, smsg); err != nil {
+			t.writeErrf(w, r, cmn.FmtErrMorphUnmarshal, t.si, msg.Action, msg.Value, err)
+			return
+		}
+		rns := xreg.RenewBckSync(t, msg.UUID, apireq.bck, smsg)
+		if rns.Err != nil {
+			t.writeErr(w, r, rns.Err)
+			return
+		}
+		xctn := rns.Entry.Get()
+		xctn.AddNotif(&xact.NotifXact{
+			Base: nl.Base{When: cluster.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+			Xact: xctn,
+		})
+		go xctn.Run(nil)
 	default:
 		t.writeErrAct(w, r, msg.Action)
 	}
@@ -462,6 +483,9 @@ func (t *target) httpbckhead(w http.ResponseWriter, r *http.Request, apireq *api
 
 	debug.Assert(!apireq.bck.IsAIS())
 
+	if apireq.bck.Provider == apc.AWS && (apireq.query.Has(apc.QparamS3Endpoint) || apireq.query.Has(apc.QparamS3Profile)) {
+		apireq.bck = s3HeadBck(apireq.bck, apireq.query)
+	}
 	if apireq.bck.IsHTTP() {
 		originalURL := apireq.query.Get(apc.QparamOrigURL)
 		ctx = context.WithValue(ctx, cos.CtxOriginalURL, originalURL)
@@ -503,3 +527,18 @@ func (t *target) httpbckhead(w http.ResponseWriter, r *http.Request, apireq *api
 		hdr.Set(k, v)
 	}
 }
+
+// (not yet applied) S3 endpoint and credentials profile to validate
+func s3HeadBck(bck *meta.Bck, query url.Values) *meta.Bck {
+	var (
+		nbck  = *bck
+		props = &cmn.BucketProps{}
+	)
+	if bck.Props != nil {
+		props = bck.Props.Clone()
+	}
+	props.Extra.AWS.Endpoint = query.Get(apc.QparamS3Endpoint)
+	props.Extra.AWS.Profile = query.Get(apc.QparamS3Profile)
+	nbck.Props = props
+	return &nbck
+}
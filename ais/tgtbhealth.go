@@ -0,0 +1,263 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/ais/backend"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Remote backend health.
+// Each target periodically probes remote backends - via HEAD(bucket) of one of the respective
+// (randomly selected) buckets in the BMD. Health is tracked per remote endpoint rather than
+// per provider: buckets of the same provider may be served by different endpoints (e.g., S3
+// buckets with `extra.aws.endpoint`, or `file://` buckets with different directories), and
+// only those that share the failing endpoint are affected (see bhealthKey).
+// Upon `bhealthMaxFails` consecutive failures, the endpoint is declared offline, and the
+// target switches all affected buckets into serve-cached-only mode:
+// - cold GET, cold HEAD, list-objects, and delete fail fast with 503;
+// - warm GET skips remote version validation (see `validate_warm_get`);
+// - PUT stores the object locally and queues it for write-back (see xs.XactWriteBack).
+// The first successful probe brings the endpoint back online.
+// (Remote AIS clusters are not included - see `apc.WhatRemoteAIS` instead.)
+
+const (
+	bhealthIval     = 30 * time.Second // probing interval
+	bhealthIvalOff  = 10 * time.Second // ditto, when offline
+	bhealthTimeout  = 10 * time.Second // probe timeout
+	bhealthMaxFails = 2                // consecutive failed probes to go offline
+)
+
+type (
+	bhealth struct {
+		stats.BackendHealth
+		stub     cluster.BackendProvider // when offline (see backend.NewOffline)
+		provider string
+		nfail    int
+		probing  bool
+	}
+	bhealthMgr struct {
+		t    *target
+		m    map[string]*bhealth // by endpoint (see bhealthKey)
+		noff atomic.Int32        // num offline (fast path)
+		mu   sync.RWMutex
+	}
+)
+
+func (t *target) initBackendHealth() {
+	t.bhealth.t = t
+	t.bhealth.m = make(map[string]*bhealth, 4)
+	hk.Reg("backend-health"+hk.NameSuffix, t.bhealth.housekeep, bhealthIvalOff)
+}
+
+// remote endpoint that (if offline) will not serve the bucket, and its provider;
+// empty key for ais:// buckets (with no backend), remote AIS, and ht://
+// (the key is also used to report the status - see stats.BackendHealth)
+func bhealthKey(bck *meta.Bck) (key, provider string) {
+	if bck.IsRemoteAIS() || bck.IsHTTP() || !bck.IsRemote() {
+		return
+	}
+	if bck.Props == nil {
+		return bck.Provider, bck.Provider
+	}
+	rbck := bck.RemoteBck() // (with no props when `bck` is ais:// with backend_bck)
+	provider = rbck.Provider
+	key = provider
+	if rbck.Props == nil {
+		return
+	}
+	switch provider {
+	case apc.AWS:
+		if ep := rbck.Props.Extra.AWS.Endpoint; ep != "" {
+			key += "@" + ep
+		}
+	case apc.File:
+		key += "@" + rbck.Props.Extra.File.RefDirectory
+	}
+	return
+}
+
+// returns non-nil stand-in backend when the bucket's endpoint is offline
+func (bm *bhealthMgr) offline(bck *meta.Bck) (stub cluster.BackendProvider) {
+	if bm.noff.Load() == 0 {
+		return
+	}
+	key, _ := bhealthKey(bck)
+	if key == "" {
+		return
+	}
+	bm.mu.RLock()
+	if bh, ok := bm.m[key]; ok {
+		stub = bh.stub
+	}
+	bm.mu.RUnlock()
+	return
+}
+
+func (bm *bhealthMgr) isOffline(bck *meta.Bck) bool { return bm.offline(bck) != nil }
+
+func (bm *bhealthMgr) status() (out map[string]*stats.BackendHealth) {
+	bm.mu.RLock()
+	if len(bm.m) > 0 {
+		out = make(map[string]*stats.BackendHealth, len(bm.m))
+		for key, bh := range bm.m {
+			v := bh.BackendHealth
+			out[key] = &v
+		}
+	}
+	bm.mu.RUnlock()
+	return
+}
+
+func (bm *bhealthMgr) housekeep() time.Duration {
+	if !bm.t.ClusterStarted() {
+		return bhealthIvalOff
+	}
+	var (
+		now  = time.Now().UnixNano()
+		bcks = bm._select(cmn.GCO.Get())
+	)
+	bm.mu.Lock()
+	for key, bck := range bcks {
+		bh, ok := bm.m[key]
+		if !ok {
+			bh = &bhealth{provider: bck.Provider}
+			bm.m[key] = bh
+		}
+		ival := bhealthIval
+		if bh.Offline {
+			ival = bhealthIvalOff
+		}
+		if bh.probing || time.Duration(now-bh.Probed) < ival {
+			continue
+		}
+		bh.probing = true
+		go bm.probe(key, bm.t.backend[bh.provider], bck, bh)
+	}
+	// no longer configured, or no buckets to serve
+	for key, bh := range bm.m {
+		if _, ok := bcks[key]; ok || bh.probing {
+			continue
+		}
+		if bh.Offline {
+			bm.noff.Dec()
+			bm.t.statsT.Add(stats.BackendOffline, -1)
+		}
+		delete(bm.m, key)
+	}
+	bm.mu.Unlock()
+	return bhealthIvalOff
+}
+
+func (bm *bhealthMgr) probe(key string, bp cluster.BackendProvider, bck *meta.Bck, bh *bhealth) {
+	err := _probe(bp, bck)
+	bm.mu.Lock()
+	bm.update(key, bh, err)
+	bm.mu.Unlock()
+}
+
+// the state machine: online => (bhealthMaxFails consecutive failures) => offline => (success) => online
+// (under lock)
+func (bm *bhealthMgr) update(key string, bh *bhealth, err error) {
+	bh.probing = false
+	bh.Probed = time.Now().UnixNano()
+	if err != nil {
+		bh.Err = err.Error()
+		bh.nfail++
+		if bh.Offline || bh.nfail < bhealthMaxFails {
+			return
+		}
+		bh.Offline, bh.Since = true, bh.Probed
+		bh.stub = backend.NewOffline(bh.provider, bm.t.statsT)
+		bm.noff.Inc()
+		bm.t.statsT.Add(stats.BackendOffline, 1)
+		glog.Errorf("%s: backend %q is offline (%v) - serving cached objects only", bm.t, key, err)
+		return
+	}
+	bh.Err, bh.nfail = "", 0
+	if !bh.Offline {
+		if bh.Since == 0 {
+			bh.Since = bh.Probed
+		}
+		return
+	}
+	bh.Offline, bh.Since, bh.stub = false, bh.Probed, nil
+	bm.noff.Dec()
+	bm.t.statsT.Add(stats.BackendOffline, -1)
+	glog.Warningf("%s: backend %q is back online", bm.t, key)
+}
+
+// one (random) bucket per endpoint of each configured (and built) backend provider
+// (NOTE: BMD ranges over maps, in random order)
+func (bm *bhealthMgr) _select(config *cmn.Config) (bcks map[string]*meta.Bck) {
+	bcks = make(map[string]*meta.Bck, 4)
+	bmd := bm.t.owner.bmd.get()
+	bmd.Range(nil, nil, func(b *meta.Bck) bool {
+		if b.Ns.IsRemote() {
+			return false
+		}
+		key, provider := bhealthKey(b)
+		if key == "" {
+			return false
+		}
+		if _, ok := bcks[key]; ok {
+			return false
+		}
+		if _, ok := config.Backend.Providers[provider]; !ok || bm.t.backend[provider] == nil {
+			return false // not configured or not built
+		}
+		if b.IsAIS() {
+			b = meta.CloneBck(b.RemoteBck()) // probe the backend bucket
+		}
+		bcks[key] = b
+		return false
+	})
+	return
+}
+
+// remote backend is considered unreachable upon: timeout, network error, or 5xx
+// (in particular, 4xx means that the backend is up and running)
+func _probe(bp cluster.BackendProvider, bck *meta.Bck) (err error) {
+	type res struct {
+		err     error
+		errCode int
+	}
+	var (
+		ch          = make(chan res, 1)
+		ctx, cancel = context.WithTimeout(context.Background(), bhealthTimeout)
+	)
+	defer cancel()
+	go func() {
+		_, errCode, err := bp.HeadBucket(ctx, bck)
+		ch <- res{err, errCode}
+	}()
+	select {
+	case r := <-ch:
+		if r.err == nil {
+			return nil
+		}
+		if r.errCode == 0 || r.errCode >= http.StatusInternalServerError || cos.IsUnreachable(r.err, r.errCode) ||
+			ctx.Err() != nil {
+			return r.err
+		}
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("probe %s: %w", bck, ctx.Err())
+	}
+}
@@ -0,0 +1,127 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+type testHeadBackend struct {
+	cluster.BackendProvider
+	errCode int
+	err     error
+}
+
+func (b *testHeadBackend) HeadBucket(context.Context, *meta.Bck) (cos.StrKVs, int, error) {
+	return nil, b.errCode, b.err
+}
+
+func testS3Bck(name, endpoint string) *meta.Bck {
+	props := &cmn.BucketProps{Extra: cmn.ExtraProps{AWS: cmn.ExtraPropsAWS{Endpoint: endpoint}}}
+	return meta.NewBck(name, apc.AWS, cmn.NsGlobal, props)
+}
+
+func TestBackendHealthKey(tst *testing.T) {
+	aisWithBackend := meta.NewBck("abc", apc.AIS, cmn.NsGlobal, &cmn.BucketProps{
+		BackendBck: cmn.Bck{Name: "xyz", Provider: apc.AWS, Ns: cmn.NsGlobal},
+	})
+	tests := []struct {
+		bck      *meta.Bck
+		key      string
+		provider string
+	}{
+		{meta.NewBck("abc", apc.AIS, cmn.NsGlobal, &cmn.BucketProps{}), "", ""},
+		{meta.NewBck("abc", apc.AIS, cmn.Ns{UUID: "remais"}, &cmn.BucketProps{}), "", ""},
+		{testS3Bck("abc", ""), apc.AWS, apc.AWS},
+		{testS3Bck("abc", "http://minio:9000"), apc.AWS + "@http://minio:9000", apc.AWS},
+		{aisWithBackend, apc.AWS, apc.AWS},
+		{meta.NewBck("abc", apc.GCP, cmn.NsGlobal, &cmn.BucketProps{}), apc.GCP, apc.GCP},
+		{meta.NewBck("abc", apc.File, cmn.NsGlobal, &cmn.BucketProps{
+			Extra: cmn.ExtraProps{File: cmn.ExtraPropsFile{RefDirectory: "/mnt/nfs"}},
+		}), apc.File + "@/mnt/nfs", apc.File},
+	}
+	for _, test := range tests {
+		key, provider := bhealthKey(test.bck)
+		tassert.Errorf(tst, key == test.key && provider == test.provider, "%s: expected (%q, %q), got (%q, %q)",
+			test.bck, test.key, test.provider, key, provider)
+	}
+}
+
+func TestBackendHealthProbe(tst *testing.T) {
+	var (
+		bck    = testS3Bck("abc", "")
+		errNet = errors.New("connection refused")
+	)
+	tests := []struct {
+		errCode int
+		err     error
+		online  bool
+	}{
+		{0, nil, true},
+		{http.StatusNotFound, errors.New("not found"), true},
+		{http.StatusForbidden, errors.New("forbidden"), true},
+		{http.StatusServiceUnavailable, errors.New("slow down"), false},
+		{0, errNet, false},
+	}
+	for _, test := range tests {
+		err := _probe(&testHeadBackend{errCode: test.errCode, err: test.err}, bck)
+		tassert.Errorf(tst, (err == nil) == test.online, "(%d, %v): expected online=%t, got %v",
+			test.errCode, test.err, test.online, err)
+	}
+}
+
+func TestBackendHealthStateMachine(tst *testing.T) {
+	var (
+		bm = &bhealthMgr{t: t, m: make(map[string]*bhealth)}
+
+		minio    = testS3Bck("abc", "http://minio:9000")
+		other    = testS3Bck("xyz", "")
+		keyMinio = apc.AWS + "@http://minio:9000"
+		bh       = &bhealth{provider: apc.AWS}
+		errNet   = errors.New("connection refused")
+	)
+	bm.m[keyMinio] = bh
+	bm.m[apc.AWS] = &bhealth{provider: apc.AWS}
+
+	bm.update(keyMinio, bh, nil)
+	tassert.Fatalf(tst, !bh.Offline && bh.Since != 0 && bh.Err == "", "expected online, got %+v", bh.BackendHealth)
+
+	// one failure is not enough
+	bm.update(keyMinio, bh, errNet)
+	tassert.Fatalf(tst, !bh.Offline && bh.nfail == 1 && bh.Err != "", "expected online, got %+v", bh.BackendHealth)
+	tassert.Fatalf(tst, !bm.isOffline(minio), "expected %s to be served", minio)
+
+	// offline, affecting only the buckets behind the same endpoint
+	bm.update(keyMinio, bh, errNet)
+	tassert.Fatalf(tst, bh.Offline && bh.stub != nil && bm.noff.Load() == 1, "expected offline, got %+v", bh.BackendHealth)
+	stub := bm.offline(minio)
+	tassert.Fatalf(tst, stub != nil && stub.Provider() == apc.AWS, "expected offline stub for %s", minio)
+	tassert.Errorf(tst, !bm.isOffline(other), "expected %s (different endpoint) to be served", other)
+	since := bh.Since
+
+	// remains offline
+	bm.update(keyMinio, bh, errNet)
+	tassert.Errorf(tst, bh.Offline && bh.Since == since && bm.noff.Load() == 1, "expected offline, got %+v",
+		bh.BackendHealth)
+
+	// back online upon the first success
+	bm.update(keyMinio, bh, nil)
+	tassert.Errorf(tst, !bh.Offline && bh.stub == nil && bh.nfail == 0 && bh.Err == "", "expected online, got %+v",
+		bh.BackendHealth)
+	tassert.Errorf(tst, bm.noff.Load() == 0 && !bm.isOffline(minio), "expected %s to be served", minio)
+
+	st := bm.status()
+	tassert.Errorf(tst, len(st) == 2 && st[keyMinio] != nil && !st[keyMinio].Offline, "unexpected status %v", st)
+}
@@ -0,0 +1,397 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/feat"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/stats"
+)
+
+// Chunked (parallel) cold GET
+//
+// Large remote objects (see cmn.ColdGetConf) get fetched in byte ranges by multiple
+// concurrent workers that write directly into a preallocated workfile. Meanwhile,
+// the caller follows the contiguous written-so-far watermark to compute checksum(s)
+// and, when serving GET request, to stream the first bytes back to the client
+// before the entire object lands. Finally, the checksum is validated (as per
+// bucket's `checksum.validate_cold_get`), and the workfile gets finalized the same
+// way it does for the regular (single-stream) cold GET.
+//
+// There's no separate remote HEAD: the object is first opened the same way the regular
+// cold GET does it (GetObjReader), which yields its size and attributes. Small objects
+// are then simply stored from this single stream, while for the large ones the stream
+// provides the first chunk, and the remaining ones get read in ranges pinned to the
+// same version (or ETag).
+//
+// When the checksum is to be validated the client gets nothing until the validation
+// succeeds - otherwise, a corrupted object could go out with 200 status.
+//
+// Requires the remote backend to implement cluster.RangeReader.
+
+const coldGetRetries = 3 // max attempts per byte range
+
+type (
+	coldGet struct {
+		ctx    context.Context
+		cancel context.CancelFunc
+		rr     cluster.RangeReader
+		mm     *memsys.MMSA
+		lom    *cluster.LOM
+		oa     *cmn.ObjAttrs // remote attrs (to pin the version)
+		expct  *cos.Cksum    // the checksum the remote backend vouches for (nil if none)
+		first  io.ReadCloser // single stream (GetObjReader) to read the first chunk from
+		fh     *os.File      // workfile
+		cond   *sync.Cond
+		done   []bool // by chunk idx
+		err    error  // first error
+		code   int    // and its status code, if any
+		size   int64
+		chunk  int64
+		next   atomic.Int64 // next chunk idx to fetch
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+	}
+	// stream to GET client; keeps consuming (and discarding) upon write error
+	coldTx struct {
+		goi     *getOI
+		err     error
+		written int64
+	}
+)
+
+// returns `done` = false when not configured or not supported, in which case
+// the caller proceeds with the regular cold GET
+func (t *target) getColdChunked(ctx context.Context, lom *cluster.LOM, owt cmn.OWT, goi *getOI) (done bool,
+	errCode int, err error) {
+	var (
+		r        io.ReadCloser
+		expCksum *cos.Cksum
+		size     int64 = -1
+		config         = cmn.GCO.Get()
+		backend        = t.Backend(lom.Bck())
+	)
+	if !config.ColdGet.Enabled() {
+		return
+	}
+	rr, ok := backend.(cluster.RangeReader)
+	if !ok {
+		return
+	}
+	ctx = context.WithValue(ctx, cos.CtxSetSize, cos.SetSizeFunc(func(n int64) { size = n }))
+	if r, expCksum, errCode, err = backend.GetObjReader(ctx, lom); err != nil {
+		return true, errCode, err
+	}
+	done = true
+	if size < int64(config.ColdGet.MinSize) {
+		// small (or unknown size): single stream, same as backend's GetObj
+		params := cluster.AllocPutObjParams()
+		{
+			params.WorkTag = fs.WorkfileColdget
+			params.Reader = r
+			params.OWT = owt
+			params.Cksum = expCksum
+			params.Atime = time.Now()
+		}
+		if err = t.PutObject(lom, params); err != nil {
+			errCode = http.StatusInternalServerError
+		}
+		return
+	}
+
+	workFQN := fs.CSM.Gen(lom, fs.WorkfileType, fs.WorkfileColdget)
+	cg := newColdGet(ctx, rr, t.gmm, lom, size, config.ColdGet.Chunk())
+	cg.first, cg.expct = r, expCksum
+	if cg.fh, err = lom.CreateFile(workFQN); err != nil {
+		cos.Close(r)
+		errCode = http.StatusInternalServerError
+		return
+	}
+
+	var (
+		w      io.Writer
+		tx     *coldTx
+		ckconf = lom.CksumConf()
+	)
+	if goi != nil && goi.ranges.Range == "" && goi.archive.filename == "" && !goi.isGFN &&
+		(expCksum == nil || ckconf.Type == cos.ChecksumNone || !ckconf.ValidateColdGet) {
+		tx = &coldTx{goi: goi}
+		w = tx
+	}
+	errCode, err = cg.do(t, config, w)
+	if err == nil {
+		poi := allocPOI()
+		{
+			poi.t = t
+			poi.lom = lom
+			poi.config = config
+			poi.workFQN = workFQN
+			poi.atime = time.Now().UnixNano()
+			poi.owt = owt
+		}
+		errCode, err = poi.finalize()
+		freePOI(poi)
+	} else if errRm := cos.RemoveFile(workFQN); errRm != nil {
+		glog.Errorf(fmtNested, t, err, "remove", workFQN, errRm)
+	}
+	if tx != nil && err == nil {
+		tx.fini()
+	}
+	return
+}
+
+// (GetObjReader has already set remote attrs => LOM)
+func newColdGet(ctx context.Context, rr cluster.RangeReader, mm *memsys.MMSA, lom *cluster.LOM, size,
+	chunk int64) *coldGet {
+	cg := &coldGet{rr: rr, mm: mm, lom: lom, size: size, chunk: chunk}
+	lom.SetSize(size)
+	cg.oa = &cmn.ObjAttrs{Ver: lom.Version(true), Size: size}
+	for k, v := range lom.GetCustomMD() {
+		cg.oa.SetCustomKey(k, v)
+	}
+	cg.ctx, cg.cancel = context.WithCancel(ctx)
+	cg.cond = sync.NewCond(&cg.mu)
+	cg.done = make([]bool, (cg.size+chunk-1)/chunk)
+	return cg
+}
+
+// fetch all byte ranges while sequentially reading the workfile behind the watermark
+// (`w`, if not nil, is the GET client)
+func (cg *coldGet) do(t *target, config *cmn.Config, w io.Writer) (errCode int, err error) {
+	var (
+		written int64
+		expct   *cos.Cksum
+		store   *cos.CksumHash
+		compt   *cos.CksumHash
+		writers = make([]io.Writer, 0, 3)
+		ckconf  = cg.lom.CksumConf()
+	)
+	// checksums (compare with poi.write)
+	if ckconf.Type != cos.ChecksumNone {
+		store = cos.NewCksumHash(ckconf.Type)
+		writers = append(writers, store.H)
+		if expct = cg.expct; expct != nil && ckconf.ValidateColdGet {
+			if expct.Type() == store.Type() {
+				compt = store
+			} else {
+				compt = cos.NewCksumHash(expct.Type())
+				writers = append(writers, compt.H)
+			}
+		}
+	}
+	if w != nil {
+		writers = append(writers, w)
+	}
+
+	written, err = cg.download(config.ColdGet.Workers(), cos.NewWriterMulti(writers...))
+	if r := cg.takeFirst(0); r != nil { // (not consumed)
+		cos.Close(r)
+	}
+	if err == nil && cmn.Features.IsSet(feat.FsyncPUT) {
+		err = cg.fh.Sync()
+	}
+	cos.Close(cg.fh)
+	if err != nil {
+		if errCode = cg.code; errCode == 0 {
+			errCode = http.StatusInternalServerError
+		}
+		return
+	}
+	debug.Assert(written == cg.size, written, " vs ", cg.size)
+
+	// validate and set
+	if compt != nil {
+		compt.Finalize()
+		if !compt.Equal(expct) {
+			err = cos.NewErrDataCksum(expct, &compt.Cksum, cg.lom.String())
+			t.statsT.AddMany(
+				cos.NamedVal64{Name: stats.ErrCksumCount, Value: 1},
+				cos.NamedVal64{Name: stats.ErrCksumSize, Value: written},
+			)
+			return http.StatusInternalServerError, err
+		}
+	}
+	if store == nil {
+		cg.lom.SetCksum(cos.NoneCksum)
+	} else {
+		if compt != store {
+			store.Finalize()
+		}
+		cg.lom.SetCksum(&store.Cksum)
+	}
+	return
+}
+
+func (cg *coldGet) download(numWorkers int, w io.Writer) (written int64, err error) {
+	if n := len(cg.done); numWorkers > n {
+		numWorkers = n
+	}
+	if err = cg.fh.Truncate(cg.size); err != nil { // preallocate
+		return
+	}
+	for i := 0; i < numWorkers; i++ {
+		cg.wg.Add(1)
+		go cg.work()
+	}
+
+	buf, slab := cg.mm.AllocSize(cg.chunk)
+	for idx := range cg.done {
+		if err = cg.wait(idx); err != nil {
+			break
+		}
+		var (
+			n   int64
+			off = int64(idx) * cg.chunk
+		)
+		n, err = io.CopyBuffer(w, io.NewSectionReader(cg.fh, off, cg.length(off)), buf)
+		written += n
+		if err != nil {
+			break
+		}
+	}
+	slab.Free(buf)
+
+	cg.cancel()
+	cg.wg.Wait()
+	return
+}
+
+func (cg *coldGet) length(off int64) int64 {
+	if n := cg.size - off; n < cg.chunk {
+		return n
+	}
+	return cg.chunk
+}
+
+// wait for the chunk to land
+func (cg *coldGet) wait(idx int) (err error) {
+	cg.mu.Lock()
+	for !cg.done[idx] && cg.err == nil {
+		cg.cond.Wait()
+	}
+	err = cg.err
+	cg.mu.Unlock()
+	return
+}
+
+func (cg *coldGet) work() {
+	buf, slab := cg.mm.AllocSize(cg.chunk)
+	for {
+		idx := cg.next.Inc() - 1
+		if idx >= int64(len(cg.done)) {
+			break
+		}
+		var (
+			errCode int
+			err     = cg.ctx.Err()
+		)
+		if err == nil {
+			errCode, err = cg.fetch(idx*cg.chunk, buf)
+		}
+		cg.mu.Lock()
+		if err == nil {
+			cg.done[idx] = true
+		} else if cg.err == nil {
+			cg.err, cg.code = err, errCode
+			cg.cancel()
+		}
+		cg.cond.Broadcast()
+		cg.mu.Unlock()
+		if err != nil {
+			break
+		}
+	}
+	slab.Free(buf)
+	cg.wg.Done()
+}
+
+func (cg *coldGet) fetch(off int64, buf []byte) (errCode int, err error) {
+	length := cg.length(off)
+	for i := 0; i < coldGetRetries; i++ {
+		var (
+			r io.ReadCloser
+			n int64
+		)
+		if r = cg.takeFirst(off); r == nil {
+			r, errCode, err = cg.rr.GetObjRange(cg.ctx, cg.lom, cg.oa, off, length)
+		}
+		if err == nil {
+			n, err = io.CopyBuffer(io.NewOffsetWriter(cg.fh, off), io.LimitReader(r, length), buf)
+			cos.Close(r)
+			if err == nil && n != length {
+				err = fmt.Errorf("%s: short read at offset %d: %d bytes (expected %d)", cg.lom, off, n, length)
+			}
+			if err == nil {
+				return
+			}
+		}
+		// only retry network and 5xx
+		if cg.ctx.Err() != nil || (errCode != 0 && errCode < http.StatusInternalServerError) {
+			break
+		}
+		glog.Warningf("%s: failed to read range [%d, %d): %v - retrying...", cg.lom, off, off+length, err)
+	}
+	return
+}
+
+// the first chunk comes from the already open single stream (first attempt only)
+func (cg *coldGet) takeFirst(off int64) (r io.ReadCloser) {
+	if off != 0 {
+		return nil
+	}
+	cg.mu.Lock()
+	r, cg.first = cg.first, nil
+	cg.mu.Unlock()
+	return
+}
+
+////////////
+// coldTx //
+////////////
+
+func (tx *coldTx) Write(b []byte) (int, error) {
+	if tx.err != nil {
+		return len(b), nil
+	}
+	goi := tx.goi
+	if !goi.coldSent {
+		hdr := goi.w.Header()
+		cmn.ToHeader(goi.lom.ObjAttrs(), hdr) // (not including checksum - not computed yet)
+		hdr.Set(cos.HdrContentLength, strconv.FormatInt(goi.lom.SizeBytes(true), 10))
+		hdr.Set(cos.HdrContentType, cos.ContentBinary)
+		goi.coldSent = true
+	}
+	n, err := goi.w.Write(b)
+	tx.written += int64(n)
+	if err != nil {
+		tx.err = err
+		glog.Error(cmn.NewErrFailedTo(goi.t, "GET", goi.lom.Cname(), err))
+	}
+	return len(b), nil
+}
+
+func (tx *coldTx) fini() {
+	if tx.err != nil {
+		tx.goi.t.statsT.IncErr(stats.GetCount)
+		return
+	}
+	tx.goi.stats(tx.written)
+}
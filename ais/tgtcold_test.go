@@ -0,0 +1,92 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/memsys"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+// serves byte ranges out of memory, in random order and with random delays
+type testRangeReader struct {
+	data   []byte
+	failAt int64 // offset to fail reading at (-1: never)
+}
+
+func (rr *testRangeReader) GetObjRange(_ context.Context, _ *cluster.LOM, _ *cmn.ObjAttrs, off, length int64) (io.ReadCloser,
+	int, error) {
+	time.Sleep(time.Duration(rand.Intn(10)) * time.Millisecond)
+	if off == rr.failAt {
+		return nil, http.StatusPreconditionFailed, errors.New("precondition failed")
+	}
+	return io.NopCloser(bytes.NewReader(rr.data[off : off+length])), 0, nil
+}
+
+func TestColdGetChunked(tst *testing.T) {
+	lom := cluster.AllocLOM("cold-obj")
+	defer cluster.FreeLOM(lom)
+	tassert.CheckFatal(tst, lom.InitBck(&cmn.Bck{Name: testBucket, Provider: apc.AIS, Ns: cmn.NsGlobal}))
+
+	tests := []struct {
+		size, chunk int64
+		workers     int
+		failAt      int64
+		first       bool // first chunk from the open single stream
+	}{
+		{size: 10*cos.KiB + 1, chunk: cos.KiB, workers: 4, failAt: -1},
+		{size: 10*cos.KiB + 1, chunk: cos.KiB, workers: 4, failAt: -1, first: true},
+		{size: 64 * cos.KiB, chunk: 4 * cos.KiB, workers: 16, failAt: -1},
+		{size: 8 * cos.KiB, chunk: 8 * cos.KiB, workers: 2, failAt: -1, first: true},
+		{size: 64 * cos.KiB, chunk: 4 * cos.KiB, workers: 4, failAt: 40 * cos.KiB},
+		{size: 64 * cos.KiB, chunk: 4 * cos.KiB, workers: 4, failAt: 0, first: true},
+	}
+	for _, test := range tests {
+		var (
+			data = make([]byte, test.size)
+			rr   = &testRangeReader{data: data, failAt: test.failAt}
+			out  = &bytes.Buffer{}
+		)
+		rand.Read(data)
+		fh, err := os.Create(filepath.Join(tst.TempDir(), "workfile"))
+		tassert.CheckFatal(tst, err)
+
+		cg := newColdGet(context.Background(), rr, memsys.PageMM(), lom, test.size, test.chunk)
+		cg.fh = fh
+		if test.first {
+			cg.first = io.NopCloser(bytes.NewReader(data))
+		}
+		written, err := cg.download(test.workers, out)
+		cos.Close(fh)
+
+		if test.failAt >= 0 && !(test.first && test.failAt == 0) {
+			tassert.Fatalf(tst, err != nil, "expected error (%+v)", test)
+			tassert.Errorf(tst, cg.code == http.StatusPreconditionFailed, "expected status %d, got %d",
+				http.StatusPreconditionFailed, cg.code)
+			tassert.Errorf(tst, written <= test.failAt, "written %d past failure at %d", written, test.failAt)
+			continue
+		}
+		tassert.CheckFatal(tst, err)
+		tassert.Fatalf(tst, written == test.size, "written %d, expected %d", written, test.size)
+		tassert.Errorf(tst, bytes.Equal(out.Bytes(), data), "streamed content differs (%+v)", test)
+		b, err := os.ReadFile(fh.Name())
+		tassert.CheckFatal(tst, err)
+		tassert.Errorf(tst, bytes.Equal(b, data), "workfile content differs (%+v)", test)
+	}
+}
@@ -190,6 +190,18 @@ func (t *target) daeputJSON(w http.ResponseWriter, r *http.Request) {
 		}
 		t.termKaliveX(msg.Action)
 		t.decommission(msg.Action, &opts)
+	case apc.ActRebPause:
+		if !t.ensureIntraControl(w, r, true /* from primary */) {
+			return
+		}
+		if err := t.reb.Pause(); err != nil {
+			t.writeErr(w, r, err)
+		}
+	case apc.ActRebResume:
+		if !t.ensureIntraControl(w, r, true /* from primary */) {
+			return
+		}
+		t.reb.Resume()
 	case apc.ActCleanupMarkers:
 		if !t.ensureIntraControl(w, r, true /* from primary */) {
 			return
@@ -304,6 +316,7 @@ func (t *target) httpdaeget(w http.ResponseWriter, r *http.Request) {
 			BuildTime:      daemon.buildTime,
 			K8sPodName:     os.Getenv(env.AIS.K8sPod),
 			Status:         t._status(smap),
+			Backends:       t.bhealth.status(),
 		}
 		// stats and capacity
 		daeStats := t.statsT.GetStats()
@@ -648,6 +661,8 @@ func (t *target) _syncBMD(newBMD *bucketMD, msg *aisMsg, payload msPayload, psi
 			if obck.Props.EC.Enabled && !nbck.Props.EC.Enabled {
 				flt := xreg.Flt{Kind: apc.ActECEncode, Bck: nbck}
 				xreg.DoAbort(flt, errors.New("apply-bmd"))
+				flt.Kind = apc.ActECReencode
+				xreg.DoAbort(flt, errors.New("apply-bmd"))
 			}
 			return true
 		})
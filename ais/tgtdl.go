@@ -41,63 +41,16 @@ func (t *target) downloadHandler(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		var (
-			query            = r.URL.Query()
-			xid              = query.Get(apc.QparamUUID)
-			jobID            = query.Get(apc.QparamJobID)
-			dlb              = dload.Body{}
-			progressInterval = dload.DownloadProgressInterval
+			query = r.URL.Query()
+			xid   = query.Get(apc.QparamUUID)
+			jobID = query.Get(apc.QparamJobID)
+			dlb   = dload.Body{}
 		)
 		debug.Assertf(cos.IsValidUUID(xid) && cos.IsValidUUID(jobID), "%q, %q", xid, jobID)
 		if err := cmn.ReadJSON(w, r, &dlb); err != nil {
 			return
 		}
-
-		dlBodyBase := dload.Base{}
-		if err := jsoniter.Unmarshal(dlb.RawMessage, &dlBodyBase); err != nil {
-			err = fmt.Errorf(cmn.FmtErrUnmarshal, t, "download message", cos.BHead(dlb.RawMessage), err)
-			t.writeErr(w, r, err)
-			return
-		}
-
-		if dlBodyBase.ProgressInterval != "" {
-			dur, err := time.ParseDuration(dlBodyBase.ProgressInterval)
-			if err != nil {
-				t.writeErrf(w, r, "%s: invalid progress interval %q: %v", t, dlBodyBase.ProgressInterval, err)
-				return
-			}
-			progressInterval = dur
-		}
-
-		bck := meta.CloneBck(&dlBodyBase.Bck)
-		if err := bck.Init(t.Bowner()); err != nil {
-			t.writeErr(w, r, err)
-			return
-		}
-
-		xdl, err := t.renewdl(xid)
-		if err != nil {
-			t.writeErr(w, r, err, http.StatusInternalServerError)
-			return
-		}
-		dljob, err := dload.ParseStartRequest(t, bck, jobID, dlb, xdl)
-		if err != nil {
-			t.writeErr(w, r, err)
-			return
-		}
-		if cmn.FastV(4, cos.SmoduleAIS) {
-			glog.Infoln("Downloading: " + dljob.ID())
-		}
-
-		dljob.AddNotif(&dload.NotifDownload{
-			Base: nl.Base{
-				When:     cluster.UponProgress,
-				Interval: progressInterval,
-				Dsts:     []string{equalIC},
-				F:        t.notifyTerm,
-				P:        t.notifyProgress,
-			},
-		}, dljob)
-		response, statusCode, respErr = xdl.Download(dljob)
+		response, statusCode, respErr = t.startdl(xid, jobID, dlb, false /*resumed*/)
 
 	case http.MethodGet:
 		if _, err := t.apiItems(w, r, 0, false, apc.URLPathDownload.L); err != nil {
@@ -185,6 +138,81 @@ func (t *target) downloadHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (t *target) startdl(xid, jobID string, dlb dload.Body, resumed bool) (any, int, error) {
+	var (
+		dlBodyBase       = dload.Base{}
+		progressInterval = dload.DownloadProgressInterval
+	)
+	if err := jsoniter.Unmarshal(dlb.RawMessage, &dlBodyBase); err != nil {
+		err = fmt.Errorf(cmn.FmtErrUnmarshal, t, "download message", cos.BHead(dlb.RawMessage), err)
+		return nil, http.StatusBadRequest, err
+	}
+
+	if dlBodyBase.ProgressInterval != "" {
+		dur, err := time.ParseDuration(dlBodyBase.ProgressInterval)
+		if err != nil {
+			err = fmt.Errorf("%s: invalid progress interval %q: %v", t, dlBodyBase.ProgressInterval, err)
+			return nil, http.StatusBadRequest, err
+		}
+		progressInterval = dur
+	}
+
+	bck := meta.CloneBck(&dlBodyBase.Bck)
+	if err := bck.Init(t.Bowner()); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	xdl, err := t.renewdl(xid)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	dljob, err := dload.ParseStartRequest(t, bck, jobID, dlb, xdl)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	if cmn.FastV(4, cos.SmoduleAIS) {
+		glog.Infoln("Downloading: " + dljob.ID())
+	}
+
+	dljob.AddNotif(&dload.NotifDownload{
+		Base: nl.Base{
+			When:     cluster.UponProgress,
+			Interval: progressInterval,
+			Dsts:     []string{equalIC},
+			F:        t.notifyTerm,
+			P:        t.notifyProgress,
+		},
+	}, dljob)
+	if resumed {
+		// IC may have long stopped listening (e.g., when this target went down) - register
+		// this target as a notifier; IC merges the notifiers of all targets resuming
+		// the same job (see notifs.merge)
+		smap := t.owner.smap.get()
+		dlnl := dload.NewDownloadNL(jobID, string(dlb.Type), &smap.Smap, meta.NodeMap{t.SID(): t.si}, progressInterval)
+		dlnl.SetOwner(equalIC)
+		t.bcastAsyncIC(t.newAmsgActVal(apc.ActListenToNotif, newNLMsg(dlnl)))
+	}
+	return xdl.Download(dljob)
+}
+
+// resume download jobs that were interrupted by the (previous) shutdown
+// (each with its persisted downloader xaction ID)
+func (t *target) goresumedl() {
+	for !t.ClusterStarted() {
+		time.Sleep(cmn.GCO.Get().Periodic.NotifTime.D())
+	}
+	for jobID, job := range dload.Unfinished() {
+		xid := job.XactID
+		if !cos.IsValidUUID(xid) {
+			xid = cos.GenUUID()
+		}
+		glog.Infof("%s: resuming download job %q (xid %q)", t, jobID, xid)
+		if _, _, err := t.startdl(xid, jobID, job.Body, true /*resumed*/); err != nil {
+			glog.Errorf("%s: failed to resume download job %q: %v", t, jobID, err)
+		}
+	}
+}
+
 func (t *target) renewdl(xid string) (*dload.Xact, error) {
 	rns := xreg.RenewDownloader(t, t.statsT, xid)
 	if rns.Err != nil {
@@ -52,6 +52,9 @@ func (t *target) Backend(bck *meta.Bck) cluster.BackendProvider {
 		bp, k := t.backend[provider]
 		debug.Assert(k, provider)
 		if bp != nil {
+			if stub := t.bhealth.offline(bck); stub != nil {
+				return stub
+			}
 			return bp
 		}
 		// nil when configured & not-built
@@ -142,7 +145,12 @@ func (t *target) CopyObject(lom *cluster.LOM, params *cluster.CopyObjectParams,
 	return
 }
 
-func (t *target) GetCold(ctx context.Context, lom *cluster.LOM, owt cmn.OWT) (errCode int, err error) {
+func (t *target) GetCold(ctx context.Context, lom *cluster.LOM, owt cmn.OWT) (int, error) {
+	return t.getCold(ctx, lom, owt, nil)
+}
+
+// non-nil `goi` (GET request) may get served while the object is still being downloaded (see tgtcold.go)
+func (t *target) getCold(ctx context.Context, lom *cluster.LOM, owt cmn.OWT, goi *getOI) (errCode int, err error) {
 	// 1. lock
 	switch owt {
 	case cmn.OwtGetPrefetchLock:
@@ -173,8 +181,12 @@ func (t *target) GetCold(ctx context.Context, lom *cluster.LOM, owt cmn.OWT) (er
 		return
 	}
 
-	// 2. get from remote
-	if errCode, err = t.Backend(lom.Bck()).GetObj(ctx, lom, owt); err != nil {
+	// 2. get from remote: in parallel byte ranges, if configured and applicable; otherwise, single stream
+	var done bool
+	if done, errCode, err = t.getColdChunked(ctx, lom, owt, goi); !done && err == nil {
+		errCode, err = t.Backend(lom.Bck()).GetObj(ctx, lom, owt)
+	}
+	if err != nil {
 		if owt != cmn.OwtGetPrefetchLock {
 			lom.Unlock(true)
 		}
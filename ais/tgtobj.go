@@ -36,6 +36,7 @@ import (
 	"github.com/NVIDIA/aistore/stats"
 	"github.com/NVIDIA/aistore/transport"
 	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/NVIDIA/aistore/xact/xs"
 )
 
 //
@@ -52,6 +53,7 @@ type (
 		config     *cmn.Config   // (during this request)
 		resphdr    http.Header   // as implied
 		workFQN    string        // temp fqn to be renamed
+		retainTo   string        // object lock: user-specified retain-until (apc.HdrObjRetainUntil)
 		atime      int64         // access time
 		size       int64         // aka Content-Length
 		owt        cmn.OWT       // object write transaction enum { OwtPut, ..., OwtGet* }
@@ -59,6 +61,7 @@ type (
 		t2t        bool          // by another target
 		skipEC     bool          // do not erasure-encode when finalizing
 		skipVC     bool          // skip loading existing Version and skip comparing Checksums (skip VC)
+		bypassGov  bool          // object lock: bypass governance-mode retention
 	}
 
 	getOI struct {
@@ -75,6 +78,7 @@ type (
 		unlocked   bool            // internal
 		verchanged bool            // version changed
 		retry      bool            // once
+		coldSent   bool            // chunked cold GET has started transmitting (see tgtcold.go)
 	}
 
 	// append handle (packed)
@@ -135,6 +139,8 @@ func (poi *putOI) do(resphdr http.Header, r *http.Request, dpq *dpq) (int, error
 		poi.workFQN = fs.CSM.Gen(poi.lom, fs.WorkfileType, fs.WorkfilePut)
 		poi.cksumToUse = poi.lom.ObjAttrs().FromHeader(r.Header)
 		poi.owt = cmn.OwtPut // default
+		poi.retainTo = r.Header.Get(apc.HdrObjRetainUntil)
+		poi.bypassGov = cos.IsParseBool(r.Header.Get(apc.HdrBypassGovernance))
 	}
 	if dpq.owt != "" {
 		poi.owt.FromS(dpq.owt)
@@ -159,6 +165,12 @@ func (poi *putOI) do(resphdr http.Header, r *http.Request, dpq *dpq) (int, error
 }
 
 func (poi *putOI) putObject() (errCode int, err error) {
+	if poi.owt == cmn.OwtPut || poi.owt == cmn.OwtFinalize || poi.owt == cmn.OwtPromote {
+		if errCode, err = poi.objLock(); err != nil {
+			cos.Close(poi.r)
+			goto rerr
+		}
+	}
 	// PUT is a no-op if the checksums do match
 	if !poi.skipVC && !poi.cksumToUse.IsEmpty() {
 		if poi.lom.EqCksum(poi.cksumToUse) {
@@ -208,6 +220,46 @@ rerr:
 	return
 }
 
+// object lock: fail to overwrite existing object under retention; set retain-until for the new one
+// (early check to fail fast - see also fini)
+func (poi *putOI) objLock() (int, error) {
+	var (
+		conf = &poi.lom.Bprops().ObjLock
+		now  = time.Now()
+	)
+	if errCode, err := poi.checkRetention(false /*locked*/); err != nil {
+		return errCode, err
+	}
+	until, err := conf.RetainUntil(poi.retainTo, now)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	cmn.SetRetainUntil(poi.lom.ObjAttrs(), until)
+	return 0, nil
+}
+
+// check the existing (current) object, if any
+func (poi *putOI) checkRetention(locked bool) (int, error) {
+	conf := &poi.lom.Bprops().ObjLock
+	if !conf.Enabled() {
+		return 0, nil
+	}
+	cur := cluster.AllocLOM(poi.lom.ObjName)
+	err := cur.InitBck(poi.lom.Bucket())
+	if err == nil {
+		if err = cur.Load(false /*cache it*/, locked); err == nil {
+			err = conf.Check(cur.Cname(), cur, poi.bypassGov, time.Now())
+		} else if cmn.IsObjNotExist(err) {
+			err = nil
+		}
+	}
+	cluster.FreeLOM(cur)
+	if err != nil && cmn.IsErrObjLocked(err) {
+		return http.StatusForbidden, err
+	}
+	return 0, err
+}
+
 func (poi *putOI) loghdr() string {
 	s := poi.owt.String() + ", " + poi.lom.String()
 	if poi.xctn != nil { // may not be showing remote xaction (see doPut)
@@ -246,6 +298,11 @@ func (poi *putOI) finalize() (errCode int, err error) {
 		}
 	}
 	poi.t.putMirror(poi.lom)
+	// replicate new content only (and not, e.g., cold GET or rebalance) - compare with putRemote in fini()
+	// (local copies are replicated by coi - see copyObject and copyReader)
+	if poi.owt == cmn.OwtPut || poi.owt == cmn.OwtFinalize || poi.owt == cmn.OwtPromote {
+		poi.t.replicate(poi.lom, xs.ReplPut)
+	}
 	return
 }
 
@@ -254,9 +311,28 @@ func (poi *putOI) fini() (errCode int, err error) {
 	var (
 		lom = poi.lom
 		bck = lom.Bck()
+		xwb *xs.XactWriteBack
 	)
-	// put remote
-	if bck.IsRemote() && (poi.owt == cmn.OwtPut || poi.owt == cmn.OwtFinalize || poi.owt == cmn.OwtPromote) {
+	// object lock: (re)check the current object under write lock, prior to overwriting
+	// it (remotely, if need be) - the early check (see objLock) could be racing with
+	// concurrent PUT and DELETE
+	objLock := lom.Bprops().ObjLock.Enabled() &&
+		(poi.owt == cmn.OwtPut || poi.owt == cmn.OwtFinalize || poi.owt == cmn.OwtPromote)
+	if objLock {
+		lom.Lock(true) // (the default locking strategy - see below)
+		defer lom.Unlock(true)
+		if errCode, err = poi.checkRetention(true /*locked*/); err != nil {
+			return
+		}
+	}
+	// put remote (or write back later)
+	if wb := poi.writeBack(); wb {
+		if xwb, err = poi.t.renewWriteBack(bck); err != nil {
+			return
+		}
+		lom.ObjAttrs().DelCustomKeys(cmn.SourceObjMD, cmn.CRC32CObjMD, cmn.ETag, cmn.MD5ObjMD, cmn.VersionObjMD)
+		lom.SetCustomKey(cmn.WriteBackObjMD, "pending")
+	} else if bck.IsRemote() && (poi.owt == cmn.OwtPut || poi.owt == cmn.OwtFinalize || poi.owt == cmn.OwtPromote) {
 		errCode, err = poi.putRemote()
 		if err != nil {
 			loghdr := poi.loghdr()
@@ -290,14 +366,20 @@ func (poi *putOI) fini() (errCode int, err error) {
 	default:
 		// expecting valid atime passed with `poi`
 		debug.Assert(cos.IsValidAtime(poi.atime), poi.atime)
-		lom.Lock(true)
-		defer lom.Unlock(true)
+		if !objLock {
+			lom.Lock(true)
+			defer lom.Unlock(true)
+		}
 		lom.SetAtimeUnix(poi.atime)
 	}
 
 	// ais versioning
 	if bck.IsAIS() && lom.VersionConf().Enabled {
 		if poi.owt == cmn.OwtPut || poi.owt == cmn.OwtFinalize || poi.owt == cmn.OwtPromote {
+			// retain the current generation (versioning.retain > 0)
+			if err = lom.RetainVer(); err != nil {
+				return
+			}
 			if poi.skipVC {
 				err = lom.IncVersion()
 				debug.Assert(err == nil)
@@ -309,6 +391,13 @@ func (poi *putOI) fini() (errCode int, err error) {
 		}
 	}
 
+	// journal prior to making the new content visible
+	if xwb != nil {
+		if err = xwb.Add(lom); err != nil {
+			return
+		}
+	}
+
 	// done
 	if err = lom.RenameFrom(poi.workFQN); err != nil {
 		return
@@ -325,6 +414,25 @@ func (poi *putOI) fini() (errCode int, err error) {
 	return
 }
 
+// write-back: remote bucket with `write_policy.data` = "delayed" or offline backend;
+// in addition, migrated objects that are still pending write-back (see xs.XactWriteBack)
+func (poi *putOI) writeBack() bool {
+	lom := poi.lom
+	if !lom.Bck().IsRemote() {
+		return false
+	}
+	switch poi.owt {
+	case cmn.OwtPut, cmn.OwtFinalize, cmn.OwtPromote:
+		// NOTE: queued for write-back while the backend is offline (see tgtbhealth.go)
+		return lom.Bprops().WritePolicy.Data == apc.WriteDelayed || poi.t.bhealth.isOffline(lom.Bck())
+	case cmn.OwtMigrate:
+		_, pending := lom.GetCustomKey(cmn.WriteBackObjMD)
+		return pending
+	default:
+		return false
+	}
+}
+
 // via backend.PutObj()
 func (poi *putOI) putRemote() (errCode int, err error) {
 	var (
@@ -545,7 +653,7 @@ do:
 			}
 			goto fin
 		}
-	} else if goi.lom.Bck().IsRemote() && goi.lom.VersionConf().ValidateWarmGet { // check remote version
+	} else if goi.lom.Bck().IsRemote() && goi.lom.VersionConf().ValidateWarmGet && !goi.wbPending() { // check remote version
 		var equal bool
 		goi.lom.Unlock(false)
 		if equal, errCode, err = goi.t.CompareObjects(goi.ctx, goi.lom); err != nil {
@@ -585,10 +693,16 @@ do:
 		}
 		goi.lom.SetAtimeUnix(goi.atime)
 		// (will upgrade rlock => wlock)
-		if errCode, err = goi.t.GetCold(goi.ctx, goi.lom, cmn.OwtGet); err != nil {
+		if errCode, err = goi.t.getCold(goi.ctx, goi.lom, cmn.OwtGet, goi); err != nil {
 			goi.unlocked = true
+			if goi.coldSent {
+				err = errSendingResp
+			}
 			return
 		}
+		if goi.coldSent {
+			return // done
+		}
 	}
 
 	// read locally and stream back
@@ -610,6 +724,12 @@ fin:
 	return
 }
 
+// (not yet written to remote backend - nothing to compare with)
+func (goi *getOI) wbPending() (pending bool) {
+	_, pending = goi.lom.GetCustomKey(cmn.WriteBackObjMD)
+	return
+}
+
 // - validate checksums
 // - if corrupted and IsAIS, try to recover from redundant replicas or EC slices
 // - otherwise, rely on the remote backend for recovery (tradeoff; TODO: make it configurable)
@@ -974,7 +1094,11 @@ func (goi *getOI) transmit(r io.Reader, buf []byte, fqn string, coldGet bool) er
 	if goi.isGFN {
 		goi.t.reb.FilterAdd([]byte(goi.lom.Uname()))
 	}
-	// stats
+	goi.stats(written)
+	return nil
+}
+
+func (goi *getOI) stats(written int64) {
 	goi.t.statsT.AddMany(
 		cos.NamedVal64{Name: stats.GetThroughput, Value: written},
 		cos.NamedVal64{Name: stats.GetLatency, Value: mono.SinceNano(goi.latency)},
@@ -986,7 +1110,6 @@ func (goi *getOI) transmit(r io.Reader, buf []byte, fqn string, coldGet bool) er
 			cos.NamedVal64{Name: stats.VerChangeSize, Value: goi.lom.SizeBytes()},
 		)
 	}
-	return nil
 }
 
 // parse & validate user-spec-ed goi.ranges, and set response header
@@ -1216,6 +1339,7 @@ func (coi *copyOI) copyObject(lom *cluster.LOM, objNameTo string) (size int64, e
 		size = lom.SizeBytes()
 		if coi.finalize {
 			coi.t.putMirror(dst2)
+			coi.t.replicate(dst2, xs.ReplPut)
 		}
 	}
 	err = err2
@@ -1293,11 +1417,15 @@ func (coi *copyOI) putReader(lom, dst *cluster.LOM) (size int64, err error) {
 		}
 		params.Atime = lom.Atime()
 	}
+	owt := params.OWT
 	err = coi.t.PutObject(dst, params)
 	cluster.FreePutObjParams(params)
 	if err != nil {
 		return
 	}
+	if owt == cmn.OwtMigrate {
+		coi.t.replicate(dst, xs.ReplPut) // (local copy - not replicated by poi.finalize)
+	}
 	// xaction stats: inc locally processed (and see data mover for in and out objs)
 	size = oah.SizeBytes()
 	return
@@ -1604,6 +1732,7 @@ func (a *putA2I) finalize(size int64, cksum *cos.Cksum, fqn string) error {
 		}
 	}
 	a.t.putMirror(a.lom)
+	a.t.replicate(a.lom, xs.ReplPut)
 	return nil
 }
 
@@ -0,0 +1,83 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/NVIDIA/aistore/xact/xs"
+)
+
+// how often to check for change log records yet to be replicated that are not
+// being handled by any running xaction (e.g., upon restart)
+const replIval = time.Minute
+
+func (t *target) renewReplicate(bck *meta.Bck) (*xs.XactReplicate, error) {
+	rns := xreg.RenewReplicate(t, bck, t.backend[apc.AIS])
+	if rns.Err != nil {
+		return nil, rns.Err
+	}
+	xctn := rns.Entry.Get()
+	return xctn.(*xs.XactReplicate), nil
+}
+
+// record PUT or DELETE in the change log of a replicated bucket (see cmn.ReplicationConf)
+func (t *target) replicate(lom *cluster.LOM, op byte) {
+	if !lom.Bprops().Replication.Enabled() {
+		return
+	}
+	xrepl, err := t.renewReplicate(lom.Bck())
+	if err == nil {
+		err = xrepl.Add(lom, op)
+	}
+	if err != nil {
+		glog.Errorf("%s: failed to replicate %s: %v", t, lom, err)
+	}
+}
+
+func (t *target) initReplicate() {
+	xs.ReplInit()
+	hk.Reg(apc.ActReplicate+hk.NameSuffix, t.replicateHK, replIval)
+}
+
+func (t *target) replicateHK() time.Duration {
+	if !t.ClusterStarted() {
+		return replIval
+	}
+	for _, b := range xs.ReplOrphans() {
+		bck := meta.CloneBck(&b)
+		if err := bck.Init(t.owner.bmd); err != nil {
+			if cmn.IsErrBckNotFound(err) {
+				n := xs.ReplDiscard(&b)
+				glog.Warningf("%s: %v - discarded %d change log record%s", t, err, n, cos.Plural(n))
+			} else {
+				glog.Errorf("%s: %v", t, err)
+			}
+			continue
+		}
+		if !bck.Props.Replication.Enabled() {
+			n := xs.ReplDiscard(&b)
+			glog.Warningf("%s: %s is not replicated - discarded %d change log record%s", t, bck, n, cos.Plural(n))
+			continue
+		}
+		xrepl, err := t.renewReplicate(bck)
+		if err != nil {
+			glog.Errorf("%s: %s: %v", t, bck, err)
+			continue
+		}
+		if n := xrepl.Claim(); n > 0 {
+			glog.Infof("%s: resuming replication of %d change log record%s", xrepl, n, cos.Plural(n))
+		}
+	}
+	return replIval
+}
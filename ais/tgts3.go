@@ -6,10 +6,14 @@ package ais
 
 import (
 	"context"
+	"encoding/xml"
 	"fmt"
+	"io"
 	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/NVIDIA/aistore/ais/s3"
@@ -29,6 +33,9 @@ func (t *target) s3Handler(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		return
 	}
+	if len(apiItems) > 0 && s3.HandleCORS(w, r, apiItems[0], t.owner.bmd) {
+		return
+	}
 	switch r.Method {
 	case http.MethodHead:
 		t.headObjS3(w, r, apiItems)
@@ -38,9 +45,14 @@ func (t *target) s3Handler(w http.ResponseWriter, r *http.Request) {
 		t.putCopyMpt(w, r, apiItems)
 	case http.MethodDelete:
 		q := r.URL.Query()
-		if q.Has(s3.QparamMptUploadID) {
+		switch {
+		case q.Has(s3.QparamMptUploadID):
 			t.abortMptUpload(w, r, apiItems, q)
-		} else {
+		case q.Has(s3.QparamTagging):
+			t.delObjTaggingS3(w, r, apiItems)
+		case q.Has(s3.QparamVersionID):
+			t.delObjVerS3(w, r, apiItems, q.Get(s3.QparamVersionID))
+		default:
 			t.delObjS3(w, r, apiItems)
 		}
 	case http.MethodPost:
@@ -51,7 +63,7 @@ func (t *target) s3Handler(w http.ResponseWriter, r *http.Request) {
 }
 
 // PUT /s3/<bucket-name>/<object-name>
-// [switch] mpt | put | copy
+// [switch] tagging | mpt | put | copy
 func (t *target) putCopyMpt(w http.ResponseWriter, r *http.Request, items []string) {
 	if cs := fs.Cap(); cs.OOS {
 		s3.WriteErr(w, r, cs.Err, http.StatusInsufficientStorage)
@@ -64,76 +76,89 @@ func (t *target) putCopyMpt(w http.ResponseWriter, r *http.Request, items []stri
 	}
 	q := r.URL.Query()
 	switch {
+	case q.Has(s3.QparamTagging):
+		t.putObjTaggingS3(w, r, items, bck)
 	case q.Has(s3.QparamMptPartNo) && q.Has(s3.QparamMptUploadID):
-		if r.Header.Get(cos.S3HdrObjSrc) != "" {
-			t.putMptCopy(w, r, items)
-		} else {
-			t.putMptPart(w, r, items, q, bck)
-		}
+		t.putMptPart(w, r, items, q, bck) // (including UploadPartCopy)
 	case r.Header.Get(cos.S3HdrObjSrc) == "":
 		t.putObjS3(w, r, items, bck)
 	default:
-		t.copyObjS3(w, r, items)
+		t.copyObjS3(w, r, items, bck)
 	}
 }
 
 // Copy object (maybe from another bucket)
+// NOTE: executed by the destination's HRW target that reads the source - locally
+// or from the source's HRW target via intra-cluster data network (see `openCopySrc`)
 // https://docs.aws.amazon.com/AmazonS3/latest/API/API_CopyObject.html
-func (t *target) copyObjS3(w http.ResponseWriter, r *http.Request, items []string) {
+func (t *target) copyObjS3(w http.ResponseWriter, r *http.Request, items []string, bck *meta.Bck) {
 	if len(items) < 2 {
 		s3.WriteErr(w, r, errS3Obj, 0)
 		return
 	}
-	src := r.Header.Get(cos.S3HdrObjSrc)
-	src = strings.Trim(src, "/") // in AWS examples the path starts with "/"
-	parts := strings.SplitN(src, "/", 2)
-	if len(parts) < 2 {
-		s3.WriteErr(w, r, errS3Obj, 0)
-		return
-	}
-	// src
-	bckSrc, err, errCode := meta.InitByNameOnly(parts[0], t.owner.bmd)
+	replaceMD, err := s3.ParseDirective(r.Header, cos.S3HdrMetadataDirective)
 	if err != nil {
-		s3.WriteErr(w, r, err, errCode)
+		s3.WriteErr(w, r, err, 0)
 		return
 	}
-	objSrc := strings.Trim(parts[1], "/")
-	if err := bckSrc.Init(t.owner.bmd); err != nil {
+	replaceTags, err := s3.ParseDirective(r.Header, cos.S3HdrTaggingDirective)
+	if err != nil {
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
-	lom := cluster.AllocLOM(objSrc)
-	defer cluster.FreeLOM(lom)
-	if err := lom.InitBck(bckSrc.Bucket()); err != nil {
-		if cmn.IsErrRemoteBckNotFound(err) {
-			t.BMDVersionFixup(r)
-			err = lom.InitBck(bckSrc.Bucket())
-		}
-		if err != nil {
-			s3.WriteErr(w, r, err, 0)
-		}
+	lomSrc, version, errCode, err := t.initCopySrc(r)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
 		return
 	}
-	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
-		s3.WriteErr(w, r, err, 0)
+	defer cluster.FreeLOM(lomSrc)
+	objName := s3.ObjName(items)
+	if lomSrc.Bck().Equal(bck, true, true) && lomSrc.ObjName == objName && version == "" && !replaceMD && !replaceTags {
+		s3.WriteErr(w, r, s3.ErrCopyToSelf, 0)
 		return
 	}
-	// dst
-	bckDst, err, errCode := meta.InitByNameOnly(items[0], t.owner.bmd)
+	src, errCode, err := t.openCopySrc(lomSrc, version, "" /*range*/)
 	if err != nil {
 		s3.WriteErr(w, r, err, errCode)
 		return
 	}
-	coi := allocCOI()
+
+	// dst
+	lom := cluster.AllocLOM(objName)
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		cos.Close(src.r)
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	lom.SetCustomMD(src.oa.GetCustomMD())
+	if replaceMD {
+		s3.SetUserMD(lom.ObjAttrs(), r.Header)
+	}
+	if replaceTags {
+		var tags cos.StrKVs
+		if hdr := r.Header.Get(cos.S3HdrTagging); hdr != "" {
+			if tags, err = s3.ParseTaggingHdr(hdr); err != nil {
+				cos.Close(src.r)
+				s3.WriteErr(w, r, err, 0)
+				return
+			}
+		}
+		lom.ObjAttrs().SetTags(tags)
+	}
+	started := time.Now()
+	lom.SetAtimeUnix(started.UnixNano())
+	params := cluster.AllocPutObjParams()
 	{
-		coi.t = t
-		coi.BckTo = bckDst
-		coi.owt = cmn.OwtMigrate
+		params.WorkTag = fs.WorkfileCopy
+		params.Reader = src.r
+		params.OWT = cmn.OwtPut
+		params.Atime = started
 	}
-	objName := s3.ObjName(items)
-	_, err = coi.copyObject(lom, objName)
-	freeCOI(coi)
+	err = t.PutObject(lom, params)
+	cluster.FreePutObjParams(params)
 	if err != nil {
+		t.fsErr(err, lom.FQN)
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
@@ -146,13 +171,177 @@ func (t *target) copyObjS3(w http.ResponseWriter, r *http.Request, items []strin
 		LastModified: cos.FormatNanoTime(lom.AtimeUnix(), cos.ISO8601),
 		ETag:         cksumValue,
 	}
+	hdr := w.Header()
+	if version != "" {
+		hdr.Set(cos.S3HdrObjSrcVersion, version)
+	}
+	if bck.IsAIS() && lom.VersionConf().Enabled {
+		hdr.Set(cos.S3VersionHeader, lom.Version())
+	}
 	sgl := t.gmm.NewSGL(0)
 	result.MustMarshal(sgl)
-	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	hdr.Set(cos.HdrContentType, cos.ContentXML)
 	sgl.WriteTo(w)
 	sgl.Free()
 }
 
+// s3CopySrc is the source of the server-side copy (CopyObject and UploadPartCopy)
+type s3CopySrc struct {
+	r  io.ReadCloser
+	oa cmn.ObjAttrs // source object's attributes (note: size is the size of the object or its range)
+}
+
+// parse `x-amz-copy-source` and initialize (source) LOM
+func (t *target) initCopySrc(r *http.Request) (lom *cluster.LOM, version string, errCode int, err error) {
+	bucket, objName, version, err := s3.ParseCopySource(r.Header.Get(cos.S3HdrObjSrc))
+	if err != nil {
+		return nil, "", 0, err
+	}
+	bck, err, errCode := meta.InitByNameOnly(bucket, t.owner.bmd)
+	if err != nil {
+		return nil, "", errCode, err
+	}
+	if version != "" && !bck.IsAIS() {
+		err = fmt.Errorf("%s: object versions are only supported for ais:// buckets", bck)
+		return nil, "", http.StatusNotImplemented, err
+	}
+	lom = cluster.AllocLOM(objName)
+	if err = lom.InitBck(bck.Bucket()); err != nil {
+		if cmn.IsErrRemoteBckNotFound(err) {
+			t.BMDVersionFixup(r)
+			err = lom.InitBck(bck.Bucket())
+		}
+		if err != nil {
+			cluster.FreeLOM(lom)
+			return nil, "", 0, err
+		}
+	}
+	return lom, version, 0, nil
+}
+
+// Open copy source (the entire object or its range) for reading - locally if this target
+// is the source's HRW target, or else from the latter via intra-cluster data network
+func (t *target) openCopySrc(lom *cluster.LOM, version, rng string) (*s3CopySrc, int, error) {
+	smap := t.owner.smap.get()
+	tsi, err := cluster.HrwTarget(lom.Uname(), &smap.Smap)
+	if err != nil {
+		return nil, 0, err
+	}
+	hrw := tsi.ID() == t.SID()
+	if !hrw {
+		src, errCode, err := t.openRemoteCopySrc(lom, tsi, version, rng)
+		if errCode != http.StatusNotFound || version == "" {
+			return src, errCode, err
+		}
+		// retained version that may be stored elsewhere, including this target (see headObjVerBcast)
+		if tsi = t.headObjVerBcast(lom, version); tsi != nil {
+			return t.openRemoteCopySrc(lom, tsi, version, rng)
+		}
+	}
+
+	var (
+		src = &s3CopySrc{}
+		fqn string
+	)
+	if version != "" && !isCurVer(lom, version) {
+		ov, err := lom.LoadVer(version)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				return nil, 0, err
+			}
+			if hrw {
+				if tsi := t.headObjVerBcast(lom, version); tsi != nil {
+					return t.openRemoteCopySrc(lom, tsi, version, rng)
+				}
+			}
+			return nil, http.StatusNotFound, s3.NewErrNoSuchVersion(lom.Cname(), version)
+		}
+		src.oa.CopyFrom(&ov.ObjAttrs, true /*skip cksum*/)
+		fqn = ov.FQN
+	} else {
+		if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+			if !cmn.IsObjNotExist(err) {
+				return nil, 0, err
+			}
+			if lom.Bck().IsAIS() {
+				return nil, http.StatusNotFound, err
+			}
+			// cold GET
+			if errCode, err := t.GetCold(context.Background(), lom, cmn.OwtGetLock); err != nil {
+				return nil, errCode, err
+			}
+		}
+		lom.Lock(false)
+		defer lom.Unlock(false)
+		if err := lom.Load(true /*cache it*/, true /*locked*/); err != nil {
+			return nil, 0, err
+		}
+		src.oa.CopyFrom(lom, true /*skip cksum*/)
+		fqn = lom.FQN
+	}
+	if rng == "" {
+		fh, err := cos.NewFileHandle(fqn)
+		if err != nil {
+			return nil, 0, err
+		}
+		src.r = fh
+		return src, 0, nil
+	}
+	offset, length, err := s3.ParseCopyRange(rng, src.oa.Size)
+	if err != nil {
+		return nil, http.StatusRequestedRangeNotSatisfiable, err
+	}
+	if src.r, err = cos.NewFileSectionHandle(fqn, offset, length); err != nil {
+		return nil, 0, err
+	}
+	src.oa.Size = length
+	return src, 0, nil
+}
+
+func (t *target) openRemoteCopySrc(lom *cluster.LOM, tsi *meta.Snode, version, rng string) (*s3CopySrc, int, error) {
+	reqArgs := cmn.AllocHra()
+	{
+		reqArgs.Method = http.MethodGet
+		reqArgs.Base = tsi.URL(cmn.NetIntraData)
+		reqArgs.Header = http.Header{
+			apc.HdrCallerID:   []string{t.SID()},
+			apc.HdrCallerName: []string{t.callerName()},
+		}
+		if rng != "" {
+			reqArgs.Header.Set(cos.HdrRange, rng)
+		}
+		if version == "" {
+			reqArgs.Path = apc.URLPathObjects.Join(lom.Bck().Name, lom.ObjName)
+			reqArgs.Query = lom.Bck().AddToQuery(nil)
+		} else {
+			// retained versions are local to the target that stores them
+			reqArgs.Path = apc.URLPathS3.Join(lom.Bck().Name, lom.ObjName)
+			reqArgs.Query = url.Values{s3.QparamVersionID: []string{version}}
+		}
+	}
+	req, err := reqArgs.Req()
+	cmn.FreeHra(reqArgs)
+	if err != nil {
+		return nil, 0, err
+	}
+	resp, err := t.client.data.Do(req) //nolint:bodyclose // closed by the caller
+	if err != nil {
+		return nil, 0, err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		b := cmn.NewBuffer()
+		b.ReadFrom(resp.Body)
+		resp.Body.Close()
+		err = cmn.S2HTTPErr(req, b.String(), resp.StatusCode)
+		cmn.FreeBuffer(b)
+		return nil, resp.StatusCode, err
+	}
+	src := &s3CopySrc{r: resp.Body}
+	src.oa.FromHeader(resp.Header)
+	src.oa.Size = resp.ContentLength
+	return src, 0, nil
+}
+
 func (t *target) putObjS3(w http.ResponseWriter, r *http.Request, items []string, bck *meta.Bck) {
 	if len(items) < 2 {
 		s3.WriteErr(w, r, errS3Obj, 0)
@@ -171,6 +360,16 @@ func (t *target) putObjS3(w http.ResponseWriter, r *http.Request, items []string
 			return
 		}
 	}
+	if hdr := r.Header.Get(cos.S3HdrTagging); hdr != "" {
+		tags, err := s3.ParseTaggingHdr(hdr)
+		if err != nil {
+			s3.WriteErr(w, r, err, 0)
+			return
+		}
+		lom.ObjAttrs().SetTags(tags)
+	}
+	s3.SetUserMD(lom.ObjAttrs(), r.Header)
+	s3.ObjLockFromHdr(r.Header)
 	started := time.Now()
 	lom.SetAtimeUnix(started.UnixNano())
 
@@ -199,6 +398,9 @@ func (t *target) putObjS3(w http.ResponseWriter, r *http.Request, items []string
 		return
 	}
 	s3.SetETag(w.Header(), lom)
+	if bck.IsAIS() && lom.VersionConf().Enabled {
+		w.Header().Set(cos.S3VersionHeader, lom.Version())
+	}
 }
 
 // GET s3/<bucket-name[/<object-name>]
@@ -214,11 +416,19 @@ func (t *target) getObjS3(w http.ResponseWriter, r *http.Request, items []string
 		t.listMptUploads(w, bck, q)
 		return
 	}
+	if len(items) == 1 && q.Has(s3.QparamVersions) {
+		t.listObjVersS3(w, r, bck, q)
+		return
+	}
 	if len(items) < 2 {
 		s3.WriteErr(w, r, errS3Obj, 0)
 		return
 	}
 	objName := s3.ObjName(items)
+	if q.Has(s3.QparamTagging) {
+		t.getObjTaggingS3(w, r, bck, objName)
+		return
+	}
 	if q.Has(s3.QparamMptPartNo) {
 		t.getMptPart(w, r, bck, objName, q)
 		return
@@ -228,6 +438,9 @@ func (t *target) getObjS3(w http.ResponseWriter, r *http.Request, items []string
 		t.listMptParts(w, r, bck, objName, q)
 		return
 	}
+	if ver := q.Get(s3.QparamVersionID); ver != "" && t.getObjVerS3(w, r, bck, objName, ver) {
+		return
+	}
 
 	dpq := dpqAlloc()
 	if err := dpq.fromRawQ(r.URL.RawQuery); err != nil {
@@ -255,6 +468,9 @@ func (t *target) headObjS3(w http.ResponseWriter, r *http.Request, items []strin
 		s3.WriteErr(w, r, err, errCode)
 		return
 	}
+	if ver := r.URL.Query().Get(s3.QparamVersionID); ver != "" && t.getObjVerS3(w, r, bck, objName, ver) {
+		return
+	}
 	lom := cluster.AllocLOM(objName)
 	defer cluster.FreeLOM(lom)
 	if err := lom.InitBck(bck.Bucket()); err != nil {
@@ -301,10 +517,18 @@ func (t *target) headObjS3(w http.ResponseWriter, r *http.Request, items []strin
 	if v, ok := custom[cos.HdrContentType]; ok {
 		hdr.Set(cos.HdrContentType, v)
 	}
+	s3.UserMDToHdr(lom, hdr)
+	s3.ObjLockToHdr(&lom.Bprops().ObjLock, lom, hdr)
 	// e.g. https://docs.aws.amazon.com/AmazonS3/latest/API/API_HeadObject.html#API_HeadObject_Examples
 	// (compare w/ `p.listObjectsS3()`
 	lastModified := cos.FormatNanoTime(op.Atime, cos.RFC1123GMT)
 	hdr.Set(cos.S3LastModified, lastModified)
+	if tags := op.GetTags(); len(tags) > 0 {
+		hdr.Set(cos.S3HdrTaggingCount, strconv.Itoa(len(tags)))
+	}
+	if bck.IsAIS() && lom.VersionConf().Enabled {
+		hdr.Set(cos.S3VersionHeader, op.Version())
+	}
 
 	// TODO: lom.Checksum() via apc.HeaderPrefix+apc.HdrObjCksumType/Val via
 	// s3 obj Metadata map[string]*string
@@ -328,7 +552,8 @@ func (t *target) delObjS3(w http.ResponseWriter, r *http.Request, items []string
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
-	errCode, err = t.DeleteObject(lom, false)
+	s3.ObjLockFromHdr(r.Header)
+	errCode, err = t.delObject(lom, false /*evict*/, cos.IsParseBool(r.Header.Get(apc.HdrBypassGovernance)))
 	if err != nil {
 		name := lom.Cname()
 		if errCode == http.StatusNotFound {
@@ -372,3 +597,296 @@ func (t *target) postObjS3(w http.ResponseWriter, r *http.Request, items []strin
 		s3.QparamMptUploads, s3.QparamMptUploadID)
 	s3.WriteErr(w, r, err, 0)
 }
+
+// GET /s3/<bucket-name>/<object-name>?tagging
+func (t *target) getObjTaggingS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string) {
+	lom := cluster.AllocLOM(objName)
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+		if cmn.IsObjNotExist(err) {
+			s3.WriteErr(w, r, err, http.StatusNotFound)
+		} else {
+			s3.WriteErr(w, r, err, 0)
+		}
+		return
+	}
+	tagging := s3.NewTagging(lom.ObjAttrs().GetTags())
+	sgl := t.gmm.NewSGL(0)
+	tagging.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo(w)
+	sgl.Free()
+}
+
+// PUT /s3/<bucket-name>/<object-name>?tagging
+// (replaces all existing tags, if any)
+func (t *target) putObjTaggingS3(w http.ResponseWriter, r *http.Request, items []string, bck *meta.Bck) {
+	if len(items) < 2 {
+		s3.WriteErr(w, r, errS3Obj, 0)
+		return
+	}
+	tagging := &s3.Tagging{}
+	if err := xml.NewDecoder(r.Body).Decode(tagging); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	tags, err := tagging.ToTags()
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	t.setObjTagsS3(w, r, bck, s3.ObjName(items), tags)
+}
+
+// DELETE /s3/<bucket-name>/<object-name>?tagging
+func (t *target) delObjTaggingS3(w http.ResponseWriter, r *http.Request, items []string) {
+	bck, err, errCode := meta.InitByNameOnly(items[0], t.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if len(items) < 2 {
+		s3.WriteErr(w, r, errS3Obj, 0)
+		return
+	}
+	if t.setObjTagsS3(w, r, bck, s3.ObjName(items), nil) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (t *target) setObjTagsS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName string, tags cos.StrKVs) bool {
+	lom := cluster.AllocLOM(objName)
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return false
+	}
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if cmn.IsObjNotExist(err) {
+			s3.WriteErr(w, r, err, http.StatusNotFound)
+		} else {
+			s3.WriteErr(w, r, err, 0)
+		}
+		return false
+	}
+	lom.ObjAttrs().SetTags(tags)
+	if err := lom.Persist(); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return false
+	}
+	return true
+}
+
+// GET /s3/<bucket-name>?versions
+// (local part of the cluster-wide listing - see `p.listObjVersS3()`)
+func (t *target) listObjVersS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, q url.Values) {
+	var (
+		res = s3.NewListVersionsResult(bck.Name, q)
+		cb  = func(fqn string, de fs.DirEntry) error {
+			if de.IsDir() {
+				return nil
+			}
+			parsed, err := fs.ParseFQN(fqn)
+			if err != nil {
+				return nil
+			}
+			if parsed.ContentType == fs.ObjVerType {
+				objName, ver, ok := fs.ParseObjVer(parsed.ObjName)
+				if !ok || !res.Wanted(objName) {
+					return nil
+				}
+				if ov, err := cluster.LoadObjVer(fqn); err == nil {
+					res.Add(objName, ver, ov, false /*latest*/)
+				}
+				return nil
+			}
+			if !res.Wanted(parsed.ObjName) {
+				return nil
+			}
+			lom := cluster.AllocLOM("")
+			if lom.InitFQN(fqn, bck.Bucket()) == nil && lom.Load(false /*cache it*/, false /*locked*/) == nil && lom.IsHRW() {
+				res.Add(lom.ObjName, lom.Version(), lom, true /*latest*/)
+			}
+			cluster.FreeLOM(lom)
+			return nil
+		}
+	)
+	for _, mi := range fs.GetAvail() {
+		opts := &fs.WalkOpts{Mi: mi, Bck: *bck.Bucket(), CTs: []string{fs.ObjectType, fs.ObjVerType}, Callback: cb}
+		if err := fs.Walk(opts); err != nil {
+			s3.WriteErr(w, r, err, 0)
+			return
+		}
+		res.Finalize() // (keep at most max-keys)
+	}
+	sgl := t.gmm.NewSGL(0)
+	res.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo(w)
+	sgl.Free()
+}
+
+// [GET|HEAD] /s3/<bucket-name>/<object-name>?versionId=<version>
+// returns false if the requested version is the current one (to be served as usual)
+func (t *target) getObjVerS3(w http.ResponseWriter, r *http.Request, bck *meta.Bck, objName, ver string) bool {
+	if !bck.IsAIS() {
+		s3.WriteErr(w, r, fmt.Errorf("%s: object versions are only supported for ais:// buckets", bck), http.StatusNotImplemented)
+		return true
+	}
+	lom := cluster.AllocLOM(objName)
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return true
+	}
+	if isCurVer(lom, ver) {
+		return false
+	}
+	ov, err := lom.LoadVer(ver)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			s3.WriteErr(w, r, err, 0)
+			return true
+		}
+		// not here - may be retained by another target (e.g., the previous HRW one)
+		if r.Header.Get(apc.HdrCallerID) == "" {
+			if tsi := t.headObjVerBcast(lom, ver); tsi != nil {
+				t.fwdObjVerS3(w, r, tsi)
+				return true
+			}
+		}
+		s3.WriteErr(w, r, s3.NewErrNoSuchVersion(lom.Cname(), ver), http.StatusNotFound)
+		return true
+	}
+	fh, err := os.Open(ov.FQN)
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return true
+	}
+	hdr := w.Header()
+	hdr.Set(cos.S3VersionHeader, ver)
+	s3.SetETag(hdr, ov)
+	if r.Header.Get(apc.HdrCallerID) != "" {
+		cmn.ToHeader(ov, hdr) // intra-cluster copy (see `openRemoteCopySrc`)
+	}
+	if v, ok := ov.GetCustomKey(cos.HdrContentType); ok {
+		hdr.Set(cos.HdrContentType, v)
+	}
+	s3.UserMDToHdr(ov, hdr)
+	if tags := ov.GetTags(); len(tags) > 0 {
+		hdr.Set(cos.S3HdrTaggingCount, strconv.Itoa(len(tags)))
+	}
+	// (handles HEAD and range reads)
+	http.ServeContent(w, r, "", time.Unix(0, ov.Atime), fh)
+	cos.Close(fh)
+	return true
+}
+
+// Retained versions stay with the target (and mountpath) that stored them (see cluster/lver.go).
+// After a cluster membership change, the object's current HRW target may not be the one -
+// hence, broadcast to find out who has it.
+func (t *target) headObjVerBcast(lom *cluster.LOM, ver string) *meta.Snode {
+	args := allocBcArgs()
+	args.req = cmn.HreqArgs{
+		Method: http.MethodHead,
+		Header: http.Header{
+			apc.HdrCallerID:   []string{t.SID()},
+			apc.HdrCallerName: []string{t.callerName()},
+		},
+		Path:  apc.URLPathS3.Join(lom.Bck().Name, lom.ObjName),
+		Query: url.Values{s3.QparamVersionID: []string{ver}},
+	}
+	args.network = cmn.NetIntraData
+	args.ignoreMaintenance = true
+	args.to = cluster.Targets
+	results := t.bcastGroup(args)
+	freeBcArgs(args)
+	var tsi *meta.Snode
+	for _, res := range results {
+		if res.err == nil {
+			tsi = res.si
+			break
+		}
+	}
+	freeBcastRes(results)
+	return tsi
+}
+
+// serve a given retained version from the target that has it
+func (t *target) fwdObjVerS3(w http.ResponseWriter, r *http.Request, tsi *meta.Snode) {
+	u, err := url.Parse(tsi.URL(cmn.NetIntraData))
+	if err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	r.Header.Set(apc.HdrCallerID, t.SID()) // (no further lookups)
+	r.Header.Set(apc.HdrCallerName, t.callerName())
+	rproxy := httputil.NewSingleHostReverseProxy(u)
+	rproxy.Transport = t.client.data.Transport
+	rproxy.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
+		s3.WriteErr(w, r, err, 0)
+	}
+	rproxy.ServeHTTP(w, r)
+}
+
+// DELETE /s3/<bucket-name>/<object-name>?versionId=<version>
+// NOTE: there are no delete markers - deleting the current version deletes the object
+// along with all its retained versions
+func (t *target) delObjVerS3(w http.ResponseWriter, r *http.Request, items []string, ver string) {
+	bck, err, errCode := meta.InitByNameOnly(items[0], t.owner.bmd)
+	if err != nil {
+		s3.WriteErr(w, r, err, errCode)
+		return
+	}
+	if len(items) < 2 {
+		s3.WriteErr(w, r, errS3Obj, 0)
+		return
+	}
+	if !bck.IsAIS() {
+		s3.WriteErr(w, r, fmt.Errorf("%s: object versions are only supported for ais:// buckets", bck), http.StatusNotImplemented)
+		return
+	}
+	lom := cluster.AllocLOM(s3.ObjName(items))
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitBck(bck.Bucket()); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
+	if isCurVer(lom, ver) {
+		t.delObjS3(w, r, items)
+		return
+	}
+	ov, err := lom.LoadVer(ver)
+	if err == nil {
+		err = cos.RemoveFile(ov.FQN)
+	}
+	if err != nil {
+		if os.IsNotExist(err) {
+			if r.Header.Get(apc.HdrCallerID) == "" {
+				if tsi := t.headObjVerBcast(lom, ver); tsi != nil {
+					t.fwdObjVerS3(w, r, tsi)
+					return
+				}
+			}
+			s3.WriteErr(w, r, s3.NewErrNoSuchVersion(lom.Cname(), ver), http.StatusNotFound)
+		} else {
+			s3.WriteErr(w, r, err, 0)
+		}
+		return
+	}
+	w.Header().Set(cos.S3VersionHeader, ver)
+}
+
+func isCurVer(lom *cluster.LOM, ver string) bool {
+	if err := lom.Load(true /*cache it*/, false /*locked*/); err != nil {
+		return false
+	}
+	cur := lom.Version()
+	return cur == ver || (cur == "" && ver == s3.NullVersionID)
+}
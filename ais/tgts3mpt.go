@@ -14,6 +14,7 @@ import (
 	"os"
 	"sort"
 	"strconv"
+	"time"
 
 	"github.com/NVIDIA/aistore/3rdparty/glog"
 	"github.com/NVIDIA/aistore/ais/s3"
@@ -27,26 +28,17 @@ import (
 
 const fmtErrBO = "bucket and object names are required to complete multipart upload (have %v)"
 
-// Copy another object or its range as a part of the multipart upload.
-// Body is empty, everything in the query params and the header.
-// https://docs.aws.amazon.com/AmazonS3/latest/API/API_UploadPartCopy.html
-// TODO: not implemented yet
-func (*target) putMptCopy(w http.ResponseWriter, r *http.Request, items []string) {
-	if len(items) < 2 {
-		err := fmt.Errorf(fmtErrBO, items)
-		s3.WriteErr(w, r, err, 0)
-		return
-	}
-	s3.WriteErr(w, r, errors.New("not implemented yet"), http.StatusNotImplemented)
-}
-
 // PUT a part of the multipart upload.
 // Body is empty, everything in the query params and the header.
 //
 // "Content-MD5" in the part headers seems be to be deprecated:
 // either not present (s3cmd) or cannot be trusted (aws s3api).
 //
+// With `x-amz-copy-source` the part is a copy of another object or its range
+// (`x-amz-copy-source-range`) - see `t.openCopySrc`.
+//
 // https://docs.aws.amazon.com/AmazonS3/latest/API/API_UploadPart.html
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_UploadPartCopy.html
 func (t *target) putMptPart(w http.ResponseWriter, r *http.Request, items []string, q url.Values, bck *meta.Bck) {
 	if len(items) < 2 {
 		err := fmt.Errorf(fmtErrBO, items)
@@ -74,9 +66,27 @@ func (t *target) putMptPart(w http.ResponseWriter, r *http.Request, items []stri
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
-	if r.Header.Get(cos.S3HdrObjSrc) != "" {
-		s3.WriteErr(w, r, errors.New("uploading a copy is not supported yet"), http.StatusNotImplemented)
-		return
+	var (
+		reader = r.Body
+		isCopy = r.Header.Get(cos.S3HdrObjSrc) != ""
+	)
+	if isCopy {
+		lomSrc, version, errCode, err := t.initCopySrc(r)
+		if err != nil {
+			s3.WriteErr(w, r, err, errCode)
+			return
+		}
+		src, errCode, err := t.openCopySrc(lomSrc, version, r.Header.Get(cos.S3HdrObjSrcRange))
+		cluster.FreeLOM(lomSrc)
+		if err != nil {
+			s3.WriteErr(w, r, err, errCode)
+			return
+		}
+		reader = src.r
+		defer cos.Close(reader)
+		if version != "" {
+			w.Header().Set(cos.S3HdrObjSrcVersion, version)
+		}
 	}
 
 	objName := s3.ObjName(items)
@@ -102,13 +112,13 @@ func (t *target) putMptPart(w http.ResponseWriter, r *http.Request, items []stri
 		partSHA   string
 		mwriter   io.Writer
 	)
-	if partSHA = r.Header.Get(cos.S3HdrContentSHA256); partSHA != "" {
+	if partSHA = r.Header.Get(cos.S3HdrContentSHA256); partSHA != "" && !isCopy {
 		cksumSHA = cos.NewCksumHash(cos.ChecksumSHA256)
 		mwriter = io.MultiWriter(cksumMD5.H, cksumSHA.H, fh)
 	} else {
 		mwriter = io.MultiWriter(cksumMD5.H, fh)
 	}
-	size, err := io.CopyBuffer(mwriter, r.Body, buf)
+	size, err := io.CopyBuffer(mwriter, reader, buf)
 	cos.Close(fh)
 	slab.Free(buf)
 	if err != nil {
@@ -119,7 +129,7 @@ func (t *target) putMptPart(w http.ResponseWriter, r *http.Request, items []stri
 		return
 	}
 	cksumMD5.Finalize()
-	if partSHA != "" {
+	if cksumSHA != nil {
 		cksumSHA.Finalize()
 		recvSHA := cos.NewCksum(cos.ChecksumSHA256, partSHA)
 		if !cksumSHA.Equal(recvSHA) {
@@ -140,7 +150,19 @@ func (t *target) putMptPart(w http.ResponseWriter, r *http.Request, items []stri
 		s3.WriteErr(w, r, err, 0)
 		return
 	}
-	w.Header().Set(cos.S3CksumHeader, cksumMD5.Value()) // s3cmd checks this one
+	if !isCopy {
+		w.Header().Set(cos.S3CksumHeader, cksumMD5.Value()) // s3cmd checks this one
+		return
+	}
+	result := s3.CopyPartResult{
+		LastModified: cos.FormatTime(time.Now(), cos.ISO8601),
+		ETag:         cksumMD5.Value(),
+	}
+	sgl := t.gmm.NewSGL(0)
+	result.MustMarshal(sgl)
+	w.Header().Set(cos.HdrContentType, cos.ContentXML)
+	sgl.WriteTo(w)
+	sgl.Free()
 }
 
 // Initialize multipart upload.
@@ -156,7 +178,10 @@ func (t *target) startMpt(w http.ResponseWriter, r *http.Request, items []string
 	}
 
 	uploadID := cos.GenUUID()
-	s3.InitUpload(uploadID, bck.Name, objName)
+	if err := s3.InitUpload(uploadID, &lom); err != nil {
+		s3.WriteErr(w, r, err, 0)
+		return
+	}
 	result := &s3.InitiateMptUploadResult{Bucket: bck.Name, Key: objName, UploadID: uploadID}
 
 	sgl := t.gmm.NewSGL(0)
@@ -6,14 +6,19 @@ package ais
 
 import (
 	"sync"
+	"time"
 
 	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/ais/backend"
+	"github.com/NVIDIA/aistore/ais/s3"
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/hk"
 	"github.com/NVIDIA/aistore/ios"
 	"github.com/NVIDIA/aistore/nl"
 	"github.com/NVIDIA/aistore/space"
@@ -21,6 +26,13 @@ import (
 	"github.com/NVIDIA/aistore/xact/xreg"
 )
 
+const (
+	// how often to check buckets for expired objects (see cmn.LifecycleConf)
+	lifecycleIval = time.Hour
+	// how often to check for abandoned multipart uploads to remote backends
+	mpuCleanupIval = time.Hour
+)
+
 // triggers by an out-of-space condition or a suspicion of thereof
 func (t *target) OOS(csRefreshed *fs.CapStatus) (cs fs.CapStatus) {
 	var err error
@@ -111,6 +123,9 @@ func (t *target) runStoreCleanup(id string, wg *sync.WaitGroup, bcks ...cmn.Bck)
 		StatsT:  t.statsT,
 		Buckets: bcks,
 		WG:      wg,
+
+		IsActiveMpt:    s3.IsActiveUpload,
+		RmAbandonedMpt: s3.CleanupAbandoned,
 	}
 	xcln.AddNotif(&xact.NotifXact{
 		Base: nl.Base{When: cluster.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
@@ -118,3 +133,93 @@ func (t *target) runStoreCleanup(id string, wg *sync.WaitGroup, bcks ...cmn.Bck)
 	})
 	return space.RunCleanup(&ini)
 }
+
+//
+// bucket lifecycle
+//
+
+func (t *target) regLifecycleHK() {
+	hk.Reg(apc.ActLifecycle+hk.NameSuffix, t.lifecycleHK, lifecycleIval)
+}
+
+func (t *target) lifecycleHK() time.Duration {
+	if !t.ClusterStarted() {
+		return lifecycleIval
+	}
+	if smap := t.owner.smap.get(); smap.InMaintOrDecomm(t.si) {
+		return lifecycleIval
+	}
+	bmd := t.owner.bmd.get()
+	bmd.Range(nil, nil, func(bck *meta.Bck) bool {
+		if bck.Props.Lifecycle.IsEnabled() {
+			t.runLifecycle("" /*uuid*/, bck)
+		}
+		return false
+	})
+	return lifecycleIval
+}
+
+// - id != "": started by the user via (primary) proxy that also registers the xaction with IC;
+// - otherwise, periodic target-local housekeeping: not tracked by IC (and not notifying it)
+func (t *target) runLifecycle(id string, bck *meta.Bck) error {
+	local := id == ""
+	if local {
+		id = cos.GenUUID()
+	}
+	rns := xreg.RenewBckLifecycle(t, id, bck)
+	if rns.Err != nil || rns.IsRunning() {
+		return rns.Err
+	}
+	xlcy := rns.Entry.Get()
+	if !local {
+		xlcy.AddNotif(&xact.NotifXact{
+			Base: nl.Base{When: cluster.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+			Xact: xlcy,
+		})
+	}
+	go xlcy.Run(nil)
+	return nil
+}
+
+//
+// storage scrub (see xs.XactScrub)
+//
+
+// - id: common for all targets (generated by the primary - see proxy.scrubHK);
+// - bck == nil: all buckets
+func (t *target) runScrub(id string, bck *meta.Bck) error {
+	rns := xreg.RenewStoreScrub(t, t.statsT, id, bck)
+	if rns.Err != nil || rns.IsRunning() {
+		// (including "already running" - the caller must not be expecting `id` to run)
+		if rns.Err == nil {
+			rns.Err = cmn.NewErrXactUsePrev(rns.Entry.Get().String())
+		}
+		return rns.Err
+	}
+	xscr := rns.Entry.Get()
+	xscr.AddNotif(&xact.NotifXact{
+		Base: nl.Base{When: cluster.UponTerm, Dsts: []string{equalIC}, F: t.notifyTerm},
+		Xact: xscr,
+	})
+	go xscr.Run(nil)
+	return nil
+}
+
+//
+// abandoned multipart uploads (see backend.MpuCleanup)
+//
+
+func (t *target) regMpuCleanupHK() {
+	hk.Reg("mpu-cleanup"+hk.NameSuffix, t.mpuCleanupHK, mpuCleanupIval)
+}
+
+func (t *target) mpuCleanupHK() time.Duration {
+	if !t.ClusterStarted() {
+		return mpuCleanupIval
+	}
+	config := cmn.GCO.Get()
+	if n := backend.MpuCleanup(t.backend, config.Backend.MPU.Abandoned()); n > 0 {
+		glog.Infof("%s: aborted %d abandoned multipart upload%s", t, n, cos.Plural(n))
+	}
+	return mpuCleanupIval
+}
@@ -172,7 +172,8 @@ func (t *target) createBucket(c *txnServerCtx) error {
 		if err := t.transactions.begin(txn); err != nil {
 			return err
 		}
-		if c.msg.Action == apc.ActCreateBck && c.bck.IsRemote() {
+		// (file buckets: every target must have access to the bucket's directory)
+		if (c.msg.Action == apc.ActCreateBck || c.bck.IsFile()) && c.bck.IsRemote() {
 			if c.msg.Value != nil {
 				if err := cos.MorphMarshal(c.msg.Value, &c.bck.Props); err != nil {
 					return fmt.Errorf(cmn.FmtErrMorphUnmarshal, t, c.msg.Action, c.msg.Value, err)
@@ -340,9 +341,14 @@ func (t *target) setBucketProps(c *txnServerCtx) (string, error) {
 			xid = xctn.ID()
 		}
 		if _, reec := _reEC(bprops, nprops, c.bck, nil /*smap*/); reec {
+			var rns xreg.RenewRes
 			flt := xreg.Flt{Kind: apc.ActECEncode, Bck: c.bck}
 			xreg.DoAbort(flt, errors.New("re-ec"))
-			rns := xreg.RenewECEncode(t, c.bck, c.uuid, apc.ActCommit)
+			if _reEncode(bprops, nprops) {
+				rns = xreg.RenewECReencode(t, c.uuid, c.bck)
+			} else {
+				rns = xreg.RenewECEncode(t, c.bck, c.uuid, apc.ActCommit)
+			}
 			if rns.Err != nil {
 				return "", rns.Err
 			}
@@ -412,6 +418,11 @@ func (t *target) renameBucket(c *txnServerCtx) (string, error) {
 			nlpFrom.Unlock()
 			return "", cmn.NewErrBckIsBusy(bckTo.Bucket())
 		}
+		if err := bckFrom.Props.ObjLock.CheckBck(bckFrom.Cname(""), c.msg.Action); err != nil {
+			nlpTo.Unlock()
+			nlpFrom.Unlock()
+			return "", err
+		}
 		txn := newTxnRenameBucket(c, bckFrom, bckTo)
 		if err := t.transactions.begin(txn); err != nil {
 			nlpTo.Unlock()
@@ -860,6 +871,10 @@ func (t *target) destroyBucket(c *txnServerCtx) error {
 		if !nlp.TryLock(c.timeout.netw / 2) {
 			return cmn.NewErrBckIsBusy(c.bck.Bucket())
 		}
+		if err := c.bck.Props.ObjLock.CheckBck(c.bck.Cname(""), c.msg.Action); err != nil {
+			nlp.Unlock()
+			return err
+		}
 		txn := newTxnBckBase(c.bck)
 		txn.fillFromCtx(c)
 		if err := t.transactions.begin(txn); err != nil {
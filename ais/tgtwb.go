@@ -0,0 +1,63 @@
+// Package ais provides core functionality for the AIStore object storage.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ais
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/NVIDIA/aistore/xact/xs"
+)
+
+// how often to check for journaled objects pending write-back that are not
+// being handled by any running xaction (e.g., upon restart)
+const writeBackIval = time.Minute
+
+func (t *target) renewWriteBack(bck *meta.Bck) (*xs.XactWriteBack, error) {
+	rns := xreg.RenewWriteBack(t, bck)
+	if rns.Err != nil {
+		return nil, rns.Err
+	}
+	xctn := rns.Entry.Get()
+	return xctn.(*xs.XactWriteBack), nil
+}
+
+func (t *target) initWriteBack() {
+	xs.WriteBackInit(t.statsT)
+	hk.Reg(apc.ActWriteBack+hk.NameSuffix, t.writeBackHK, writeBackIval)
+}
+
+func (t *target) writeBackHK() time.Duration {
+	if !t.ClusterStarted() {
+		return writeBackIval
+	}
+	for _, b := range xs.WriteBackOrphans() {
+		bck := meta.CloneBck(&b)
+		if err := bck.Init(t.owner.bmd); err != nil {
+			if cmn.IsErrBckNotFound(err) || cmn.IsErrRemoteBckNotFound(err) {
+				n := xs.WriteBackDiscard(&b)
+				glog.Warningf("%s: %v - discarded %d object%s pending write-back", t, err, n, cos.Plural(n))
+			} else {
+				glog.Errorf("%s: %v", t, err)
+			}
+			continue
+		}
+		xwb, err := t.renewWriteBack(bck)
+		if err != nil {
+			glog.Errorf("%s: %s: %v", t, bck, err)
+			continue
+		}
+		if n := xwb.Claim(); n > 0 {
+			glog.Infof("%s: resuming write-back of %d object%s", xwb, n, cos.Plural(n))
+		}
+	}
+	return writeBackIval
+}
@@ -104,7 +104,11 @@ func (t *target) httpxput(w http.ResponseWriter, r *http.Request) {
 	case apc.ActXactStart:
 		debug.Assert(xact.IsValidKind(xargs.Kind), xargs.String())
 		if err := t.xstart(r, &xargs, bck); err != nil {
-			t.writeErr(w, r, err)
+			if cmn.IsErrXactUsePrev(err) {
+				t.writeErr(w, r, err, http.StatusConflict)
+			} else {
+				t.writeErr(w, r, err)
+			}
 			return
 		}
 	case apc.ActXactStop:
@@ -178,6 +182,8 @@ func (t *target) xstart(r *http.Request, args *xact.ArgsMsg, bck *meta.Bck) erro
 		wg.Add(1)
 		go t.runStoreCleanup(args.ID, wg, args.Buckets...)
 		wg.Wait()
+	case apc.ActStoreScrub:
+		return t.runScrub(args.ID, bck)
 	case apc.ActResilver:
 		if bck != nil {
 			glog.Errorf(erfmb, args.Kind, bck)
@@ -217,6 +223,44 @@ func (t *target) xstart(r *http.Request, args *xact.ArgsMsg, bck *meta.Bck) erro
 	case apc.ActLoadLomCache:
 		rns := xreg.RenewBckLoadLomCache(t, args.ID, bck)
 		return rns.Err
+	case apc.ActLifecycle:
+		return t.runLifecycle(args.ID, bck)
+	case apc.ActECScrub:
+		if err := xreg.LimitedCoexistence(t.si, bck, args.Kind); err != nil {
+			return err
+		}
+		rns := xreg.RenewECScrub(t, args.ID, bck)
+		if rns.Err != nil || rns.IsRunning() {
+			return rns.Err
+		}
+		xctn := rns.Entry.Get()
+		xctn.AddNotif(&xact.NotifXact{
+			Base: nl.Base{
+				When: cluster.UponTerm,
+				Dsts: []string{equalIC},
+				F:    t.notifyTerm,
+			},
+			Xact: xctn,
+		})
+		go xctn.Run(nil)
+	case apc.ActECReencode:
+		if err := xreg.LimitedCoexistence(t.si, bck, args.Kind); err != nil {
+			return err
+		}
+		rns := xreg.RenewECReencode(t, args.ID, bck)
+		if rns.Err != nil {
+			return rns.Err
+		}
+		xctn := rns.Entry.Get()
+		xctn.AddNotif(&xact.NotifXact{
+			Base: nl.Base{
+				When: cluster.UponTerm,
+				Dsts: []string{equalIC},
+				F:    t.notifyTerm,
+			},
+			Xact: xctn,
+		})
+		xact.GoRunW(xctn)
 	// 3. cannot start
 	case apc.ActPutCopies:
 		return fmt.Errorf("cannot start %q (is driven by PUTs into a mirrored bucket)", args)
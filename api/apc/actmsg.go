@@ -19,16 +19,19 @@ const (
 	ActSummaryBck     = "summary-bck"
 	ActCopyBck        = "copy-bck"
 	ActDownload       = "download"
-	ActECEncode       = "ec-encode" // erasure code a bucket
-	ActECGet          = "ec-get"    // erasure decode objects
-	ActECPut          = "ec-put"    // erasure encode objects
-	ActECRespond      = "ec-resp"   // respond to other targets' EC requests
+	ActECEncode       = "ec-encode"   // erasure code a bucket
+	ActECGet          = "ec-get"      // erasure decode objects
+	ActECPut          = "ec-put"      // erasure encode objects
+	ActECReencode     = "ec-reencode" // re-encode erasure coded bucket (upon changing the number of slices)
+	ActECRespond      = "ec-resp"     // respond to other targets' EC requests
+	ActECScrub        = "ec-scrub"    // verify and repair erasure coded slices and metafiles
 	ActETLInline      = "etl-inline"
 	ActETLBck         = "etl-bck"
 	ActElection       = "election"
 	ActEvictRemoteBck = "evict-remote-bck" // evict remote bucket's data
 	ActInvalListCache = "inval-listobj-cache"
 	ActLRU            = "lru"
+	ActLifecycle      = "lifecycle" // expire objects as per bucket lifecycle rules
 	ActList           = "list"
 	ActLoadLomCache   = "load-lom-cache"
 	ActMakeNCopies    = "make-n-copies"
@@ -38,6 +41,7 @@ const (
 	ActPutCopies      = "put-copies"
 	ActRebalance      = "rebalance"
 	ActRenameObject   = "rename-obj"
+	ActReplicate      = "replicate" // replicate to remote AIS cluster (see replication.dst)
 	ActResetStats     = "reset-stats"
 	ActResetBprops    = "reset-bprops"
 	ActResetConfig    = "reset-config"
@@ -46,6 +50,9 @@ const (
 	ActSetBprops      = "set-bprops"
 	ActSetConfig      = "set-config"
 	ActStoreCleanup   = "cleanup-store"
+	ActStoreScrub     = "scrub-store" // validate checksums of all objects and their copies, restore corrupted
+	ActSyncBck        = "sync-bck"    // reconcile cached objects with their remote counterparts
+	ActWriteBack      = "write-back"  // write objects to remote backend (see write_policy.data)
 
 	ActShutdownCluster = "shutdown" // see also: ActShutdownNode
 
@@ -101,6 +108,10 @@ const (
 	ActXactStop  = Stop
 	ActXactStart = Start
 
+	// Actions on the running global rebalance (/v1/cluster)
+	ActRebPause  = "pause-reb"
+	ActRebResume = "resume-reb"
+
 	// auxiliary
 	ActTransient = "transient" // transient - in-memory only
 )
@@ -24,6 +24,7 @@ const (
 	// including BucketProps.Extra.AWS
 	HdrS3Region   = HeaderPrefix + "cloud_region"
 	HdrS3Endpoint = HeaderPrefix + "endpoint"
+	HdrS3Profile  = HeaderPrefix + "profile"
 
 	// including BucketProps.Extra.HTTP
 	HdrOrigURLBck = HeaderPrefix + "original-url"
@@ -42,6 +43,10 @@ const (
 	HdrObjCustomMD  = HeaderPrefix + "custom-md"      // Object custom metadata.
 	HdrObjVersion   = HeaderPrefix + "version"        // Object version/generation - ais or cloud.
 
+	// Object lock (see cmn.ObjLockConf)
+	HdrObjRetainUntil   = HeaderPrefix + "retain-until"      // PUT: retain the object until a given time (RFC 3339)
+	HdrBypassGovernance = HeaderPrefix + "bypass-governance" // PUT, DELETE, rename: bypass governance-mode retention
+
 	// Archive filename and format (mime type)
 	HdrArchpath = HeaderPrefix + "archpath"
 	HdrArchmime = HeaderPrefix + "archmime"
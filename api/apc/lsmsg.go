@@ -120,6 +120,7 @@ const (
 	GetPropsCopies   = "copies"
 	GetPropsEC       = "ec"
 	GetPropsCustom   = "custom"
+	GetPropsTags     = "tags"     // object tags (a subset of custom metadata), URL-encoded
 	GetPropsLocation = "location" // advanced usage
 )
 
@@ -131,7 +132,8 @@ var (
 
 	GetPropsDefaultAIS = []string{GetPropsName, GetPropsSize, GetPropsChecksum, GetPropsAtime}
 	GetPropsAll        = []string{GetPropsName, GetPropsSize, GetPropsChecksum, GetPropsAtime,
-		GetPropsVersion, GetPropsCached, GetPropsStatus, GetPropsCopies, GetPropsEC, GetPropsCustom, GetPropsLocation}
+		GetPropsVersion, GetPropsCached, GetPropsStatus, GetPropsCopies, GetPropsEC, GetPropsCustom, GetPropsTags,
+		GetPropsLocation}
 )
 
 type LsoMsg struct {
@@ -35,6 +35,15 @@ type (
 		TCBMsg
 		ContinueOnError bool `json:"coer"` // ditto
 	}
+
+	// Reconcile in-cluster objects of a remote bucket with their remote counterparts:
+	// evict (or, if requested, re-read) those that have changed or have been deleted
+	// out-of-band - i.e., bypassing AIS (see ActSyncBck)
+	SyncBckMsg struct {
+		Prefix  string `json:"prefix"`  // sync only objects whose names start with prefix
+		Refresh bool   `json:"refresh"` // re-read (rather than evict) stale objects
+		DryRun  bool   `json:"dry_run"` // report only
+	}
 )
 
 ///////////////
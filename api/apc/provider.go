@@ -16,8 +16,9 @@ const (
 	GCP   = "gcp"
 	HDFS  = "hdfs"
 	HTTP  = "ht"
+	File  = "file"
 
-	AllProviders = "ais, aws (s3://), gcp (gs://), azure (az://), hdfs://, ht://" // NOTE: must include all
+	AllProviders = "ais, aws (s3://), gcp (gs://), azure (az://), hdfs://, ht://, file://" // NOTE: must include all
 
 	NsUUIDPrefix = '@' // BEWARE: used by on-disk layout
 	NsNamePrefix = '#' // BEWARE: used by on-disk layout
@@ -33,7 +34,7 @@ const (
 	AISScheme     = "ais"
 )
 
-var Providers = cos.NewStrSet(AIS, GCP, AWS, Azure, HDFS, HTTP)
+var Providers = cos.NewStrSet(AIS, GCP, AWS, Azure, HDFS, HTTP, File)
 
 func IsProvider(p string) bool { return Providers.Contains(p) }
 
@@ -42,7 +43,7 @@ func IsCloudProvider(p string) bool {
 }
 
 func IsRemoteProvider(p string) bool {
-	return IsCloudProvider(p) || p == HDFS || p == HTTP
+	return IsCloudProvider(p) || p == HDFS || p == HTTP || p == File
 }
 
 func ToScheme(p string) string {
@@ -90,6 +91,8 @@ func DisplayProvider(p string) string {
 		return "HDFS"
 	case HTTP:
 		return "HTTP(S)"
+	case File:
+		return "File"
 	default:
 		return p
 	}
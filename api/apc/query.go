@@ -52,6 +52,11 @@ const (
 	// HTTP bucket support.
 	QparamOrigURL = "original_url"
 
+	// S3 bucket: HEAD using the specified endpoint and credentials profile
+	// (to validate bucket props prior to applying them)
+	QparamS3Endpoint = "s3_endpoint"
+	QparamS3Profile  = "s3_profile"
+
 	// Log severity
 	QparamLogSev = "severity" // see { LogInfo, ...} enum
 	QparamLogOff = "offset"
@@ -8,6 +8,8 @@ import "fmt"
 
 // write policy (enum and accessors)
 // applies to both AIS metadata and data; bucket-configurable with global defaults via cluster config
+// NOTE: for data, `WriteDelayed` means write-back: PUT into a bucket with remote backend completes
+// upon local write, while the object gets asynchronously written to the backend
 type WritePolicy string
 
 const (
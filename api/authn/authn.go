@@ -81,6 +81,28 @@ func LoginUser(bp api.BaseParams, userID, pass, clusterID string, expire *time.D
 	return token, nil
 }
 
+// Get S3 credentials to presign a single URL on behalf of the `bp.Token` owner (see api.PresignS3).
+// The returned session token is limited to the given bucket, object, and method; it expires
+// in `msg.ExpiresIn` (or together with `bp.Token`, whatever comes first).
+func GetS3Creds(bp api.BaseParams, msg *S3PresignMsg) (token *TokenMsg, err error) {
+	bp.Method = http.MethodPost
+	reqParams := api.AllocRp()
+	defer api.FreeRp(reqParams)
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathTokens.S
+		reqParams.Body = cos.MustMarshal(msg)
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+	}
+	if _, err = reqParams.DoReqAny(&token); err != nil {
+		return nil, err
+	}
+	if token.Token == "" || token.S3SecretKey == "" {
+		return nil, errors.New("AuthN server did not return S3 credentials")
+	}
+	return token, nil
+}
+
 func RegisterCluster(bp api.BaseParams, cluSpec CluACL) error {
 	msg := cos.MustMarshal(cluSpec)
 	bp.Method = http.MethodPost
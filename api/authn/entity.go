@@ -36,12 +36,28 @@ type (
 	}
 	TokenMsg struct {
 		Token string `json:"token"`
+		// S3 credentials (see S3PresignMsg): the token itself is the session token
+		S3AccessKey string `json:"s3_access_key,omitempty"`
+		S3SecretKey string `json:"s3_secret_key,omitempty"`
 	}
 	LoginMsg struct {
 		Password  string         `json:"password"`
 		ExpiresIn *time.Duration `json:"expires_in"`
 		ClusterID string         `json:"cluster_id"`
 	}
+	// request S3 credentials to presign a single URL (see api.PresignS3)
+	S3PresignMsg struct {
+		S3Scope
+		ClusterID string        `json:"cluster_id"`
+		ExpiresIn time.Duration `json:"expires_in"`
+	}
+	// S3 session token is limited to a given bucket, object, and HTTP method
+	// (empty object name: list objects)
+	S3Scope struct {
+		Bck     cmn.Bck `json:"bck"`
+		ObjName string  `json:"obj,omitempty"`
+		Method  string  `json:"method"`
+	}
 	RegisteredClusters struct {
 		M map[string]*CluACL `json:"clusters,omitempty"`
 	}
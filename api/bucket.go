@@ -342,6 +342,26 @@ func EvictRemoteBucket(bp BaseParams, bck cmn.Bck, keepMD bool) error {
 	return err
 }
 
+// SyncBucket starts an extended action (xaction) to reconcile in-cluster objects of a given
+// remote bucket with their remote counterparts that may have been updated or deleted
+// out-of-band. Stale and remotely deleted objects get evicted (or, if `msg.Refresh`,
+// re-read); `msg.DryRun` only reports them (see xs.ExtBckSyncStats).
+// Returns xaction ID if successful, an error otherwise.
+func SyncBucket(bp BaseParams, bck cmn.Bck, msg *apc.SyncBckMsg) (xid string, err error) {
+	bp.Method = http.MethodPost
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathBuckets.Join(bck.Name)
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: apc.ActSyncBck, Value: msg})
+		reqParams.Header = http.Header{cos.HdrContentType: []string{cos.ContentJSON}}
+		reqParams.Query = bck.AddToQuery(nil)
+	}
+	_, err = reqParams.doReqStr(&xid)
+	FreeRp(reqParams)
+	return
+}
+
 // ListObjects returns a list of objects in a bucket - a slice of structures in the
 // `cmn.LsoResult` that look like `cmn.LsoEntry`.
 //
@@ -162,6 +162,29 @@ func ResetClusterStats(bp BaseParams, errorsOnly bool) (err error) {
 	return
 }
 
+// PauseRebalance pauses the currently running global rebalance, retaining its stage
+// and progress; see also ResumeRebalance
+func PauseRebalance(bp BaseParams) error {
+	return rebAction(bp, apc.ActRebPause)
+}
+
+func ResumeRebalance(bp BaseParams) error {
+	return rebAction(bp, apc.ActRebResume)
+}
+
+func rebAction(bp BaseParams, action string) (err error) {
+	bp.Method = http.MethodPut
+	reqParams := AllocRp()
+	{
+		reqParams.BaseParams = bp
+		reqParams.Path = apc.URLPathClu.S
+		reqParams.Body = cos.MustMarshal(apc.ActMsg{Action: action})
+	}
+	err = reqParams.DoRequest()
+	FreeRp(reqParams)
+	return
+}
+
 func GetRemoteAIS(bp BaseParams) (remais cluster.Remotes, err error) {
 	bp.Method = http.MethodGet
 	reqParams := AllocRp()
@@ -85,6 +85,10 @@ type (
 		// - we massively write a new content into a bucket, and/or
 		// - we simply don't care.
 		SkipVC bool
+
+		// optional; applies only to buckets with object lock enabled
+		// (default: now + bucket's default retention, if any)
+		RetainUntil time.Time
 	}
 	PromoteArgs struct {
 		BaseParams BaseParams
@@ -260,6 +264,9 @@ func (args *PutArgs) put(reqArgs *cmn.HreqArgs) (*http.Request, error) {
 		}
 		req.Header.Set(apc.HdrObjCksumVal, ckVal)
 	}
+	if !args.RetainUntil.IsZero() {
+		req.Header.Set(apc.HdrObjRetainUntil, args.RetainUntil.UTC().Format(time.RFC3339))
+	}
 	if args.Size != 0 {
 		req.ContentLength = int64(args.Size) // as per https://tools.ietf.org/html/rfc7230#section-3.3.2
 	}
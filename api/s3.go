@@ -8,11 +8,25 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
+	"time"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
 )
 
+// S3 credentials to presign URLs: with AuthN enabled, use authn.GetS3Creds to obtain
+// them for a given bucket, object, and method (access key is the user ID, session token
+// is issued by AuthN and limited to the same bucket, object, and method).
+type S3Creds struct {
+	AccessKey    string
+	SecretKey    string
+	SessionToken string
+}
+
+const presignRegion = "ais" // (any region will do)
+
 // s3/<bucket-name>/<object-name>
 func GetObjectS3(bp BaseParams, bck cmn.Bck, objectName string, args ...GetArgs) (int64, error) {
 	var (
@@ -39,3 +53,20 @@ func GetObjectS3(bp BaseParams, bck cmn.Bck, objectName string, args ...GetArgs)
 	}
 	return wresp.n, nil
 }
+
+// PresignS3 returns SigV4 presigned URL to GET, PUT, or HEAD s3/<bucket-name>/<object-name>
+// via AIS gateway at `bp.URL` - e.g., for 3rd party clients that only support presigned URLs.
+func PresignS3(bp BaseParams, method string, bck cmn.Bck, objectName string, creds *S3Creds,
+	expires time.Duration) (string, error) {
+	u, err := url.Parse(bp.URL)
+	if err != nil {
+		return "", err
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/") + apc.URLPathS3.Join(bck.Name, objectName)
+	err = cos.PresignS3(method, u, presignRegion, creds.AccessKey, creds.SecretKey, creds.SessionToken,
+		expires, time.Now())
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
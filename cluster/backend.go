@@ -31,3 +31,10 @@ type BackendProvider interface {
 	GetObj(ctx context.Context, lom *LOM, owt cmn.OWT) (errCode int, err error)
 	GetObjReader(ctx context.Context, lom *LOM) (r io.ReadCloser, expectedCksum *cos.Cksum, errCode int, err error)
 }
+
+// Optional: byte-range reads, to fetch large remote objects in parallel (see ais/tgtcold.go).
+// The `oa` (as returned by HeadObj) identifies the remote object's version - implementations
+// must fail (rather than return data from a different version) if the object has changed.
+type RangeReader interface {
+	GetObjRange(ctx context.Context, lom *LOM, oa *cmn.ObjAttrs, offset, length int64) (r io.ReadCloser, errCode int, err error)
+}
@@ -6,6 +6,7 @@ package cluster
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cluster/meta"
@@ -61,6 +62,10 @@ func _hrwTarget(uname string, smap *meta.Smap, skipMaint bool) (si *meta.Snode,
 // returns resulting subset (aka slice) that has the requested length = count.
 // Returns error if the cluster does not have enough targets.
 // If count == length of Smap.Tmap, the function returns as many targets as possible.
+//
+// When targets carry rack and/or zone labels (see meta.Snode), the selection is topology-aware
+// (see hrwSpread below) - the first target remains the same (cf. HrwTarget), and the (n+1)-long
+// list always starts with the n-long one.
 func HrwTargetList(uname string, smap *meta.Smap, count int) (sis meta.Nodes, err error) {
 	const fmterr = "%v: required %d, available %d, %s"
 	cnt := smap.CountTargets()
@@ -68,17 +73,27 @@ func HrwTargetList(uname string, smap *meta.Smap, count int) (sis meta.Nodes, er
 		err = fmt.Errorf(fmterr, cmn.ErrNotEnoughTargets, count, cnt, smap)
 		return
 	}
-	digest := xxhash.ChecksumString64S(uname, cos.MLCG32)
-	hlist := newHrwList(count)
-
+	var (
+		digest = xxhash.ChecksumString64S(uname, cos.MLCG32)
+		hlist  = newHrwList(count)
+		topo   bool
+	)
 	for _, tsi := range smap.Tmap {
 		cs := xoshiro256.Hash(tsi.Digest() ^ digest)
 		if tsi.InMaintOrDecomm() {
 			continue
 		}
+		if tsi.HasTopology() {
+			topo = true
+			break
+		}
 		hlist.add(cs, tsi)
 	}
-	sis = hlist.get()
+	if topo {
+		sis = hrwSpread(digest, smap, count)
+	} else {
+		sis = hlist.get()
+	}
 	if count != cnt && len(sis) < count {
 		err = fmt.Errorf(fmterr, cmn.ErrNotEnoughTargets, count, len(sis), smap)
 		return nil, err
@@ -86,6 +101,48 @@ func HrwTargetList(uname string, smap *meta.Smap, count int) (sis meta.Nodes, er
 	return sis, nil
 }
 
+// Topology-aware variant of the above: sorts all targets by their weights and then selects,
+// one at a time, the highest-weight target from the least used zone and - within
+// the zone - the least used rack. The result: erasure-coded slices of any given object
+// spread evenly across failure domains (with rebalance honoring the same placement).
+func hrwSpread(digest uint64, smap *meta.Smap, count int) meta.Nodes {
+	type cand struct {
+		tsi  *meta.Snode
+		rack string // zone-qualified
+		cs   uint64
+	}
+	cands := make([]cand, 0, len(smap.Tmap))
+	for _, tsi := range smap.Tmap {
+		if tsi.InMaintOrDecomm() {
+			continue
+		}
+		cs := xoshiro256.Hash(tsi.Digest() ^ digest)
+		cands = append(cands, cand{tsi: tsi, rack: tsi.Zone + "/" + tsi.Rack, cs: cs})
+	}
+	sort.Slice(cands, func(i, j int) bool { return cands[i].cs > cands[j].cs })
+
+	var (
+		sis   = make(meta.Nodes, 0, count)
+		zones = make(map[string]int, 4)
+		racks = make(map[string]int, 8)
+	)
+	for len(sis) < count && len(cands) > 0 {
+		best := 0
+		for i := 1; i < len(cands); i++ {
+			zi, zb := zones[cands[i].tsi.Zone], zones[cands[best].tsi.Zone]
+			if zi < zb || (zi == zb && racks[cands[i].rack] < racks[cands[best].rack]) {
+				best = i
+			}
+		}
+		c := cands[best]
+		sis = append(sis, c.tsi)
+		zones[c.tsi.Zone]++
+		racks[c.rack]++
+		cands = append(cands[:best], cands[best+1:]...)
+	}
+	return sis
+}
+
 func HrwProxy(smap *meta.Smap, idToSkip string) (pi *meta.Snode, err error) {
 	var max uint64
 	for pid, psi := range smap.Pmap {
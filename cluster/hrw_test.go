@@ -0,0 +1,105 @@
+// Package cluster provides common interfaces and local access to cluster-level metadata.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cluster
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+	"github.com/OneOfOne/xxhash"
+)
+
+func TestHrwTargetListTopology(t *testing.T) {
+	const (
+		numZones = 2
+		numRacks = 3 // per zone
+		numNodes = 2 // per rack
+		numObjs  = 1000
+	)
+	smap := &meta.Smap{Tmap: make(meta.NodeMap, numZones*numRacks*numNodes), Version: 1}
+	for z := 0; z < numZones; z++ {
+		for r := 0; r < numRacks; r++ {
+			for n := 0; n < numNodes; n++ {
+				tsi := &meta.Snode{Zone: fmt.Sprintf("z%d", z), Rack: fmt.Sprintf("r%d", r)}
+				tsi.Init(fmt.Sprintf("t%d%d%d", z, r, n), apc.Target)
+				smap.Tmap[tsi.ID()] = tsi
+			}
+		}
+	}
+	for i := 0; i < numObjs; i++ {
+		uname := fmt.Sprintf("ais/@#/bck/obj-%d", i)
+		first, err := HrwTarget(uname, smap)
+		tassert.CheckFatal(t, err)
+		all, err := HrwTargetList(uname, smap, smap.CountTargets())
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, all[0].ID() == first.ID(), "%s: expected %s, got %s", uname, first, all[0])
+
+		// prefix property
+		sis, err := HrwTargetList(uname, smap, numZones*numRacks)
+		tassert.CheckFatal(t, err)
+		for j, tsi := range sis {
+			tassert.Fatalf(t, tsi.ID() == all[j].ID(), "%s: [%d] %s vs %s", uname, j, tsi, all[j])
+		}
+
+		// zones remain balanced, and no rack is used twice
+		var (
+			racks = make(map[string]bool, len(sis))
+			zones = make(map[string]int, numZones)
+		)
+		for _, tsi := range sis {
+			zones[tsi.Zone]++
+			d := zones["z0"] - zones["z1"]
+			tassert.Fatalf(t, d >= -1 && d <= 1, "%s: unbalanced zones %v", uname, zones)
+			rack := tsi.Zone + "/" + tsi.Rack
+			tassert.Fatalf(t, !racks[rack], "%s: rack %s used twice", uname, rack)
+			racks[rack] = true
+		}
+	}
+
+	// no labels: same as plain HRW
+	for _, tsi := range smap.Tmap {
+		tsi.Zone, tsi.Rack = "", ""
+	}
+	uname := "ais/@#/bck/obj"
+	sis, err := HrwTargetList(uname, smap, 4)
+	tassert.CheckFatal(t, err)
+	spread := hrwSpread(xxhash.ChecksumString64S(uname, cos.MLCG32), smap, 4)
+	for j, tsi := range sis {
+		tassert.Errorf(t, tsi.ID() == spread[j].ID(), "[%d] %s vs %s", j, tsi, spread[j])
+	}
+}
+
+// partially labeled and uneven topology, with some targets in maintenance
+func TestHrwTargetListFirst(t *testing.T) {
+	smap := &meta.Smap{Tmap: make(meta.NodeMap, 10), Version: 1}
+	for i := 0; i < 10; i++ {
+		tsi := &meta.Snode{}
+		switch {
+		case i < 5:
+			tsi.Zone, tsi.Rack = "z0", fmt.Sprintf("r%d", i%2)
+		case i < 8:
+			tsi.Rack = "r2"
+		}
+		if i%4 == 3 {
+			tsi.Flags = tsi.Flags.Set(meta.SnodeMaint)
+		}
+		tsi.Init(fmt.Sprintf("t%d", i), apc.Target)
+		smap.Tmap[tsi.ID()] = tsi
+	}
+	for i := 0; i < 1000; i++ {
+		uname := fmt.Sprintf("ais/@#/bck/obj-%d", i)
+		first, err := HrwTarget(uname, smap)
+		tassert.CheckFatal(t, err)
+		for _, count := range []int{1, 3, smap.CountActiveTs()} {
+			sis, err := HrwTargetList(uname, smap, count)
+			tassert.CheckFatal(t, err)
+			tassert.Fatalf(t, sis[0].ID() == first.ID(), "%s(%d): expected %s, got %s", uname, count, first, sis[0])
+		}
+	}
+}
@@ -33,6 +33,7 @@ var _ = Describe("LOM", func() {
 		bucketLocalA = "LOM_TEST_Local_A"
 		bucketLocalB = "LOM_TEST_Local_B"
 		bucketLocalC = "LOM_TEST_Local_C"
+		bucketLocalV = "LOM_TEST_Local_V"
 
 		bucketCloudA = "LOM_TEST_Cloud_A"
 		bucketCloudB = "LOM_TEST_Cloud_B"
@@ -43,6 +44,7 @@ var _ = Describe("LOM", func() {
 	var (
 		localBckA = cmn.Bck{Name: bucketLocalA, Provider: apc.AIS, Ns: cmn.NsGlobal}
 		localBckB = cmn.Bck{Name: bucketLocalB, Provider: apc.AIS, Ns: cmn.NsGlobal}
+		localBckV = cmn.Bck{Name: bucketLocalV, Provider: apc.AIS, Ns: cmn.NsGlobal}
 		cloudBckA = cmn.Bck{Name: bucketCloudA, Provider: apc.AWS, Ns: cmn.NsGlobal}
 	)
 
@@ -72,6 +74,7 @@ var _ = Describe("LOM", func() {
 
 	_ = fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{})
 	_ = fs.CSM.Reg(fs.WorkfileType, &fs.WorkfileContentResolver{})
+	_ = fs.CSM.Reg(fs.ObjVerType, &fs.ObjVerContentResolver{})
 
 	bmd := mock.NewBaseBownerMock(
 		meta.NewBck(
@@ -91,6 +94,14 @@ var _ = Describe("LOM", func() {
 				BID:    3,
 			},
 		),
+		meta.NewBck(
+			bucketLocalV, apc.AIS, cmn.NsGlobal,
+			&cmn.BucketProps{
+				Cksum:      cmn.CksumConf{Type: cos.ChecksumXXHash},
+				Versioning: cmn.VersionConf{Enabled: true, Retain: 2},
+				BID:        8,
+			},
+		),
 		meta.NewBck(sameBucketName, apc.AIS, cmn.NsGlobal, &cmn.BucketProps{BID: 4}),
 		meta.NewBck(bucketCloudA, apc.AWS, cmn.NsGlobal, &cmn.BucketProps{BID: 5}),
 		meta.NewBck(bucketCloudB, apc.AWS, cmn.NsGlobal, &cmn.BucketProps{BID: 6}),
@@ -994,6 +1005,46 @@ var _ = Describe("LOM", func() {
 		})
 	})
 
+	Describe("retained versions", func() {
+		const objName = "foldr/test-obj.ext"
+
+		It("should retain up to versioning.retain prior versions", func() {
+			for i := 1; i <= 4; i++ {
+				lom := &cluster.LOM{ObjName: objName}
+				Expect(lom.InitBck(&localBckV)).NotTo(HaveOccurred())
+				lom.Lock(true)
+				Expect(lom.RetainVer()).NotTo(HaveOccurred())
+				Expect(lom.IncVersion()).NotTo(HaveOccurred())
+				createTestFile(lom.FQN, i*10)
+				lom.SetSize(int64(i * 10))
+				Expect(persist(lom)).NotTo(HaveOccurred())
+				lom.Unlock(true)
+				Expect(lom.Version()).To(Equal(strconv.Itoa(i)))
+			}
+
+			lom := &cluster.LOM{ObjName: objName}
+			Expect(lom.InitBck(&localBckV)).NotTo(HaveOccurred())
+			fqns := lom.VerFQNs()
+			Expect(fqns).To(HaveLen(2))
+			_, ver, ok := fs.ParseObjVer(filepath.Base(fqns[0]))
+			Expect(ok).To(BeTrue())
+			Expect(ver).To(Equal("3"))
+
+			ov, err := lom.LoadVer("2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ov.Version()).To(Equal("2"))
+			Expect(ov.SizeBytes()).To(BeEquivalentTo(20))
+
+			_, err = lom.LoadVer("1")
+			Expect(os.IsNotExist(err)).To(BeTrue())
+
+			Expect(lom.DelAllVers()).NotTo(HaveOccurred())
+			Expect(lom.VerFQNs()).To(BeEmpty())
+			Expect(lom.Load(false, false)).NotTo(HaveOccurred())
+			Expect(lom.Version()).To(Equal("4"))
+		})
+	})
+
 	Describe("local and cloud bucket with the same name", func() {
 		It("should have different fqn", func() {
 			testObject := "foldr/test-obj.ext"
@@ -0,0 +1,181 @@
+// Package cluster provides common interfaces and local access to cluster-level metadata
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cluster
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/fs"
+)
+
+//
+// Prior object versions (generations) in ais:// buckets with `versioning.retain` > 0:
+// upon overwrite, the current generation is hard-linked (along with its metadata)
+// as fs.ObjVerType content, and the oldest generations beyond the limit get removed.
+// NOTE: retained versions are local - they stay with the target (and mountpath) that
+// stored them, and are not migrated by global rebalance or resilvering. Instead, version
+// lookups that miss locally fall back to asking all targets (see ais/tgts3.go).
+//
+
+type ObjVer struct {
+	cmn.ObjAttrs
+	FQN string
+}
+
+// RetainVer must be called under exclusive lock prior to overwriting the object.
+// It also sets lom's version to the current (on-disk) one, so that the caller
+// can subsequently increment it.
+func (lom *LOM) RetainVer() error {
+	retain := lom.VersionConf().Retain
+	if retain <= 0 || !lom.Bck().IsAIS() {
+		return nil
+	}
+	debug.AssertFunc(func() bool { _, exclusive := lom.IsLocked(); return exclusive })
+	cur := AllocLOM(lom.ObjName)
+	defer FreeLOM(cur)
+	if err := cur.InitBck(lom.Bucket()); err != nil {
+		return err
+	}
+	if err := cur.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if cmn.IsObjNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	ver := cur.Version()
+	if ver == "" {
+		return nil
+	}
+	lom.SetVersion(ver)
+
+	vfqn := fs.CSM.Gen(cur, fs.ObjVerType, ver)
+	if err := cos.CreateDir(filepath.Dir(vfqn)); err != nil {
+		return err
+	}
+	if err := os.Link(cur.FQN, vfqn); err != nil {
+		if !os.IsExist(err) {
+			return cmn.NewErrFailedTo(T, "retain version", vfqn, err)
+		}
+		// (unlikely) same version
+		if err := cos.RemoveFile(vfqn); err != nil {
+			return err
+		}
+		if err := os.Link(cur.FQN, vfqn); err != nil {
+			return cmn.NewErrFailedTo(T, "retain version", vfqn, err)
+		}
+	}
+
+	// prune
+	vers := lom.VerFQNs()
+	for i := retain; i < len(vers); i++ {
+		if err := cos.RemoveFile(vers[i]); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// VerFQNs returns retained versions of the object, newest first
+func (lom *LOM) VerFQNs() (fqns []string) {
+	var (
+		vers   []uint64
+		prefix = filepath.Base(lom.ObjName)
+		avail  = fs.GetAvail()
+	)
+	for _, mi := range avail {
+		dir := filepath.Dir(mi.MakePathFQN(lom.Bucket(), fs.ObjVerType, lom.ObjName))
+		dentries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, de := range dentries {
+			name := de.Name()
+			if de.IsDir() || !strings.HasPrefix(name, prefix) {
+				continue
+			}
+			if base, ver, ok := fs.ParseObjVer(name); ok && base == prefix {
+				v, _ := strconv.ParseUint(ver, 10, 64)
+				fqns = append(fqns, filepath.Join(dir, name))
+				vers = append(vers, v)
+			}
+		}
+	}
+	if len(fqns) > 1 {
+		sort.Sort(&verFQNs{fqns, vers})
+	}
+	return
+}
+
+// LoadVer returns metadata and location of a given retained version
+func (lom *LOM) LoadVer(ver string) (*ObjVer, error) {
+	var (
+		avail = fs.GetAvail()
+		base  = fs.CSM.Resolver(fs.ObjVerType).GenUniqueFQN(lom.ObjName, ver)
+		err   error
+	)
+	for _, mi := range avail {
+		fqn := mi.MakePathFQN(lom.Bucket(), fs.ObjVerType, base)
+		if err = cos.Stat(fqn); err == nil {
+			return LoadObjVer(fqn)
+		}
+	}
+	if err == nil {
+		err = os.ErrNotExist // no mountpaths
+	}
+	return nil, err
+}
+
+// DelAllVers removes all retained versions of the object
+func (lom *LOM) DelAllVers() (err error) {
+	for _, fqn := range lom.VerFQNs() {
+		if erv := cos.RemoveFile(fqn); erv != nil && !os.IsNotExist(erv) {
+			err = erv
+		}
+	}
+	return
+}
+
+// LoadObjVer loads metadata of a retained object version given its (fs.ObjVerType) FQN;
+// last modification time is returned as atime
+func LoadObjVer(fqn string) (*ObjVer, error) {
+	finfo, err := os.Stat(fqn)
+	if err != nil {
+		return nil, err
+	}
+	vlom := AllocLOM("")
+	vlom.FQN = fqn
+	md, err := vlom.lmfs(false)
+	FreeLOM(vlom)
+	if err != nil {
+		return nil, err
+	}
+	ov := &ObjVer{ObjAttrs: md.ObjAttrs, FQN: fqn}
+	ov.Atime = finfo.ModTime().UnixNano()
+	ov.Size = finfo.Size()
+	return ov, nil
+}
+
+/////////////
+// verFQNs //
+/////////////
+
+type verFQNs struct {
+	fqns []string
+	vers []uint64
+}
+
+func (v *verFQNs) Len() int           { return len(v.fqns) }
+func (v *verFQNs) Less(i, j int) bool { return v.vers[i] > v.vers[j] }
+func (v *verFQNs) Swap(i, j int) {
+	v.fqns[i], v.fqns[j] = v.fqns[j], v.fqns[i]
+	v.vers[i], v.vers[j] = v.vers[j], v.vers[i]
+}
@@ -71,6 +71,8 @@ type (
 		ControlNet NetInfo    `json:"intra_control_net"` // cmn.NetIntraControl
 		DaeType    string     `json:"daemon_type"`       // "target" or "proxy"
 		DaeID      string     `json:"daemon_id"`
+		Rack       string     `json:"rack,omitempty"` // failure domain (optional; see cmn.LocalTopoConf)
+		Zone       string     `json:"zone,omitempty"` // ditto
 		name       string
 		Flags      cos.BitFlags `json:"flags"` // enum { SnodeNonElectable, SnodeIC, ... }
 		idDigest   uint64
@@ -257,6 +259,12 @@ func (d *Snode) isDupNet(n *Snode, smap *Smap) error {
 	return nil
 }
 
+// the node's rack and zone labels, if any (see cluster.HrwTargetList)
+func (d *Snode) HasTopology() bool { return d.Rack != "" || d.Zone != "" }
+
+// rack and zone are not part of node identity (cf. Equals) - changing either requires Smap update
+func (d *Snode) SameTopology(o *Snode) bool { return d.Rack == o.Rack && d.Zone == o.Zone }
+
 func (d *Snode) IsProxy() bool  { return d.DaeType == apc.Proxy }
 func (d *Snode) IsTarget() bool { return d.DaeType == apc.Target }
 
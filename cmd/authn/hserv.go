@@ -92,8 +92,10 @@ func (h *hserv) tokenHandler(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodDelete:
 		h.httpRevokeToken(w, r)
+	case http.MethodPost:
+		h.httpS3Creds(w, r)
 	default:
-		cmn.WriteErr405(w, r, http.MethodDelete)
+		cmn.WriteErr405(w, r, http.MethodDelete, http.MethodPost)
 	}
 }
 
@@ -133,6 +135,28 @@ func (h *hserv) httpRevokeToken(w http.ResponseWriter, r *http.Request) {
 	h.mgr.revokeToken(msg.Token)
 }
 
+// Issues S3 credentials to presign a single URL on behalf of the token owner
+func (h *hserv) httpS3Creds(w http.ResponseWriter, r *http.Request) {
+	if _, err := checkRESTItems(w, r, 0, apc.URLPathTokens.L); err != nil {
+		return
+	}
+	token, err := tok.ExtractToken(r.Header)
+	if err != nil {
+		cmn.WriteErr(w, r, err, http.StatusUnauthorized)
+		return
+	}
+	msg := &authn.S3PresignMsg{}
+	if err := cmn.ReadJSON(w, r, msg); err != nil {
+		return
+	}
+	repl, errCode, err := h.mgr.issueS3Creds(token, msg)
+	if err != nil {
+		cmn.WriteErr(w, r, err, errCode)
+		return
+	}
+	writeJSON(w, repl, "s3 creds")
+}
+
 func (h *hserv) httpUserDel(w http.ResponseWriter, r *http.Request) {
 	apiItems, err := checkRESTItems(w, r, 1, apc.URLPathUsers.L)
 	if err != nil {
@@ -436,6 +436,66 @@ func (m *mgr) issueToken(userID, pwd string, msg *authn.LoginMsg) (string, error
 	return token, err
 }
 
+// Issue S3 session token (and the corresponding S3 credentials) on behalf of the (valid)
+// token's owner. The session token gets embedded in presigned URL and, therefore, is
+// limited to a single bucket, object, and operation (see authn.S3Scope); it cannot
+// outlive the original token
+func (m *mgr) issueS3Creds(token string, msg *authn.S3PresignMsg) (*authn.TokenMsg, int, error) {
+	secret := Conf.Secret()
+	tk, err := tok.DecryptToken(token, secret)
+	if err != nil {
+		return nil, http.StatusUnauthorized, err
+	}
+	now := time.Now()
+	if tk.Expires.Before(now) {
+		return nil, http.StatusUnauthorized, fmt.Errorf("%v: %s", tok.ErrTokenExpired, tk)
+	}
+	var v string
+	if err := m.db.Get(revokedCollection, token, &v); err == nil {
+		return nil, http.StatusUnauthorized, fmt.Errorf("%v: %s", tok.ErrTokenRevoked, tk)
+	}
+	if tk.S3Scope != nil {
+		return nil, http.StatusForbidden, fmt.Errorf("cannot issue S3 credentials using S3 session token (%s)", tk)
+	}
+	if msg.ExpiresIn <= 0 || msg.ExpiresIn > cos.S3PresignMaxExpires {
+		return nil, http.StatusBadRequest, fmt.Errorf("invalid expiration %v (expecting (0, %v])",
+			msg.ExpiresIn, cos.S3PresignMaxExpires)
+	}
+	if msg.Bck.Name == "" || msg.Bck.Provider == "" {
+		return nil, http.StatusBadRequest, fmt.Errorf("invalid bucket %q (expecting name and provider)", msg.Bck)
+	}
+	ace, err := tok.S3Ace(msg.Method, msg.ObjName)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	if msg.ClusterID == "" {
+		return nil, http.StatusBadRequest, fmt.Errorf("cannot issue S3 credentials for %q: cluster ID not set", tk.UserID)
+	}
+	cid := m.cluLookup(msg.ClusterID, msg.ClusterID)
+	if cid == "" {
+		return nil, http.StatusNotFound, cos.NewErrNotFound("%s: cluster %q", svcName, msg.ClusterID)
+	}
+	if err := tk.CheckPermissions(cid, &msg.Bck, ace); err != nil {
+		return nil, http.StatusForbidden, err
+	}
+
+	var (
+		scope   = msg.S3Scope
+		acl     = &authn.BckACL{Bck: msg.Bck, Access: ace}
+		expires = now.Add(msg.ExpiresIn)
+	)
+	acl.Bck.Ns.UUID = cid
+	if expires.After(tk.Expires) {
+		expires = tk.Expires
+	}
+	s3tok, err := tok.IssueS3JWT(expires, tk.UserID, &scope, []*authn.BckACL{acl}, secret)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	repl := &authn.TokenMsg{Token: s3tok, S3AccessKey: tk.UserID, S3SecretKey: tok.S3SecretKey(s3tok, secret)}
+	return repl, 0, nil
+}
+
 // Before putting a list of cluster permissions to a token, cluster aliases
 // must be replaced with their IDs.
 func (m *mgr) fixClusterIDs(lst []*authn.CluACL) {
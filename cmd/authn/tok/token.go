@@ -6,6 +6,9 @@
 package tok
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/http"
@@ -26,6 +29,8 @@ type Token struct {
 	ClusterACLs []*authn.CluACL `json:"clusters"`
 	BucketACLs  []*authn.BckACL `json:"buckets,omitempty"`
 	IsAdmin     bool            `json:"admin"`
+	// S3 session token (see authn.S3PresignMsg)
+	S3Scope *authn.S3Scope `json:"s3scope,omitempty"`
 }
 
 var (
@@ -57,6 +62,19 @@ func IssueJWT(expires time.Time, userID string, bucketACLs []*authn.BckACL, clus
 	return t.SignedString([]byte(secret))
 }
 
+// S3 session token to presign a single URL: the token is limited to the scope,
+// and `bucketACLs` to the corresponding permission
+func IssueS3JWT(expires time.Time, userID string, scope *authn.S3Scope, bucketACLs []*authn.BckACL,
+	secret string) (string, error) {
+	t := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"expires":  expires,
+		"username": userID,
+		"buckets":  bucketACLs,
+		"s3scope":  scope,
+	})
+	return t.SignedString([]byte(secret))
+}
+
 // Header format: 'Authorization: Bearer <token>'
 func ExtractToken(hdr http.Header) (string, error) {
 	s := hdr.Get(apc.HdrAuthorization)
@@ -91,14 +109,60 @@ func DecryptToken(tokenStr, secret string) (*Token, error) {
 	return tk, nil
 }
 
+// S3SecretKey derives S3 (SigV4) secret access key from a given S3 session token (see IssueS3JWT).
+// Together with the user ID (access key) and the token itself, it constitutes S3 credentials
+// that AIS gateways validate without contacting AuthN.
+func S3SecretKey(token, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("s3-secret-key:" + token))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// S3Ace returns permission required to execute presigned S3 request
+// (empty object name: list objects)
+func S3Ace(method, objName string) (apc.AccessAttrs, error) {
+	switch method {
+	case http.MethodGet:
+		if objName == "" {
+			return apc.AceObjLIST, nil
+		}
+		return apc.AceGET, nil
+	case http.MethodPut:
+		if objName != "" {
+			return apc.AcePUT, nil
+		}
+	case http.MethodHead:
+		if objName != "" {
+			return apc.AceObjHEAD, nil
+		}
+	}
+	return 0, fmt.Errorf("presigned %s %q requests are not supported", method, objName)
+}
+
 ///////////
 // Token //
 ///////////
 
 func (tk *Token) String() string {
+	if tk.S3Scope != nil {
+		return fmt.Sprintf("user %s, S3 %s %s, %s", tk.UserID, tk.S3Scope.Method, tk.S3Scope.Bck.Cname(tk.S3Scope.ObjName),
+			expiresIn(tk.Expires))
+	}
 	return fmt.Sprintf("user %s, %s", tk.UserID, expiresIn(tk.Expires))
 }
 
+// CheckS3Scope validates presigned S3 request against S3 session token
+func (tk *Token) CheckS3Scope(method string, bck *cmn.Bck, objName string) error {
+	scope := tk.S3Scope
+	if scope == nil {
+		return fmt.Errorf("%v: expecting S3 session token (%s)", ErrNoPermissions, tk)
+	}
+	if scope.Method != method || scope.ObjName != objName || !scope.Bck.Equal(bck) {
+		return fmt.Errorf("%v: %s %s is out of scope (%s)", ErrNoPermissions, method, bck.Cname(objName), tk)
+	}
+	return nil
+}
+
 // A user has two-level permissions: cluster-wide and on per bucket basis.
 // To be able to access data, a user must have either permission. This
 // allows creating users, e.g, with read-only access to the entire cluster,
@@ -5,9 +5,11 @@
 package main
 
 import (
+	"net/http"
 	"testing"
 	"time"
 
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/api/authn"
 	"github.com/NVIDIA/aistore/cluster/mock"
 	"github.com/NVIDIA/aistore/cmd/authn/tok"
@@ -194,6 +196,62 @@ func TestToken(t *testing.T) {
 	}
 }
 
+func TestS3Creds(t *testing.T) {
+	var (
+		secret  = Conf.Secret()
+		bck     = cmn.Bck{Name: "abc", Provider: apc.AIS}
+		expires = time.Now().Add(time.Hour)
+		clu     = authn.CluACL{ID: "ABCD", Alias: "cluster-test"}
+	)
+	driver := mock.NewDBDriver()
+	mgr, err := newMgr(driver)
+	tassert.CheckFatal(t, err)
+	tassert.CheckFatal(t, mgr.db.Set(clustersCollection, clu.ID, clu))
+
+	token, err := tok.IssueJWT(expires, users[0], nil, []*authn.CluACL{{ID: clu.ID, Access: apc.AccessRO}}, secret)
+	tassert.CheckFatal(t, err)
+	msg := &authn.S3PresignMsg{
+		S3Scope:   authn.S3Scope{Bck: bck, ObjName: "obj", Method: http.MethodGet},
+		ClusterID: clu.Alias,
+		ExpiresIn: 2 * time.Hour,
+	}
+	creds, _, err := mgr.issueS3Creds(token, msg)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, creds.Token != token, "expecting new (S3 session) token")
+	tassert.Errorf(t, creds.S3AccessKey == users[0], "expecting access key %q, got %q", users[0], creds.S3AccessKey)
+	tassert.Errorf(t, creds.S3SecretKey == tok.S3SecretKey(creds.Token, secret), "invalid secret key")
+
+	tk, err := tok.DecryptToken(creds.Token, secret)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, tk.S3Scope != nil && *tk.S3Scope == msg.S3Scope, "invalid S3 scope: %+v", tk.S3Scope)
+	tassert.Errorf(t, !tk.Expires.After(expires), "S3 session token (%s) outlives the user's token", tk)
+
+	// limited to a single bucket, object, and method
+	tassert.CheckError(t, tk.CheckS3Scope(http.MethodGet, &bck, "obj"))
+	tassert.CheckError(t, tk.CheckPermissions(clu.ID, &bck, apc.AceGET))
+	tassert.Errorf(t, tk.CheckS3Scope(http.MethodPut, &bck, "obj") != nil, "PUT must be out of scope")
+	tassert.Errorf(t, tk.CheckS3Scope(http.MethodGet, &bck, "obj2") != nil, "obj2 must be out of scope")
+	tassert.Errorf(t, tk.CheckPermissions(clu.ID, &bck, apc.AcePUT) != nil, "PUT must not be permitted")
+	other := cmn.Bck{Name: "xyz", Provider: apc.AIS}
+	tassert.Errorf(t, tk.CheckPermissions(clu.ID, &other, apc.AceGET) != nil, "%s must not be permitted", other)
+
+	// no permission to PUT
+	msg.Method = http.MethodPut
+	_, errCode, err := mgr.issueS3Creds(token, msg)
+	tassert.Errorf(t, err != nil && errCode == http.StatusForbidden, "expecting PUT to fail with 403, got %d(%v)", errCode, err)
+
+	// S3 session token cannot be used to issue S3 credentials
+	msg.Method = http.MethodGet
+	_, _, err = mgr.issueS3Creds(creds.Token, msg)
+	tassert.Errorf(t, err != nil, "expecting error issuing S3 credentials with S3 session token")
+
+	// revoked
+	tassert.CheckFatal(t, mgr.db.Set(revokedCollection, token, "!"))
+	_, errCode, err = mgr.issueS3Creds(token, msg)
+	tassert.Errorf(t, err != nil && errCode == http.StatusUnauthorized, "expecting 401 for revoked token, got %d(%v)",
+		errCode, err)
+}
+
 func TestMergeCluACLS(t *testing.T) {
 	tests := []struct {
 		title    string
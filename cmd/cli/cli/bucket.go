@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -126,6 +127,91 @@ func mvBucket(c *cli.Context, bckFrom, bckTo cmn.Bck) error {
 	return nil
 }
 
+// Reconcile remote bucket's in-cluster objects with their remote counterparts
+func syncBucket(c *cli.Context, bck cmn.Bck) error {
+	if bck.IsAIS() || bck.IsRemoteAIS() {
+		return fmt.Errorf("cannot sync %s: expecting cloud or http bucket", bck.Cname(""))
+	}
+	msg := &apc.SyncBckMsg{
+		Prefix:  parseStrFlag(c, syncObjPrefixFlag),
+		Refresh: flagIsSet(c, syncRereadFlag),
+		DryRun:  flagIsSet(c, dryRunFlag),
+	}
+	if msg.DryRun {
+		dryRunCptn(c)
+	}
+	xid, err := api.SyncBucket(apiBP, bck, msg)
+	if err != nil {
+		return err
+	}
+	_, xname := xact.GetKindName(apc.ActSyncBck)
+	text := fmt.Sprintf("%s[%s] %s", xname, xid, bck.Cname(msg.Prefix))
+	// (dry-run: always wait to show the results)
+	if !msg.DryRun && !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
+		actionDone(c, text+". "+toMonitorMsg(c, xid, ""))
+		return nil
+	}
+
+	// wait
+	var timeout time.Duration
+	if flagIsSet(c, waitJobXactFinishedFlag) {
+		timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
+	}
+	fmt.Fprintln(c.App.Writer, text+" ...")
+	xargs := xact.ArgsMsg{ID: xid, Kind: apc.ActSyncBck, Timeout: timeout}
+	if err := waitXact(apiBP, xargs); err != nil {
+		return err
+	}
+	return showSyncResults(c, xargs, msg.DryRun)
+}
+
+// sum up extended stats (see xs.ExtBckSyncStats) across all targets
+func showSyncResults(c *cli.Context, xargs xact.ArgsMsg, dryRun bool) error {
+	const maxSample = 16
+	var (
+		names  = []string{"stale", "deleted", "evicted", "refreshed"}
+		totals = make(map[string]int64, len(names))
+		sample []string
+	)
+	xs, err := api.QueryXactionSnaps(apiBP, xargs)
+	if err != nil {
+		return err
+	}
+	for _, snaps := range xs {
+		for _, snap := range snaps {
+			ext, ok := snap.Ext.(map[string]any)
+			if !ok {
+				continue
+			}
+			for _, name := range names {
+				if v, ok := ext[name].(string); ok {
+					n, _ := strconv.ParseInt(v, 10, 64)
+					totals[name] += n
+				}
+			}
+			if objNames, ok := ext["sample"].([]any); ok {
+				for _, objName := range objNames {
+					if len(sample) < maxSample {
+						sample = append(sample, fmt.Sprintf("%v", objName))
+					}
+				}
+			}
+		}
+	}
+	fmt.Fprintf(c.App.Writer, "changed remotely: %d, deleted remotely: %d", totals["stale"], totals["deleted"])
+	if !dryRun {
+		fmt.Fprintf(c.App.Writer, ", evicted: %d, re-read: %d", totals["evicted"], totals["refreshed"])
+	}
+	fmt.Fprintln(c.App.Writer)
+	for _, objName := range sample {
+		fmt.Fprintln(c.App.Writer, indent1+objName)
+	}
+	if n := totals["stale"] + totals["deleted"]; n > int64(len(sample)) && len(sample) > 0 {
+		fmt.Fprintln(c.App.Writer, indent1+"...")
+	}
+	return nil
+}
+
 // Evict remote bucket
 func evictBucket(c *cli.Context, bck cmn.Bck) (err error) {
 	if flagIsSet(c, dryRunFlag) {
@@ -63,6 +63,13 @@ var (
 			keepMDFlag,
 			verboseFlag,
 		),
+		commandSync: {
+			syncObjPrefixFlag,
+			syncRereadFlag,
+			dryRunFlag,
+			waitFlag,
+			waitJobXactFinishedFlag,
+		},
 		cmdSetBprops: {
 			forceFlag,
 		},
@@ -133,6 +140,15 @@ var (
 		Action:       evictHandler,
 		BashComplete: bucketCompletions(bcmplop{multiple: true}),
 	}
+	bucketCmdSync = cli.Command{
+		Name: commandSync,
+		Usage: "reconcile remote bucket's in-cluster objects with their remote counterparts:\n" +
+			indent4 + "\tevict (or re-read) objects that have been updated or deleted out-of-band (i.e., bypassing AIS)",
+		ArgsUsage:    bucketArgument,
+		Flags:        bucketCmdsFlags[commandSync],
+		Action:       syncBucketHandler,
+		BashComplete: bucketCompletions(bcmplop{}),
+	}
 	bucketCmdCopy = cli.Command{
 		Name:         commandCopy,
 		Usage:        "copy entire bucket or selected objects (to select, use '--list' or '--template')",
@@ -158,6 +174,7 @@ var (
 			bucketCmdSummary,
 			bucketCmdLRU,
 			bucketObjCmdEvict,
+			bucketCmdSync,
 			makeAlias(showCmdBucket, "", true, commandShow), // alias for `ais show`
 			{
 				Name:      commandCreate,
@@ -330,6 +347,17 @@ func mvBucketHandler(c *cli.Context) error {
 	return mvBucket(c, bckFrom, bckTo)
 }
 
+func syncBucketHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	bck, err := parseBckURI(c, c.Args().Get(0), false)
+	if err != nil {
+		return err
+	}
+	return syncBucket(c, bck)
+}
+
 func removeBucketHandler(c *cli.Context) (err error) {
 	var buckets []cmn.Bck
 	if buckets, err = bucketsFromArgsOrEnv(c); err != nil {
@@ -85,6 +85,16 @@ var (
 		Flags:  clusterCmdsFlags[commandStop],
 		Action: stopClusterRebalanceHandler,
 	}
+	pauseRebalance = cli.Command{
+		Name:   commandPause,
+		Usage:  "pause rebalancing ais cluster (to be resumed from where it left off)",
+		Action: pauseClusterRebalanceHandler,
+	}
+	resumeRebalance = cli.Command{
+		Name:   commandResume,
+		Usage:  "resume paused rebalance",
+		Action: resumeClusterRebalanceHandler,
+	}
 
 	clusterCmd = cli.Command{
 		Name:  commandCluster,
@@ -108,10 +118,12 @@ var (
 			},
 			{
 				Name:  cmdRebalance,
-				Usage: "administratively start and stop global rebalance; show global rebalance",
+				Usage: "administratively start, stop, pause, and resume global rebalance; show global rebalance",
 				Subcommands: []cli.Command{
 					startRebalance,
 					stopRebalance,
+					pauseRebalance,
+					resumeRebalance,
 					{
 						Name:         commandShow,
 						Usage:        "show global rebalance",
@@ -497,6 +509,22 @@ func stopClusterRebalanceHandler(c *cli.Context) error {
 	return nil
 }
 
+func pauseClusterRebalanceHandler(c *cli.Context) error {
+	if err := api.PauseRebalance(apiBP); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.App.Writer, "Paused %s\n", apc.ActRebalance)
+	return nil
+}
+
+func resumeClusterRebalanceHandler(c *cli.Context) error {
+	if err := api.ResumeRebalance(apiBP); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.App.Writer, "Resumed %s\n", apc.ActRebalance)
+	return nil
+}
+
 func showClusterRebalanceHandler(c *cli.Context) error {
 	var (
 		xid      = c.Args().Get(0)
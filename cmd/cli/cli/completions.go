@@ -377,6 +377,9 @@ func (opts *bcmplop) buckets(c *cli.Context) {
 			return
 		}
 		for provider := range config.Backend.Conf {
+			if !apc.IsProvider(provider) {
+				continue // reserved (non-provider) key, e.g. cmn.BackendMPU
+			}
 			if provider == apc.AIS {
 				qbck := cmn.QueryBcks{Provider: apc.AIS, Ns: cmn.NsAnyRemote}
 				fmt.Println(qbck)
@@ -455,6 +458,8 @@ func bpropsFilterExtra(c *cli.Context, tag string) bool {
 		return strings.HasPrefix(tag, "extra.http")
 	case apc.HDFS:
 		return strings.HasPrefix(tag, "extra.hdfs")
+	case apc.File:
+		return strings.HasPrefix(tag, "extra.file")
 	}
 	return false
 }
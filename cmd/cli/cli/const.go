@@ -8,6 +8,7 @@
 package cli
 
 import (
+	"net/http"
 	"strings"
 	"time"
 
@@ -63,11 +64,14 @@ const (
 	commandList      = "ls"
 	commandSetCustom = "set-custom"
 	commandPut       = "put"
+	commandPresign   = "presign"
 	commandRemove    = "rm"
 	commandRename    = "mv"
 	commandSet       = "set"
 	commandStart     = apc.ActXactStart
 	commandStop      = apc.ActXactStop
+	commandPause     = "pause"
+	commandResume    = "resume"
 	commandWait      = "wait"
 
 	cmdSmap   = apc.WhatSmap
@@ -84,14 +88,17 @@ const (
 
 	commandPromote  = apc.ActPromote
 	commandECEncode = apc.ActECEncode
+	commandECScrub  = apc.ActECScrub
 	commandMirror   = "mirror"   // display name for apc.ActMakeNCopies
 	commandEvict    = "evict"    // apc.ActEvictRemoteBck or apc.ActEvictObjects
 	commandPrefetch = "prefetch" // apc.ActPrefetchObjects
+	commandSync     = "sync"     // apc.ActSyncBck
 
 	cmdDownload    = apc.ActDownload
 	cmdRebalance   = apc.ActRebalance
 	cmdLRU         = apc.ActLRU
 	cmdStgCleanup  = "cleanup" // display name for apc.ActStoreCleanup
+	cmdStgScrub    = "scrub"   // ditto apc.ActStoreScrub
 	cmdStgValidate = "validate"
 	cmdSummary     = "summary" // ditto apc.ActSummaryBck
 
@@ -264,6 +271,7 @@ const (
 	concatObjectArgument  = "FILE|DIRECTORY[/PATTERN] [ FILE|DIRECTORY[/PATTERN] ...] " + objectArgument
 
 	renameObjectArgument = objectArgument + " NEW_OBJECT_NAME"
+	presignArgument      = optionalObjArgument
 
 	setCustomArgument = objectArgument + " " + jsonKeyValueArgument + " | " + keyValuePairsArgument + ", e.g.:\n" +
 		indent1 +
@@ -403,6 +411,11 @@ var (
 			indent4 + "\t'--prefix a/b/c' - sum-up sizes of the virtual directory a/b/c and objects from the virtual directory\n" +
 			indent4 + "\ta/b that have names (relative to this directory) starting with the letter c",
 	}
+	syncObjPrefixFlag = cli.StringFlag{
+		Name:  "prefix",
+		Usage: "sync only those objects that have names starting with the specified prefix",
+	}
+	syncRereadFlag = cli.BoolFlag{Name: "reread", Usage: "re-read (rather than evict) objects that have changed remotely"}
 
 	//
 	// longRunFlags
@@ -746,6 +759,19 @@ var (
 		Value: 24 * time.Hour,
 	}
 
+	// S3 presigned URL
+	presignMethodFlag = cli.StringFlag{
+		Name:  "method",
+		Usage: "HTTP method to presign: GET, PUT, or HEAD (GET with no object name: list objects)",
+		Value: http.MethodGet,
+	}
+	presignExpireFlag = DurationFlag{
+		Name: "expire,e",
+		Usage: "presigned URL expiration time (maximum 7 days);\n" +
+			indent4 + "\tvalid time units: " + timeUnits,
+		Value: time.Hour,
+	}
+
 	// Copy Bucket
 	copyDryRunFlag = cli.BoolFlag{
 		Name:  "dry-run",
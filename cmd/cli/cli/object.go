@@ -345,6 +345,12 @@ func propVal(op *cmn.ObjectProps, name string) (v string) {
 		} else {
 			v = cmn.CustomMD2S(custom)
 		}
+	case apc.GetPropsTags:
+		if tags := op.GetTags(); len(tags) == 0 {
+			v = teb.NotSetVal
+		} else {
+			v = cmn.Tags2S(tags)
+		}
 	case apc.GetPropsLocation:
 		v = op.Location
 	default:
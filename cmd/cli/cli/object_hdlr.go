@@ -9,8 +9,10 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/authn"
 	"github.com/NVIDIA/aistore/cmd/cli/teb"
 	"github.com/NVIDIA/aistore/cmn"
 	"github.com/NVIDIA/aistore/cmn/cos"
@@ -84,6 +86,10 @@ var (
 			cksumFlag,
 			forceFlag,
 		},
+		commandPresign: {
+			presignMethodFlag,
+			presignExpireFlag,
+		},
 	}
 
 	// define separately to allow for aliasing (see alias_hdlr.go)
@@ -171,6 +177,16 @@ var (
 				Action:       catHandler,
 				BashComplete: bucketCompletions(bcmplop{separator: true}),
 			},
+			{
+				Name: commandPresign,
+				Usage: "generate S3 presigned URL to GET, PUT, or HEAD an object (or list objects)\n" +
+					indent4 + "\t- with AuthN: signed with S3 credentials that AuthN issues for the logged-in user,\n" +
+					indent4 + "\t  and only for the given bucket, object, and method (see 'ais auth login')",
+				ArgsUsage:    presignArgument,
+				Flags:        objectCmdsFlags[commandPresign],
+				Action:       presignHandler,
+				BashComplete: bucketCompletions(bcmplop{separator: true}),
+			},
 		},
 	}
 )
@@ -402,3 +418,41 @@ func setCustomPropsHandler(c *cli.Context) (err error) {
 	}
 	return setCustomProps(c, bck, objName)
 }
+
+func presignHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	uri := c.Args().Get(0)
+	bck, objName, err := parseBckObjURI(c, uri, true /*optional objName*/)
+	if err != nil {
+		return err
+	}
+	var (
+		method  = strings.ToUpper(parseStrFlag(c, presignMethodFlag))
+		expires = parseDurationFlag(c, presignExpireFlag)
+		creds   = &api.S3Creds{AccessKey: "anonymous"} // AuthN disabled: the cluster only checks expiration
+	)
+	if authnHTTPClient != nil && loggedUserToken != "" {
+		smap, err := getClusterMap(c)
+		if err != nil {
+			return err
+		}
+		msg := &authn.S3PresignMsg{
+			S3Scope:   authn.S3Scope{Bck: bck, ObjName: objName, Method: method},
+			ClusterID: smap.UUID,
+			ExpiresIn: expires,
+		}
+		token, err := authn.GetS3Creds(authParams, msg)
+		if err != nil {
+			return err
+		}
+		creds = &api.S3Creds{AccessKey: token.S3AccessKey, SecretKey: token.S3SecretKey, SessionToken: token.Token}
+	}
+	u, err := api.PresignS3(apiBP, method, bck, objName, creds, expires)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(c.App.Writer, u)
+	return nil
+}
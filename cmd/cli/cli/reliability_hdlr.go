@@ -7,8 +7,14 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/api/apc"
 	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/xact"
 	"github.com/urfave/cli"
 )
 
@@ -21,6 +27,10 @@ var (
 			dataSlicesFlag,
 			paritySlicesFlag,
 		},
+		commandECScrub: {
+			waitFlag,
+			waitJobXactFinishedFlag,
+		},
 	}
 
 	storageSvcCmds = []cli.Command{
@@ -41,6 +51,15 @@ var (
 			Action:       ecEncodeHandler,
 			BashComplete: bucketCompletions(bcmplop{}),
 		},
+		{
+			Name: commandECScrub,
+			Usage: "verify erasure coded bucket: validate all slices and metafiles, and\n" +
+				indent4 + "\trebuild those that are missing, corrupted, or inconsistent",
+			ArgsUsage:    bucketArgument,
+			Flags:        storageSvcCmdsFlags[commandECScrub],
+			Action:       ecScrubHandler,
+			BashComplete: bucketCompletions(bcmplop{}),
+		},
 	}
 )
 
@@ -92,3 +111,70 @@ func ecEncodeHandler(c *cli.Context) (err error) {
 
 	return ecEncode(c, bck, dataSlices, paritySlices)
 }
+
+func ecScrubHandler(c *cli.Context) (err error) {
+	var (
+		bck cmn.Bck
+		p   *cmn.BucketProps
+	)
+	if bck, err = parseBckURI(c, c.Args().Get(0), false); err != nil {
+		return
+	}
+	if p, err = headBucket(bck, false /* don't add */); err != nil {
+		return
+	}
+	if !p.EC.Enabled {
+		return fmt.Errorf("bucket %q is not erasure-coded", bck.Cname(""))
+	}
+	xid, err := api.StartXaction(apiBP, xact.ArgsMsg{Kind: apc.ActECScrub, Bck: bck})
+	if err != nil {
+		return err
+	}
+	_, xname := xact.GetKindName(apc.ActECScrub)
+	text := fmt.Sprintf("%s[%s] %s", xname, xid, bck.Cname(""))
+	if !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
+		actionDone(c, text+". "+toMonitorMsg(c, xid, ""))
+		return nil
+	}
+
+	// wait
+	var timeout time.Duration
+	if flagIsSet(c, waitJobXactFinishedFlag) {
+		timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
+	}
+	fmt.Fprintln(c.App.Writer, text+" ...")
+	xargs := xact.ArgsMsg{ID: xid, Kind: apc.ActECScrub, Timeout: timeout}
+	if err := waitXact(apiBP, xargs); err != nil {
+		return err
+	}
+	return showScrubResults(c, xargs, "healthy", "repaired", "unrecoverable")
+}
+
+// sum up named extended stats (e.g., ec.ExtScrubStats) across all targets
+func showScrubResults(c *cli.Context, xargs xact.ArgsMsg, names ...string) error {
+	totals := make(map[string]int64, len(names))
+	xs, err := api.QueryXactionSnaps(apiBP, xargs)
+	if err != nil {
+		return err
+	}
+	for _, snaps := range xs {
+		for _, snap := range snaps {
+			ext, ok := snap.Ext.(map[string]any)
+			if !ok {
+				continue
+			}
+			for _, name := range names {
+				if v, ok := ext[name].(string); ok {
+					n, _ := strconv.ParseInt(v, 10, 64)
+					totals[name] += n
+				}
+			}
+		}
+	}
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		parts = append(parts, fmt.Sprintf("%s: %d", name, totals[name]))
+	}
+	fmt.Fprintln(c.App.Writer, strings.Join(parts, ", "))
+	return nil
+}
@@ -99,6 +99,15 @@ var (
 		Action:       cleanupStorageHandler,
 		BashComplete: bucketCompletions(bcmplop{}),
 	}
+	scrubCmd = cli.Command{
+		Name: cmdStgScrub,
+		Usage: "read all objects and all their copies, validate checksums, and restore corrupted (or missing) replicas\n" +
+			indent4 + "\t(from a good copy, from EC slices, or from the remote backend)",
+		ArgsUsage:    listAnyCommandArgument,
+		Flags:        cleanupFlags,
+		Action:       scrubStorageHandler,
+		BashComplete: bucketCompletions(bcmplop{}),
+	}
 )
 
 var (
@@ -179,6 +188,7 @@ var (
 			mpathCmd,
 			showCmdDisk,
 			cleanupCmd,
+			scrubCmd,
 		},
 	}
 )
@@ -230,6 +240,47 @@ func cleanupStorageHandler(c *cli.Context) (err error) {
 	return nil
 }
 
+//
+// scrub
+//
+
+func scrubStorageHandler(c *cli.Context) (err error) {
+	var bck cmn.Bck
+	if c.NArg() != 0 {
+		bck, err = parseBckURI(c, c.Args().Get(0), false)
+		if err != nil {
+			return
+		}
+		if _, err = headBucket(bck, true /* don't add */); err != nil {
+			return
+		}
+	}
+	xid, err := api.StartXaction(apiBP, xact.ArgsMsg{Kind: apc.ActStoreScrub, Bck: bck})
+	if err != nil {
+		return err
+	}
+	text := fmt.Sprintf("%s[%s]", cmdStgScrub, xid)
+	if !bck.IsEmpty() {
+		text += " " + bck.Cname("")
+	}
+	if !flagIsSet(c, waitFlag) && !flagIsSet(c, waitJobXactFinishedFlag) {
+		actionDone(c, text+". "+toMonitorMsg(c, xid, ""))
+		return nil
+	}
+
+	// wait
+	var timeout time.Duration
+	if flagIsSet(c, waitJobXactFinishedFlag) {
+		timeout = parseDurationFlag(c, waitJobXactFinishedFlag)
+	}
+	fmt.Fprintln(c.App.Writer, text+" ...")
+	xargs := xact.ArgsMsg{ID: xid, Kind: apc.ActStoreScrub, Timeout: timeout}
+	if err := waitXact(apiBP, xargs); err != nil {
+		return err
+	}
+	return showScrubResults(c, xargs, "corrupted", "restored", "unrecoverable")
+}
+
 //
 // disk
 //
@@ -20,6 +20,7 @@ var (
 		apc.GetPropsVersion:  "{{$obj.Version}}",
 		apc.GetPropsLocation: "{{$obj.Location}}",
 		apc.GetPropsCustom:   "{{FormatObjCustom $obj.Custom}}",
+		apc.GetPropsTags:     "{{$obj.Tags}}",
 		apc.GetPropsStatus:   "{{FormatObjStatus $obj}}",
 		apc.GetPropsCopies:   "{{$obj.Copies}}",
 		apc.GetPropsCached:   "{{FormatObjIsCached $obj}}",
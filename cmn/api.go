@@ -8,6 +8,8 @@ package cmn
 import (
 	"fmt"
 	"math"
+	"net/url"
+	"path/filepath"
 	"reflect"
 	"sort"
 	"strings"
@@ -42,6 +44,10 @@ type (
 	BucketProps struct {
 		BackendBck  Bck             `json:"backend_bck,omitempty"` // makes remote bucket out of a given ais bucket
 		Extra       ExtraProps      `json:"extra,omitempty" list:"omitempty"`
+		Lifecycle   LifecycleConf   `json:"lifecycle,omitempty" list:"omitempty"`
+		CORS        CORSConf        `json:"cors,omitempty" list:"omitempty"`
+		ObjLock     ObjLockConf     `json:"object_lock,omitempty" list:"omitempty"`
+		Replication ReplicationConf `json:"replication,omitempty" list:"omitempty"`
 		WritePolicy WritePolicyConf `json:"write_policy"`
 		Provider    string          `json:"provider" list:"readonly"`       // backend provider
 		Renamed     string          `list:"omit"`                           // non-empty if the bucket has been renamed
@@ -59,11 +65,13 @@ type (
 		AWS  ExtraPropsAWS  `json:"aws,omitempty" list:"omitempty"`
 		HTTP ExtraPropsHTTP `json:"http,omitempty" list:"omitempty"`
 		HDFS ExtraPropsHDFS `json:"hdfs,omitempty" list:"omitempty"`
+		File ExtraPropsFile `json:"file,omitempty" list:"omitempty"`
 	}
 	ExtraToUpdate struct { // ref. bpropsFilterExtra
 		AWS  *ExtraPropsAWSToUpdate  `json:"aws"`
 		HTTP *ExtraPropsHTTPToUpdate `json:"http"`
 		HDFS *ExtraPropsHDFSToUpdate `json:"hdfs"`
+		File *ExtraPropsFileToUpdate `json:"file"`
 	}
 
 	ExtraPropsAWS struct {
@@ -73,18 +81,31 @@ type (
 		//   "An optional endpoint URL (hostname only or fully qualified URI)
 		//    that overrides the default generated endpoint."
 		Endpoint string `json:"endpoint,omitempty"`
+
+		// Named credentials profile in the shared credentials file (`~/.aws/credentials`)
+		// that must be present on each target; empty - default credentials. Together with
+		// the endpoint, allows to access multiple S3-compatible storages with different keys.
+		Profile string `json:"profile,omitempty"`
 	}
 	ExtraPropsAWSToUpdate struct {
 		CloudRegion *string `json:"cloud_region"`
 		Endpoint    *string `json:"endpoint"`
+		Profile     *string `json:"profile"`
 	}
 
 	ExtraPropsHTTP struct {
 		// Original URL prior to hashing.
 		OrigURLBck string `json:"original_url,omitempty" list:"readonly"`
+
+		// How to list objects (empty - not listable):
+		// - HTTPListAutoindex: crawl Apache/nginx-generated (autoindex) HTML pages;
+		// - otherwise, manifest: relative (to the original URL) or absolute URL of a text file
+		//   containing one (relative) object URL per line, or JSON array of the same.
+		Listing string `json:"listing,omitempty"`
 	}
 	ExtraPropsHTTPToUpdate struct {
 		OrigURLBck *string `json:"original_url"`
+		Listing    *string `json:"listing"`
 	}
 
 	ExtraPropsHDFS struct {
@@ -95,6 +116,14 @@ type (
 		RefDirectory *string `json:"ref_directory"`
 	}
 
+	ExtraPropsFile struct {
+		// Reference (local or, e.g., NFS-mounted) directory - must be absolute.
+		RefDirectory string `json:"ref_directory,omitempty"`
+	}
+	ExtraPropsFileToUpdate struct {
+		RefDirectory *string `json:"ref_directory"`
+	}
+
 	// Once validated, BucketPropsToUpdate are copied to BucketProps.
 	// The struct may have extra fields that do not exist in BucketProps.
 	// Add tag 'copy:"skip"' to ignore those fields when copying values.
@@ -108,6 +137,10 @@ type (
 		Access      *apc.AccessAttrs         `json:"access,string,omitempty"`
 		WritePolicy *WritePolicyConfToUpdate `json:"write_policy,omitempty"`
 		Extra       *ExtraToUpdate           `json:"extra,omitempty"`
+		Lifecycle   *LifecycleConfToUpdate   `json:"lifecycle,omitempty"`
+		CORS        *CORSConfToUpdate        `json:"cors,omitempty"`
+		ObjLock     *ObjLockConfToUpdate     `json:"object_lock,omitempty"`
+		Replication *ReplicationConfToUpdate `json:"replication,omitempty"`
 		Force       bool                     `json:"force,omitempty" copy:"skip" list:"omit"`
 	}
 
@@ -197,7 +230,8 @@ func (bp *BucketProps) Validate(targetCnt int) error {
 		}
 	}
 	var softErr error
-	for _, pv := range []PropsValidator{&bp.Cksum, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy} {
+	for _, pv := range []PropsValidator{&bp.Cksum, &bp.Mirror, &bp.EC, &bp.Extra, &bp.WritePolicy, &bp.Lifecycle, &bp.CORS,
+		&bp.ObjLock, &bp.Replication} {
 		var err error
 		if pv == &bp.EC {
 			err = bp.EC.ValidateAsProps(targetCnt)
@@ -251,18 +285,40 @@ func (c *ExtraProps) ValidateAsProps(arg ...any) error {
 	provider, ok := arg[0].(string)
 	debug.Assert(ok)
 	switch provider {
+	case apc.AWS:
+		if c.AWS.Profile != "" && !cos.IsAlphaPlus(c.AWS.Profile) {
+			return fmt.Errorf("invalid credentials profile %q (expecting letters, numbers, dashes, underscores, and periods)",
+				c.AWS.Profile)
+		}
 	case apc.HDFS:
 		if c.HDFS.RefDirectory == "" {
 			return fmt.Errorf("reference directory must be set for a bucket with HDFS provider")
 		}
+	case apc.File:
+		if c.File.RefDirectory == "" {
+			return fmt.Errorf("reference directory must be set for a bucket with %s provider", apc.File)
+		}
+		if !filepath.IsAbs(c.File.RefDirectory) {
+			return fmt.Errorf("reference directory %q must be an absolute path", c.File.RefDirectory)
+		}
 	case apc.HTTP:
 		if c.HTTP.OrigURLBck == "" {
 			return fmt.Errorf("original bucket URL must be set for a bucket with HTTP provider")
 		}
+		if c.HTTP.Listing != "" && c.HTTP.Listing != HTTPListAutoindex {
+			if _, err := url.Parse(c.HTTP.Listing); err != nil {
+				return fmt.Errorf("invalid manifest URL %q: %v", c.HTTP.Listing, err)
+			}
+		}
 	}
 	return nil
 }
 
+// see ExtraPropsHTTP.Listing
+const HTTPListAutoindex = "autoindex"
+
+func (c *ExtraPropsHTTP) Listable() bool { return c.Listing != "" }
+
 //
 // Bucket Summary - result for a given bucket, and all results -------------------------------------------------
 //
@@ -349,6 +349,7 @@ func (b *Bck) IsAIS() bool {
 func (b *Bck) IsRemoteAIS() bool { return b.Provider == apc.AIS && b.Ns.IsRemote() }
 func (b *Bck) IsHDFS() bool      { return b.Provider == apc.HDFS }
 func (b *Bck) IsHTTP() bool      { return b.Provider == apc.HTTP }
+func (b *Bck) IsFile() bool      { return b.Provider == apc.File }
 
 func (b *Bck) IsRemote() bool {
 	return apc.IsRemoteProvider(b.Provider) || b.IsRemoteAIS() || b.Backend() != nil
@@ -430,6 +431,7 @@ func (qbck QueryBcks) String() string {
 func (qbck *QueryBcks) IsAIS() bool       { b := (*Bck)(qbck); return b.IsAIS() }
 func (qbck *QueryBcks) IsHDFS() bool      { b := (*Bck)(qbck); return b.IsHDFS() }
 func (qbck *QueryBcks) IsHTTP() bool      { b := (*Bck)(qbck); return b.IsHTTP() }
+func (qbck *QueryBcks) IsFile() bool      { b := (*Bck)(qbck); return b.IsFile() }
 func (qbck *QueryBcks) IsRemoteAIS() bool { b := (*Bck)(qbck); return b.IsRemoteAIS() }
 func (qbck *QueryBcks) IsCloud() bool     { return apc.IsCloudProvider(qbck.Provider) }
 
@@ -79,6 +79,12 @@ type (
 		// Transform (offline) or Copy src Bucket => dst bucket
 		TCB TCBConf `json:"tcb"`
 
+		// parallel (chunked) cold GET of large remote objects
+		ColdGet ColdGetConf `json:"cold_get"`
+
+		// periodic scrubbing: validate checksums of all objects and their copies
+		Scrub ScrubConf `json:"scrub"`
+
 		// metadata write policy: (immediate | delayed | never)
 		WritePolicy WritePolicyConf `json:"write_policy"`
 
@@ -116,6 +122,8 @@ type (
 		Transport   *TransportConfToUpdate   `json:"transport,omitempty"`
 		Memsys      *MemsysConfToUpdate      `json:"memsys,omitempty"`
 		TCB         *TCBConfToUpdate         `json:"tcb,omitempty"`
+		ColdGet     *ColdGetConfToUpdate     `json:"cold_get,omitempty"`
+		Scrub       *ScrubConfToUpdate       `json:"scrub,omitempty"`
 		WritePolicy *WritePolicyConfToUpdate `json:"write_policy,omitempty"`
 		Proxy       *ProxyConfToUpdate       `json:"proxy,omitempty"`
 		Features    *feat.Flags              `json:"features,string,omitempty"`
@@ -130,6 +138,15 @@ type (
 		HostNet   LocalNetConfig `json:"host_net"`
 		FSP       FSPConf        `json:"fspaths"`
 		TestFSP   TestFSPConf    `json:"test_fspaths"`
+		Topology  LocalTopoConf  `json:"topology"`
+	}
+
+	// Node's failure domain (optional): rack and availability zone labels that the node reports
+	// when joining the cluster, to be then stored in the cluster map (see meta.Snode) and used
+	// to spread EC slices across racks and zones (see cluster.HrwTargetList)
+	LocalTopoConf struct {
+		Rack string `json:"rack,omitempty"`
+		Zone string `json:"zone,omitempty"`
 	}
 
 	// Network config specific to node
@@ -150,6 +167,41 @@ type (
 		Conf map[string]any `json:"conf,omitempty"`
 		// 3rd party Cloud(s) -- set during validation
 		Providers map[string]Ns `json:"-"`
+		// multipart upload to 3rd party Cloud(s) -- set during validation
+		// (configured under the reserved `BackendMPU` key)
+		MPU BackendConfMPU `json:"-"`
+		// rate limiting and throttling (configured under the reserved `BackendRateLimit` key)
+		RateLimit BackendConfRateLimit `json:"-"`
+		// file:// backend (configured under the `file` provider key)
+		File BackendConfFile `json:"-"`
+	}
+	BackendConfMPU struct {
+		// objects larger than this size get uploaded in parts of (about) this size
+		// (zero value: disabled - single-shot upload)
+		PartSize cos.SizeIEC `json:"part_size"`
+		// max number of parts uploaded concurrently (per object; zero value: use default)
+		NumWorkers int `json:"num_workers"`
+		// max number of attempts to upload a given part (zero value: use default)
+		MaxRetries int `json:"max_retries"`
+		// uploads neither completed nor resumed for longer than this get aborted (zero value: use default)
+		AbandonedTime cos.Duration `json:"abandoned_time"`
+	}
+	BackendConfRateLimit struct {
+		// cluster-wide max number of requests per second by provider (e.g., "aws")
+		// and/or bucket (e.g., "s3://abc"); each target enforces its (1/num-targets) share
+		RPS map[string]float64 `json:"rps,omitempty"`
+		// max number of times to retry a request upon throttling response (429 or 503),
+		// with exponential backoff (zero value: use default; negative: do not retry)
+		MaxRetries int `json:"max_retries"`
+		// max backoff between retries (zero value: use default)
+		MaxBackoff cos.Duration `json:"max_backoff"`
+		// (normalized RPS - set during validation)
+		limits map[string]float64
+	}
+	BackendConfFile struct {
+		// absolute paths of the directories that may contain `extra.file.ref_directory`
+		// of a file:// bucket (none: file:// buckets cannot be created or accessed)
+		Roots []string `json:"roots"`
 	}
 	BackendConfHDFS struct {
 		Addresses           []string `json:"addresses"`
@@ -274,12 +326,17 @@ type (
 		// Out-of-Space: if exceeded, the target starts failing new PUTs and keeps
 		// failing them until its local used-cap gets back below HighWM (see above)
 		OOS int64 `json:"out_of_space"`
+
+		// S3 multipart uploads that remain neither completed nor aborted for longer
+		// than this duration get removed by storage cleanup (zero value: use default)
+		AbandonedMptTime cos.Duration `json:"abandoned_mpt_time"`
 	}
 	SpaceConfToUpdate struct {
-		CleanupWM *int64 `json:"cleanupwm,omitempty"`
-		LowWM     *int64 `json:"lowwm,omitempty"`
-		HighWM    *int64 `json:"highwm,omitempty"`
-		OOS       *int64 `json:"out_of_space,omitempty"`
+		CleanupWM        *int64        `json:"cleanupwm,omitempty"`
+		LowWM            *int64        `json:"lowwm,omitempty"`
+		HighWM           *int64        `json:"highwm,omitempty"`
+		OOS              *int64        `json:"out_of_space,omitempty"`
+		AbandonedMptTime *cos.Duration `json:"abandoned_mpt_time,omitempty"`
 	}
 
 	LRUConf struct {
@@ -318,12 +375,14 @@ type (
 		Compression   string       `json:"compression"`       // enum { CompressAlways, ... } in api/apc/compression.go
 		DestRetryTime cos.Duration `json:"dest_retry_time"`   // max wait for ACKs & neighbors to complete
 		SbundleMult   int          `json:"bundle_multiplier"` // stream-bundle multiplier: num streams to destination
+		Bandwidth     cos.SizeIEC  `json:"bandwidth"`         // max outgoing bytes per second, per target (0: unlimited)
 		Enabled       bool         `json:"enabled"`           // true=auto-rebalance | manual rebalancing
 	}
 	RebalanceConfToUpdate struct {
 		DestRetryTime *cos.Duration `json:"dest_retry_time,omitempty"`
 		Compression   *string       `json:"compression,omitempty"`
 		SbundleMult   *int          `json:"bundle_multiplier"`
+		Bandwidth     *cos.SizeIEC  `json:"bandwidth,omitempty"`
 		Enabled       *bool         `json:"enabled,omitempty"`
 	}
 
@@ -370,10 +429,15 @@ type (
 
 		// Validate object version upon warm GET.
 		ValidateWarmGet bool `json:"validate_warm_get"`
+
+		// Number of prior object versions (generations) to retain upon overwrite;
+		// zero (default) - none. Applies to ais:// buckets only.
+		Retain int `json:"retain"`
 	}
 	VersionConfToUpdate struct {
 		Enabled         *bool `json:"enabled,omitempty"`
 		ValidateWarmGet *bool `json:"validate_warm_get,omitempty"`
+		Retain          *int  `json:"retain,omitempty"`
 	}
 
 	TestFSPConf struct {
@@ -542,12 +606,36 @@ type (
 		SbundleMult *int    `json:"bundle_multiplier,omitempty"`
 	}
 
+	ColdGetConf struct {
+		// remote objects of (at least) this size get fetched in byte ranges by multiple
+		// concurrent workers (zero value: disabled - always use a single stream)
+		MinSize cos.SizeIEC `json:"min_size"`
+		// size of a single byte range (zero value: use default)
+		ChunkSize cos.SizeIEC `json:"chunk_size"`
+		// max number of concurrent range requests per object (zero value: use default)
+		NumWorkers int `json:"num_workers"`
+	}
+	ColdGetConfToUpdate struct {
+		MinSize    *cos.SizeIEC `json:"min_size,omitempty"`
+		ChunkSize  *cos.SizeIEC `json:"chunk_size,omitempty"`
+		NumWorkers *int         `json:"num_workers,omitempty"`
+	}
+
+	ScrubConf struct {
+		// how often to scrub all buckets (zero value: disabled - scrub only on demand,
+		// via `ais storage scrub`)
+		Interval cos.Duration `json:"interval"`
+	}
+	ScrubConfToUpdate struct {
+		Interval *cos.Duration `json:"interval,omitempty"`
+	}
+
 	WritePolicyConf struct {
 		Data apc.WritePolicy `json:"data"`
 		MD   apc.WritePolicy `json:"md"`
 	}
 	WritePolicyConfToUpdate struct {
-		Data *apc.WritePolicy `json:"data,omitempty" list:"readonly"` // NOTE: NIY
+		Data *apc.WritePolicy `json:"data,omitempty"`
 		MD   *apc.WritePolicy `json:"md,omitempty"`
 	}
 )
@@ -617,6 +705,8 @@ var (
 	_ Validator = (*TransportConf)(nil)
 	_ Validator = (*MemsysConf)(nil)
 	_ Validator = (*TCBConf)(nil)
+	_ Validator = (*ColdGetConf)(nil)
+	_ Validator = (*ScrubConf)(nil)
 	_ Validator = (*WritePolicyConf)(nil)
 
 	_ PropsValidator = (*CksumConf)(nil)
@@ -791,9 +881,17 @@ func (c *ClientConf) Validate() error {
 // BackendConf //
 /////////////////
 
+// reserved (non-provider) keys
+const (
+	BackendMPU       = "multipart"
+	BackendRateLimit = "rate_limit"
+)
+
 func (c *BackendConf) keys() (v []string) {
 	for k := range c.Conf {
-		v = append(v, k)
+		if k != BackendMPU && k != BackendRateLimit {
+			v = append(v, k)
+		}
 	}
 	return
 }
@@ -807,6 +905,9 @@ func (c *BackendConf) MarshalJSON() (data []byte, err error) {
 }
 
 func (c *BackendConf) Validate() (err error) {
+	c.MPU = BackendConfMPU{}
+	c.RateLimit = BackendConfRateLimit{}
+	c.File = BackendConfFile{}
 	for provider := range c.Conf {
 		b := cos.MustMarshal(c.Conf[provider])
 		switch provider {
@@ -853,6 +954,37 @@ func (c *BackendConf) Validate() (err error) {
 
 			c.Conf[provider] = hdfsConf
 			c.setProvider(provider)
+		case apc.File:
+			var fileConf BackendConfFile
+			if err := jsoniter.Unmarshal(b, &fileConf); err != nil {
+				return fmt.Errorf("invalid %s specification: %v", apc.File, err)
+			}
+			if err := fileConf.Validate(); err != nil {
+				return err
+			}
+			c.Conf[provider] = fileConf
+			c.File = fileConf
+			c.setProvider(provider)
+		case BackendMPU:
+			var mpuConf BackendConfMPU
+			if err := jsoniter.Unmarshal(b, &mpuConf); err != nil {
+				return fmt.Errorf("invalid %s specification: %v", BackendMPU, err)
+			}
+			if err := mpuConf.Validate(); err != nil {
+				return err
+			}
+			c.Conf[provider] = mpuConf
+			c.MPU = mpuConf
+		case BackendRateLimit:
+			var rlConf BackendConfRateLimit
+			if err := jsoniter.Unmarshal(b, &rlConf); err != nil {
+				return fmt.Errorf("invalid %s specification: %v", BackendRateLimit, err)
+			}
+			if err := rlConf.Validate(); err != nil {
+				return err
+			}
+			c.Conf[provider] = rlConf
+			c.RateLimit = rlConf
 		case "":
 			continue
 		default:
@@ -865,7 +997,7 @@ func (c *BackendConf) Validate() (err error) {
 func (c *BackendConf) setProvider(provider string) {
 	var ns Ns
 	switch provider {
-	case apc.AWS, apc.Azure, apc.GCP, apc.HDFS:
+	case apc.AWS, apc.Azure, apc.GCP, apc.HDFS, apc.File:
 		ns = NsGlobal
 	default:
 		debug.Assert(false, "unknown backend provider "+provider)
@@ -927,6 +1059,114 @@ func (c *BackendConf) EqualRemAIS(o *BackendConf, sname string) bool {
 	return true
 }
 
+// defaults for BackendConfMPU
+const (
+	dfltMpuNumWorkers    = 4
+	dfltMpuMaxRetries    = 3
+	dfltMpuAbandonedTime = 24 * time.Hour
+)
+
+func (c *BackendConfMPU) Validate() error {
+	if c.PartSize != 0 && (c.PartSize < 5*cos.MiB || c.PartSize > 5*cos.GiB) {
+		return fmt.Errorf("invalid backend.%s.part_size=%s (expected range [5MiB, 5GiB])", BackendMPU, c.PartSize)
+	}
+	if c.NumWorkers < 0 || c.NumWorkers > 64 {
+		return fmt.Errorf("invalid backend.%s.num_workers=%d (expected range [0, 64])", BackendMPU, c.NumWorkers)
+	}
+	if c.MaxRetries < 0 || c.MaxRetries > 100 {
+		return fmt.Errorf("invalid backend.%s.max_retries=%d (expected range [0, 100])", BackendMPU, c.MaxRetries)
+	}
+	if c.AbandonedTime < 0 || (c.AbandonedTime > 0 && c.AbandonedTime.D() < time.Hour) {
+		return fmt.Errorf("invalid backend.%s.abandoned_time=%s (expected zero or at least 1h)", BackendMPU, c.AbandonedTime)
+	}
+	return nil
+}
+
+func (c *BackendConfMPU) Enabled() bool { return c.PartSize > 0 }
+
+func (c *BackendConfMPU) Workers() int {
+	if c.NumWorkers == 0 {
+		return dfltMpuNumWorkers
+	}
+	return c.NumWorkers
+}
+
+func (c *BackendConfMPU) Retries() int {
+	if c.MaxRetries == 0 {
+		return dfltMpuMaxRetries
+	}
+	return c.MaxRetries
+}
+
+func (c *BackendConfMPU) Abandoned() time.Duration {
+	if c.AbandonedTime == 0 {
+		return dfltMpuAbandonedTime
+	}
+	return c.AbandonedTime.D()
+}
+
+func (c *BackendConfFile) Validate() error {
+	for i, root := range c.Roots {
+		if !filepath.IsAbs(root) {
+			return fmt.Errorf("invalid backend.%s.roots: %q is not an absolute path", apc.File, root)
+		}
+		c.Roots[i] = filepath.Clean(root)
+	}
+	return nil
+}
+
+// defaults for BackendConfRateLimit
+const (
+	dfltThrottleMaxRetries = 5
+	dfltThrottleMaxBackoff = 10 * time.Second
+)
+
+func (c *BackendConfRateLimit) Validate() error {
+	c.limits = make(map[string]float64, len(c.RPS))
+	for k, rps := range c.RPS {
+		if rps <= 0 {
+			return fmt.Errorf("invalid backend.%s.rps[%q]=%v (expecting positive number)", BackendRateLimit, k, rps)
+		}
+		if provider, err := NormalizeProvider(k); err == nil && apc.IsRemoteProvider(provider) {
+			c.limits[provider] = rps
+			continue
+		}
+		bck, objName, err := ParseBckObjectURI(k, ParseURIOpts{})
+		if err != nil || objName != "" || bck.Name == "" || !apc.IsRemoteProvider(bck.Provider) || bck.Ns.IsRemote() {
+			return fmt.Errorf("invalid backend.%s.rps key %q (expecting remote provider, e.g. \"aws\", or bucket, e.g. \"s3://abc\")",
+				BackendRateLimit, k)
+		}
+		c.limits[bck.MakeUname("")] = rps
+	}
+	if c.MaxRetries > 100 {
+		return fmt.Errorf("invalid backend.%s.max_retries=%d (expected range [0, 100])", BackendRateLimit, c.MaxRetries)
+	}
+	if c.MaxBackoff < 0 {
+		return fmt.Errorf("invalid backend.%s.max_backoff=%v", BackendRateLimit, c.MaxBackoff)
+	}
+	return nil
+}
+
+// cluster-wide limits by provider and by bucket (cmn.Bck.MakeUname)
+func (c *BackendConfRateLimit) Limits() map[string]float64 { return c.limits }
+
+func (c *BackendConfRateLimit) Retries() int {
+	switch {
+	case c.MaxRetries < 0:
+		return 0
+	case c.MaxRetries == 0:
+		return dfltThrottleMaxRetries
+	}
+	return c.MaxRetries
+}
+
+func (c *BackendConfRateLimit) Backoff() time.Duration {
+	if c.MaxBackoff == 0 {
+		return dfltThrottleMaxBackoff
+	}
+	return c.MaxBackoff.D()
+}
+
 func (c BackendConfAIS) String() (s string) {
 	for a, urls := range c {
 		if len(s) > 0 {
@@ -966,10 +1206,22 @@ func (c *DiskConf) Validate() (err error) {
 func (c *SpaceConf) Validate() (err error) {
 	if c.CleanupWM <= 0 || c.LowWM < c.CleanupWM || c.HighWM < c.LowWM || c.OOS < c.HighWM || c.OOS > 100 {
 		err = fmt.Errorf("invalid %s (expecting: 0 < cleanup < low < high < OOS < 100)", c)
+	} else if c.AbandonedMptTime < 0 {
+		err = fmt.Errorf("invalid space.abandoned_mpt_time=%v (expecting non-negative)", c.AbandonedMptTime)
 	}
 	return
 }
 
+// default for SpaceConf.AbandonedMptTime
+const dfltAbandonedMptTime = 24 * time.Hour
+
+func (c *SpaceConf) AbandonedMpt() time.Duration {
+	if c.AbandonedMptTime == 0 {
+		return dfltAbandonedMptTime
+	}
+	return c.AbandonedMptTime.D()
+}
+
 func (c *SpaceConf) ValidateAsProps(...any) error { return c.Validate() }
 
 func (c *SpaceConf) String() string {
@@ -1035,10 +1287,18 @@ func (c *CksumConf) String() string {
 // VersionConf //
 /////////////////
 
+const MaxVerRetain = 1000 // max number of retained prior versions (per object)
+
 func (c *VersionConf) Validate() error {
 	if !c.Enabled && c.ValidateWarmGet {
 		return errors.New("versioning.validate_warm_get requires versioning to be enabled")
 	}
+	if c.Retain < 0 || c.Retain > MaxVerRetain {
+		return fmt.Errorf("invalid versioning.retain=%d (expecting range [0, %d])", c.Retain, MaxVerRetain)
+	}
+	if !c.Enabled && c.Retain > 0 {
+		return errors.New("versioning.retain requires versioning to be enabled")
+	}
 	return nil
 }
 
@@ -1053,6 +1313,9 @@ func (c *VersionConf) String() string {
 	} else {
 		text += "no"
 	}
+	if c.Retain > 0 {
+		text += " | Retain: " + strconv.Itoa(c.Retain)
+	}
 
 	return text
 }
@@ -1161,7 +1424,7 @@ func (c *ECConf) RequiredRestoreTargets() int {
 func (c *WritePolicyConf) Validate() (err error) {
 	err = c.Data.Validate()
 	if err == nil {
-		if !c.Data.IsImmediate() {
+		if c.Data == apc.WriteNever {
 			return fmt.Errorf("invalid write policy for data: %q not implemented yet", c.Data)
 		}
 		err = c.MD.Validate()
@@ -1440,6 +1703,20 @@ func (c *TestFSPConf) ValidateMpath(p string) (err error) {
 	return
 }
 
+///////////////////
+// LocalTopoConf //
+///////////////////
+
+func (c *LocalTopoConf) Validate() error {
+	if !cos.IsAlphaPlus(c.Rack) {
+		return fmt.Errorf("invalid topology.rack %q (expecting letters, numbers, dashes, underscores, and periods)", c.Rack)
+	}
+	if !cos.IsAlphaPlus(c.Zone) {
+		return fmt.Errorf("invalid topology.zone %q (expecting letters, numbers, dashes, underscores, and periods)", c.Zone)
+	}
+	return nil
+}
+
 // common mountpath validation (NOTE: calls filepath.Clean() every time)
 func ValidateMpath(mpath string) (string, error) {
 	cleanMpath := filepath.Clean(mpath)
@@ -1526,6 +1803,60 @@ func (c *TCBConf) Validate() error {
 	return nil
 }
 
+/////////////////
+// ColdGetConf //
+/////////////////
+
+// defaults for ColdGetConf
+const (
+	dfltColdGetChunkSize  = 64 * cos.MiB
+	dfltColdGetNumWorkers = 8
+)
+
+func (c *ColdGetConf) Validate() error {
+	if c.MinSize < 0 {
+		return fmt.Errorf("invalid cold_get.min_size=%d (expecting non-negative)", c.MinSize)
+	}
+	if c.ChunkSize != 0 && (c.ChunkSize < cos.MiB || c.ChunkSize > 4*cos.GiB) {
+		return fmt.Errorf("invalid cold_get.chunk_size=%s (expected range [1MiB, 4GiB])", c.ChunkSize)
+	}
+	if c.MinSize > 0 && int64(c.MinSize) < c.Chunk() {
+		return fmt.Errorf("invalid cold_get.min_size=%s (cannot be smaller than chunk size %s)",
+			c.MinSize, cos.ToSizeIEC(c.Chunk(), 0))
+	}
+	if c.NumWorkers < 0 || c.NumWorkers > 64 {
+		return fmt.Errorf("invalid cold_get.num_workers=%d (expected range [0, 64])", c.NumWorkers)
+	}
+	return nil
+}
+
+func (c *ColdGetConf) Enabled() bool { return c.MinSize > 0 }
+
+func (c *ColdGetConf) Chunk() int64 {
+	if c.ChunkSize == 0 {
+		return dfltColdGetChunkSize
+	}
+	return int64(c.ChunkSize)
+}
+
+func (c *ColdGetConf) Workers() int {
+	if c.NumWorkers == 0 {
+		return dfltColdGetNumWorkers
+	}
+	return c.NumWorkers
+}
+
+///////////////
+// ScrubConf //
+///////////////
+
+func (c *ScrubConf) Validate() error {
+	if c.Interval != 0 && c.Interval.D() < time.Hour {
+		return fmt.Errorf("invalid scrub.interval=%s (expecting 0 (disabled) or at least 1h)", c.Interval)
+	}
+	return nil
+}
+
 /////////////////
 // TimeoutConf //
 /////////////////
@@ -1585,6 +1916,9 @@ func (c *RebalanceConf) Validate() error {
 	if c.SbundleMult < 0 || c.SbundleMult > 16 {
 		return fmt.Errorf("invalid rebalance.bundle_multiplier: %v (expected range [0, 16])", c.SbundleMult)
 	}
+	if c.Bandwidth < 0 {
+		return fmt.Errorf("invalid rebalance.bandwidth: %d (expecting non-negative)", c.Bandwidth)
+	}
 	if !apc.IsValidCompression(c.Compression) {
 		return fmt.Errorf("invalid rebalance.compression: %q (expecting one of: %v)",
 			c.Compression, apc.SupportedCompression)
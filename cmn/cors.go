@@ -0,0 +1,133 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Bucket CORS (cross-origin resource sharing) rules - compare with S3 PutBucketCors.
+// Used by S3 API handlers to respond to preflight (OPTIONS) requests and to set
+// `Access-Control-*` headers when serving cross-origin requests.
+
+const MaxCORSRules = 100 // same as S3
+
+type (
+	CORSConf struct {
+		Rules []CORSRule `json:"rules,omitempty" list:"readonly"`
+	}
+	CORSConfToUpdate struct {
+		Rules *[]CORSRule `json:"rules,omitempty"`
+	}
+
+	// Origins and headers may contain (at most) one '*' wildcard each.
+	CORSRule struct {
+		ID             string   `json:"id,omitempty"`
+		AllowedOrigins []string `json:"allowed_origins"`
+		AllowedMethods []string `json:"allowed_methods"`
+		AllowedHeaders []string `json:"allowed_headers,omitempty"`
+		ExposeHeaders  []string `json:"expose_headers,omitempty"`
+		MaxAgeSeconds  int      `json:"max_age_seconds,omitempty"`
+	}
+)
+
+var corsMethods = []string{http.MethodGet, http.MethodPut, http.MethodHead, http.MethodPost, http.MethodDelete}
+
+//////////////
+// CORSConf //
+//////////////
+
+func (c *CORSConf) ValidateAsProps(...any) error {
+	if len(c.Rules) > MaxCORSRules {
+		return fmt.Errorf("too many CORS rules (%d, max %d)", len(c.Rules), MaxCORSRules)
+	}
+	for i := range c.Rules {
+		if err := c.Rules[i].validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the first rule that allows a given cross-origin request, or nil.
+// Note that `reqHeaders` are only specified for preflight requests.
+func (c *CORSConf) Match(origin, method string, reqHeaders []string) *CORSRule {
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		if rule.matchOrigin(origin) && cos.StringInSlice(method, rule.AllowedMethods) && rule.allowsHeaders(reqHeaders) {
+			return rule
+		}
+	}
+	return nil
+}
+
+//////////////
+// CORSRule //
+//////////////
+
+func (r *CORSRule) validate() error {
+	if len(r.AllowedOrigins) == 0 || len(r.AllowedMethods) == 0 {
+		return fmt.Errorf("CORS rule %q: allowed origins and methods must be specified", r.ID)
+	}
+	for _, m := range r.AllowedMethods {
+		if !cos.StringInSlice(m, corsMethods) {
+			return fmt.Errorf("CORS rule %q: invalid method %q (expecting one of: %v)", r.ID, m, corsMethods)
+		}
+	}
+	for _, o := range r.AllowedOrigins {
+		if strings.Count(o, "*") > 1 {
+			return fmt.Errorf("CORS rule %q: origin %q contains more than one wildcard", r.ID, o)
+		}
+	}
+	for _, h := range r.AllowedHeaders {
+		if strings.Count(h, "*") > 1 {
+			return fmt.Errorf("CORS rule %q: header %q contains more than one wildcard", r.ID, h)
+		}
+	}
+	if r.MaxAgeSeconds < 0 {
+		return fmt.Errorf("CORS rule %q: invalid max age %d", r.ID, r.MaxAgeSeconds)
+	}
+	return nil
+}
+
+// Returns true if any origin is allowed ("*")
+func (r *CORSRule) AnyOrigin() bool { return cos.StringInSlice("*", r.AllowedOrigins) }
+
+func (r *CORSRule) matchOrigin(origin string) bool {
+	for _, o := range r.AllowedOrigins {
+		if matchWildcard(o, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *CORSRule) allowsHeaders(hdrs []string) bool {
+outer:
+	for _, h := range hdrs {
+		for _, a := range r.AllowedHeaders {
+			if matchWildcard(strings.ToLower(a), strings.ToLower(h)) {
+				continue outer
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// pattern may contain at most one '*'
+func matchWildcard(pattern, s string) bool {
+	i := strings.IndexByte(pattern, '*')
+	if i < 0 {
+		return pattern == s
+	}
+	prefix, suffix := pattern[:i], pattern[i+1:]
+	return len(s) >= len(prefix)+len(suffix) && strings.HasPrefix(s, prefix) && strings.HasSuffix(s, suffix)
+}
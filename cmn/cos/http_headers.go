@@ -40,6 +40,18 @@ const (
 	HdrLocation  = "Location"
 	HdrServer    = "Server"
 	HdrETag      = "ETag" // Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/Hdrs/ETag
+	HdrVary      = "Vary"
+
+	// CORS - Ref: https://developer.mozilla.org/en-US/docs/Web/HTTP/CORS
+	HdrOrigin             = "Origin"
+	HdrACRequestMethod    = "Access-Control-Request-Method"
+	HdrACRequestHeaders   = "Access-Control-Request-Headers"
+	HdrACAllowOrigin      = "Access-Control-Allow-Origin"
+	HdrACAllowMethods     = "Access-Control-Allow-Methods"
+	HdrACAllowHeaders     = "Access-Control-Allow-Headers"
+	HdrACAllowCredentials = "Access-Control-Allow-Credentials"
+	HdrACExposeHeaders    = "Access-Control-Expose-Headers"
+	HdrACMaxAge           = "Access-Control-Max-Age"
 )
 
 // provider-specific headers (=> custom props, and more)
@@ -54,10 +66,21 @@ const (
 	S3VersionHeader = "x-amz-version-id"
 
 	// s3 api request headers
-	S3HdrObjSrc        = "x-amz-copy-source"
-	S3HdrMptCnt        = "x-amz-mp-parts-count"
-	S3HdrContentSHA256 = "x-amz-content-sha256"
-	S3HdrBckRegion     = "x-amz-bucket-region"
+	S3HdrObjSrc            = "x-amz-copy-source"
+	S3HdrObjSrcRange       = "x-amz-copy-source-range"
+	S3HdrObjSrcVersion     = "x-amz-copy-source-version-id"
+	S3HdrMetadataDirective = "x-amz-metadata-directive"
+	S3HdrTaggingDirective  = "x-amz-tagging-directive"
+	S3HdrUserMDPrefix      = "x-amz-meta-" // user-defined metadata
+	S3HdrMptCnt            = "x-amz-mp-parts-count"
+	S3HdrContentSHA256     = "x-amz-content-sha256"
+	S3HdrBckRegion         = "x-amz-bucket-region"
+	S3HdrObjLockMode       = "x-amz-object-lock-mode"
+	S3HdrObjLockUntil      = "x-amz-object-lock-retain-until-date"
+	S3HdrBypassGovernance  = "x-amz-bypass-governance-retention"
+	S3HdrACL               = "x-amz-acl" // canned ACL
+	S3HdrTagging           = "x-amz-tagging"
+	S3HdrTaggingCount      = "x-amz-tagging-count"
 
 	S3ChecksumCRC32  = "x-amz-checksum-crc32"
 	S3ChecksumCRC32C = "x-amz-checksum-crc32c"
@@ -0,0 +1,249 @@
+// Package cos provides common low-level types and utilities for all aistore projects
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cos
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// S3 SigV4 query-string authentication (aka presigned URLs)
+// https://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-query-string-auth.html
+
+const (
+	S3QparamAlgorithm     = "X-Amz-Algorithm"
+	S3QparamCredential    = "X-Amz-Credential"
+	S3QparamDate          = "X-Amz-Date"
+	S3QparamExpires       = "X-Amz-Expires"
+	S3QparamSignedHeaders = "X-Amz-SignedHeaders"
+	S3QparamSignature     = "X-Amz-Signature"
+	S3QparamSecurityToken = "X-Amz-Security-Token"
+
+	S3PresignMaxExpires = 7 * 24 * time.Hour // same as S3
+)
+
+const (
+	s3SigAlgorithm = "AWS4-HMAC-SHA256"
+	s3SigTime      = "20060102T150405Z"
+	s3SigDay       = "20060102"
+	s3SigService   = "s3"
+	s3SigTerm      = "aws4_request"
+	s3SigPayload   = "UNSIGNED-PAYLOAD"
+	s3SigHost      = "host"
+
+	s3SigClockSkew = 5 * time.Minute
+)
+
+type S3Presigned struct {
+	Date          time.Time
+	AccessKey     string
+	Region        string
+	Token         string // (optional) session token
+	Signature     string
+	SignedHeaders []string
+	Expires       time.Duration
+}
+
+var (
+	ErrS3PresignExpired  = errors.New("request has expired")
+	ErrS3PresignMismatch = errors.New("the request signature does not match the signature calculated by the server")
+)
+
+func IsS3Presigned(q url.Values) bool { return q.Get(S3QparamSignature) != "" }
+
+// PresignS3 adds SigV4 query-string authentication to a given URL that must include host;
+// only the host header gets signed, payload is not.
+func PresignS3(method string, u *url.URL, region, accessKey, secretKey, token string, expires time.Duration,
+	now time.Time) error {
+	if expires <= 0 || expires > S3PresignMaxExpires {
+		return fmt.Errorf("invalid presigned URL expiration %v (expecting (0, %v])", expires, S3PresignMaxExpires)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("cannot presign %q: missing host", u)
+	}
+	ps := &S3Presigned{
+		Date:          now.UTC(),
+		AccessKey:     accessKey,
+		Region:        region,
+		Token:         token,
+		SignedHeaders: []string{s3SigHost},
+		Expires:       expires,
+	}
+	q := u.Query()
+	q.Set(S3QparamAlgorithm, s3SigAlgorithm)
+	q.Set(S3QparamCredential, accessKey+"/"+ps.scope())
+	q.Set(S3QparamDate, ps.Date.Format(s3SigTime))
+	q.Set(S3QparamExpires, strconv.FormatInt(int64(expires/time.Second), 10))
+	q.Set(S3QparamSignedHeaders, s3SigHost)
+	if token != "" {
+		q.Set(S3QparamSecurityToken, token)
+	}
+	q.Set(S3QparamSignature, ps.sign(method, u.Path, q, http.Header{}, u.Host, secretKey))
+	u.RawQuery = q.Encode()
+	return nil
+}
+
+// ParseS3Presigned parses (but does not verify) SigV4 query-string authentication
+func ParseS3Presigned(q url.Values) (*S3Presigned, error) {
+	if alg := q.Get(S3QparamAlgorithm); alg != s3SigAlgorithm {
+		return nil, fmt.Errorf("unsupported signature algorithm %q (expecting %q)", alg, s3SigAlgorithm)
+	}
+	// <access-key>/<yyyymmdd>/<region>/s3/aws4_request
+	cred := strings.Split(q.Get(S3QparamCredential), "/")
+	if len(cred) != 5 || cred[0] == "" || cred[3] != s3SigService || cred[4] != s3SigTerm {
+		return nil, fmt.Errorf("invalid %s %q", S3QparamCredential, q.Get(S3QparamCredential))
+	}
+	date, err := time.Parse(s3SigTime, q.Get(S3QparamDate))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", S3QparamDate, err)
+	}
+	if date.Format(s3SigDay) != cred[1] {
+		return nil, fmt.Errorf("%s %q does not match credential scope date %q", S3QparamDate, q.Get(S3QparamDate), cred[1])
+	}
+	secs, err := strconv.ParseInt(q.Get(S3QparamExpires), 10, 64)
+	expires := time.Duration(secs) * time.Second
+	if err != nil || expires <= 0 || expires > S3PresignMaxExpires {
+		return nil, fmt.Errorf("invalid %s %q", S3QparamExpires, q.Get(S3QparamExpires))
+	}
+	hdrs := strings.Split(q.Get(S3QparamSignedHeaders), ";")
+	if !StringInSlice(s3SigHost, hdrs) {
+		return nil, fmt.Errorf("%s must include %q", S3QparamSignedHeaders, s3SigHost)
+	}
+	sig := q.Get(S3QparamSignature)
+	if sig == "" {
+		return nil, fmt.Errorf("missing %s", S3QparamSignature)
+	}
+	return &S3Presigned{
+		Date:          date,
+		AccessKey:     cred[0],
+		Region:        cred[2],
+		Token:         q.Get(S3QparamSecurityToken),
+		Signature:     sig,
+		SignedHeaders: hdrs,
+		Expires:       expires,
+	}, nil
+}
+
+func (ps *S3Presigned) CheckExpired(now time.Time) error {
+	if now.Add(s3SigClockSkew).Before(ps.Date) {
+		return fmt.Errorf("request is not yet valid (%s %s)", S3QparamDate, ps.Date.Format(s3SigTime))
+	}
+	if now.After(ps.Date.Add(ps.Expires)) {
+		return ErrS3PresignExpired
+	}
+	return nil
+}
+
+// Verify checks expiration and validates the signature given the secret key
+func (ps *S3Presigned) Verify(r *http.Request, secretKey string, now time.Time) error {
+	if err := ps.CheckExpired(now); err != nil {
+		return err
+	}
+	sig := ps.sign(r.Method, r.URL.Path, r.URL.Query(), r.Header, r.Host, secretKey)
+	if !hmac.Equal([]byte(sig), []byte(ps.Signature)) {
+		return ErrS3PresignMismatch
+	}
+	return nil
+}
+
+func (ps *S3Presigned) scope() string {
+	return ps.Date.Format(s3SigDay) + "/" + ps.Region + "/" + s3SigService + "/" + s3SigTerm
+}
+
+func (ps *S3Presigned) sign(method, path string, q url.Values, hdr http.Header, host, secretKey string) string {
+	var (
+		sb   strings.Builder
+		keys = make([]string, 0, len(q))
+	)
+	// canonical request
+	sb.WriteString(method)
+	sb.WriteByte('\n')
+	sb.WriteString(s3URIEncode(path, false))
+	sb.WriteByte('\n')
+	for k := range q {
+		if k != S3QparamSignature {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	first := true
+	for _, k := range keys {
+		vals := append([]string(nil), q[k]...)
+		sort.Strings(vals)
+		for _, v := range vals {
+			if !first {
+				sb.WriteByte('&')
+			}
+			first = false
+			sb.WriteString(s3URIEncode(k, true))
+			sb.WriteByte('=')
+			sb.WriteString(s3URIEncode(v, true))
+		}
+	}
+	sb.WriteByte('\n')
+	hdrs := append([]string(nil), ps.SignedHeaders...)
+	sort.Strings(hdrs)
+	for _, h := range hdrs {
+		v := host
+		if h != s3SigHost {
+			v = strings.Join(strings.Fields(hdr.Get(h)), " ")
+		}
+		sb.WriteString(h)
+		sb.WriteByte(':')
+		sb.WriteString(v)
+		sb.WriteByte('\n')
+	}
+	sb.WriteByte('\n')
+	sb.WriteString(strings.Join(hdrs, ";"))
+	sb.WriteByte('\n')
+	sb.WriteString(s3SigPayload)
+	creq := sha256.Sum256([]byte(sb.String()))
+
+	// string to sign
+	sts := s3SigAlgorithm + "\n" + ps.Date.Format(s3SigTime) + "\n" + ps.scope() + "\n" + hex.EncodeToString(creq[:])
+
+	// signing key
+	key := s3HMAC([]byte("AWS4"+secretKey), ps.Date.Format(s3SigDay))
+	key = s3HMAC(key, ps.Region)
+	key = s3HMAC(key, s3SigService)
+	key = s3HMAC(key, s3SigTerm)
+	return hex.EncodeToString(s3HMAC(key, sts))
+}
+
+func s3HMAC(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// URI-encode all except unreserved characters (and, optionally, '/')
+func s3URIEncode(s string, encodeSlash bool) string {
+	const hexUpper = "0123456789ABCDEF"
+	var sb strings.Builder
+	sb.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z', c >= '0' && c <= '9', c == '-', c == '_', c == '.', c == '~':
+			sb.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			sb.WriteByte(c)
+		default:
+			sb.WriteByte('%')
+			sb.WriteByte(hexUpper[c>>4])
+			sb.WriteByte(hexUpper[c&15])
+		}
+	}
+	return sb.String()
+}
@@ -119,6 +119,11 @@ type (
 		name   string // object's name
 		d1, d2 uint64 // lom.md.(bucket-ID) and lom.bck.(bucket-ID), respectively
 	}
+	ErrObjLocked struct {
+		until time.Time
+		name  string
+		mode  string
+	}
 	ErrAborted struct {
 		err  error
 		what string
@@ -507,6 +512,21 @@ func isErrObjDefunct(err error) bool {
 	return ok
 }
 
+// ErrObjLocked
+
+func NewErrObjLocked(name, mode string, until time.Time) *ErrObjLocked {
+	return &ErrObjLocked{name: name, mode: mode, until: until}
+}
+
+func (e *ErrObjLocked) Error() string {
+	return fmt.Sprintf("%s is locked (%s mode) until %s", e.name, e.mode, e.until.UTC().Format(time.RFC3339))
+}
+
+func IsErrObjLocked(err error) bool {
+	_, ok := err.(*ErrObjLocked)
+	return ok
+}
+
 // ErrAborted
 
 func NewErrAborted(what, ctx string, err error) *ErrAborted {
@@ -23,6 +23,9 @@ const (
 	// proxy aisnode ID
 	ProxyID = ".ais.proxy_id"
 
+	// (primary) time of the last scheduled storage scrub
+	ScrubLast = ".ais.scrub_last"
+
 	// metadata
 	Smap        = ".ais.smap"   // Smap persistent file basename
 	Rmd         = ".ais.rmd"    // rmd persistent file basename
@@ -47,4 +50,16 @@ const (
 	RebalanceMarker     = "rebalance"
 	NodeRestartedMarker = "node_restarted"
 	NodeRestartedPrev   = "node_restarted.prev"
+
+	// S3 multipart uploads in progress: per mountpath (one file per upload ID)
+	MptDir = ".ais.mpt"
+
+	// write-back journal: per mountpath (one file per object pending upload to its remote backend)
+	WriteBackDir = ".ais.wb"
+
+	// multipart uploads to remote backends in progress: per mountpath (one file per object)
+	BackendMpuDir = ".ais.mpu"
+
+	// replication change log: per mountpath (log and checkpoint files per replicated bucket)
+	ReplDir = ".ais.repl"
 )
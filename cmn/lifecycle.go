@@ -0,0 +1,127 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Bucket lifecycle: a list of expiration rules (compare with S3 PutBucketLifecycleConfiguration).
+// Matching objects are periodically removed by the target's `apc.ActLifecycle` xaction:
+// - ais:// buckets: objects get deleted;
+// - remote buckets: objects get evicted (the remote backend retains its own lifecycle).
+
+const MaxLifecycleRules = 1000 // same as S3
+
+type (
+	LifecycleConf struct {
+		Rules []LifecycleRule `json:"rules,omitempty" list:"readonly"`
+	}
+	LifecycleConfToUpdate struct {
+		Rules *[]LifecycleRule `json:"rules,omitempty"`
+	}
+
+	// An object matches a rule if its name has the rule's prefix and the object
+	// has all the rule's tags (key-value pairs) - see `TagObjMDPrefix`.
+	// A matching object expires after the rule's `Age` (counting from the object's
+	// last modification) or at the rule's `Date`, whichever comes first.
+	LifecycleRule struct {
+		Tags    cos.StrKVs   `json:"tags,omitempty"`
+		ID      string       `json:"id,omitempty"`
+		Prefix  string       `json:"prefix,omitempty"`
+		Age     cos.Duration `json:"age,omitempty"`
+		Date    int64        `json:"date,string,omitempty"` // unix nanoseconds
+		Enabled bool         `json:"enabled"`
+	}
+)
+
+///////////////////
+// LifecycleConf //
+///////////////////
+
+func (c *LifecycleConf) ValidateAsProps(...any) error {
+	if len(c.Rules) > MaxLifecycleRules {
+		return fmt.Errorf("too many lifecycle rules (%d, max %d)", len(c.Rules), MaxLifecycleRules)
+	}
+	ids := make(cos.StrSet, len(c.Rules))
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		if err := rule.validate(); err != nil {
+			return err
+		}
+		if rule.ID == "" {
+			continue
+		}
+		if ids.Contains(rule.ID) {
+			return fmt.Errorf("duplicate lifecycle rule ID %q", rule.ID)
+		}
+		ids.Set(rule.ID)
+	}
+	return nil
+}
+
+func (c *LifecycleConf) IsEnabled() bool {
+	for i := range c.Rules {
+		if c.Rules[i].Enabled {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the first enabled rule that matches a given object and
+// considers it expired; nil otherwise.
+func (c *LifecycleConf) Expired(objName string, md cos.StrKVs, mtime, now time.Time) *LifecycleRule {
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		if rule.Enabled && rule.Match(objName, md) && rule.Expired(mtime, now) {
+			return rule
+		}
+	}
+	return nil
+}
+
+///////////////////
+// LifecycleRule //
+///////////////////
+
+func (r *LifecycleRule) validate() error {
+	if r.Age < 0 || r.Date < 0 {
+		return fmt.Errorf("lifecycle rule %q: expiration age and date must be non-negative", r.ID)
+	}
+	if r.Age == 0 && r.Date == 0 {
+		return fmt.Errorf("lifecycle rule %q: expiration age or date must be specified", r.ID)
+	}
+	for k := range r.Tags {
+		if k == "" {
+			return fmt.Errorf("lifecycle rule %q: empty tag key", r.ID)
+		}
+	}
+	return nil
+}
+
+func (r *LifecycleRule) Match(objName string, md cos.StrKVs) bool {
+	if !strings.HasPrefix(objName, r.Prefix) {
+		return false
+	}
+	for k, v := range r.Tags {
+		if val, ok := md[TagObjMDPrefix+k]; !ok || val != v {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *LifecycleRule) Expired(mtime, now time.Time) bool {
+	if r.Date != 0 && now.UnixNano() >= r.Date {
+		return true
+	}
+	return r.Age != 0 && now.Sub(mtime) >= r.Age.D()
+}
@@ -8,6 +8,7 @@ package cmn
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 
@@ -35,6 +36,16 @@ const (
 
 	OrigURLObjMD = "orig_url"
 
+	// reserved namespace: object tags (key-value pairs, compare with S3 object tagging)
+	// are stored as custom metadata with this prefix prepended to each tag key
+	TagObjMDPrefix = "tag."
+
+	// object lock: retain-until time (unix nanoseconds) - see ObjLockConf
+	RetainUntilObjMD = "retain-until"
+
+	// write-back: the object is yet to be written to its remote backend - see WritePolicyConf
+	WriteBackObjMD = "write-back"
+
 	// additional backend
 	LastModified = "LastModified"
 )
@@ -115,6 +126,43 @@ func (oa *ObjAttrs) DelCustomKeys(keys ...string) {
 	}
 }
 
+//
+// object tags (a subset of custom metadata)
+//
+
+func (oa *ObjAttrs) GetTags() (tags cos.StrKVs) {
+	for k, v := range oa.CustomMD {
+		if strings.HasPrefix(k, TagObjMDPrefix) {
+			if tags == nil {
+				tags = make(cos.StrKVs, 4)
+			}
+			tags[k[len(TagObjMDPrefix):]] = v
+		}
+	}
+	return
+}
+
+// replaces all existing tags (if any) with the specified ones
+func (oa *ObjAttrs) SetTags(tags cos.StrKVs) {
+	for k := range oa.CustomMD {
+		if strings.HasPrefix(k, TagObjMDPrefix) {
+			delete(oa.CustomMD, k)
+		}
+	}
+	for k, v := range tags {
+		oa.SetCustomKey(TagObjMDPrefix+k, v)
+	}
+}
+
+// URL-encoded (as in: `x-amz-tagging` header), sorted by key
+func Tags2S(tags cos.StrKVs) string {
+	q := make(url.Values, len(tags))
+	for k, v := range tags {
+		q.Set(k, v)
+	}
+	return q.Encode()
+}
+
 // clone OAH => ObjAttrs (see also lom.CopyAttrs)
 func (oa *ObjAttrs) CopyFrom(oah cos.OAH, skipCksum ...bool) {
 	oa.Atime = oah.AtimeUnix()
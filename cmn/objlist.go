@@ -29,6 +29,7 @@ type (
 		Version  string `json:"version,omitempty" msg:"v,omitempty"`     // e.g., GCP int64 generation, AWS version (string), etc.
 		Location string `json:"location,omitempty" msg:"t,omitempty"`    // [tnode:mountpath]
 		Custom   string `json:"custom-md,omitempty" msg:"m,omitempty"`   // custom metadata: ETag, MD5, CRC, user-defined ...
+		Tags     string `json:"tags,omitempty" msg:"g,omitempty"`        // object tags, URL-encoded (see cmn.Tags2S)
 		Size     int64  `json:"size,string,omitempty" msg:"s,omitempty"` // size in bytes
 		Copies   int16  `json:"copies,omitempty" msg:"c,omitempty"`      // ## copies (NOTE: for non-replicated object copies == 1)
 		Flags    uint16 `json:"flags,omitempty" msg:"f,omitempty"`
@@ -77,6 +78,9 @@ func (be *LsoEntry) CopyWithProps(propsSet cos.StrSet) (ne *LsoEntry) {
 	if propsSet.Contains(apc.GetPropsCustom) {
 		ne.Custom = be.Custom
 	}
+	if propsSet.Contains(apc.GetPropsTags) {
+		ne.Tags = be.Tags
+	}
 	if propsSet.Contains(apc.GetPropsCopies) {
 		ne.Copies = be.Copies
 	}
@@ -0,0 +1,127 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+// Object lock (aka WORM) - compare with S3 Object Lock.
+// When enabled, objects in a bucket cannot be overwritten, deleted, renamed, or evicted
+// until their respective retain-until times (see `RetainUntilObjMD`):
+// - governance mode: retention can be bypassed by users with `apc.AcePATCH` permission
+//   (the same users can disable object lock altogether);
+// - compliance mode: retention cannot be bypassed by anyone, and the mode itself
+//   cannot be changed or disabled.
+// Objects get their retain-until time upon PUT - either explicitly, via `apc.HdrObjRetainUntil`,
+// or by default as `now + ObjLockConf.Retention`.
+// Finally, the bucket itself cannot be destroyed, evicted, or renamed while object lock is enabled -
+// that is, while it may hold objects under retention (retain-until times are enforced only as long
+// as the bucket's object lock is enabled; compliance mode cannot be disabled).
+
+const (
+	ObjLockGovernance = "governance"
+	ObjLockCompliance = "compliance"
+)
+
+type (
+	ObjLockConf struct {
+		Mode      string       `json:"mode,omitempty"`      // empty (disabled) | governance | compliance
+		Retention cos.Duration `json:"retention,omitempty"` // default retention period
+	}
+	ObjLockConfToUpdate struct {
+		Mode      *string       `json:"mode,omitempty"`
+		Retention *cos.Duration `json:"retention,omitempty"`
+	}
+)
+
+func (c *ObjLockConf) Enabled() bool { return c.Mode != "" }
+
+func (c *ObjLockConf) ValidateAsProps(...any) error {
+	switch c.Mode {
+	case "":
+		if c.Retention != 0 {
+			return fmt.Errorf("object lock: default retention (%v) requires mode (%q or %q)",
+				c.Retention, ObjLockGovernance, ObjLockCompliance)
+		}
+	case ObjLockGovernance, ObjLockCompliance:
+		if c.Retention < 0 {
+			return fmt.Errorf("object lock: invalid default retention %v", c.Retention)
+		}
+	default:
+		return fmt.Errorf("object lock: invalid mode %q (expecting %q or %q)", c.Mode, ObjLockGovernance, ObjLockCompliance)
+	}
+	return nil
+}
+
+// Returns the retain-until time for a new object, given (optional) user-specified value
+func (c *ObjLockConf) RetainUntil(hdr string, now time.Time) (until time.Time, err error) {
+	if hdr == "" {
+		if c.Retention > 0 {
+			until = now.Add(c.Retention.D())
+		}
+		return
+	}
+	if !c.Enabled() {
+		return until, fmt.Errorf("cannot set retain-until %q: object lock is disabled", hdr)
+	}
+	if until, err = time.Parse(time.RFC3339, hdr); err != nil {
+		return until, fmt.Errorf("invalid retain-until %q (expecting RFC 3339 time, e.g. %q)", hdr,
+			now.UTC().Format(time.RFC3339))
+	}
+	if !until.After(now) {
+		return until, fmt.Errorf("invalid retain-until %q: must be in the future", hdr)
+	}
+	return
+}
+
+//
+// per-object retain-until (custom metadata)
+//
+
+func RetainUntil(oah cos.OAH) (until time.Time) {
+	if v, ok := oah.GetCustomKey(RetainUntilObjMD); ok {
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+			until = time.Unix(0, n)
+		}
+	}
+	return
+}
+
+func SetRetainUntil(oa *ObjAttrs, until time.Time) {
+	if until.IsZero() {
+		oa.DelCustomKeys(RetainUntilObjMD)
+		return
+	}
+	oa.SetCustomKey(RetainUntilObjMD, strconv.FormatInt(until.UnixNano(), 10))
+}
+
+// Buckets with object lock enabled (in either mode) cannot be destroyed, evicted, or renamed
+func (c *ObjLockConf) CheckBck(bname, action string) error {
+	if !c.Enabled() {
+		return nil
+	}
+	return fmt.Errorf("cannot %s bucket %s: object lock is enabled (%s mode)", action, bname, c.Mode)
+}
+
+// Returns ErrObjLocked if the object is under retention
+func (c *ObjLockConf) Check(name string, oah cos.OAH, bypass bool, now time.Time) error {
+	if !c.Enabled() {
+		return nil
+	}
+	until := RetainUntil(oah)
+	if until.IsZero() || !now.Before(until) {
+		return nil
+	}
+	if bypass && c.Mode == ObjLockGovernance {
+		return nil
+	}
+	return NewErrObjLocked(name, c.Mode, until)
+}
@@ -0,0 +1,81 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestObjLockValidate(t *testing.T) {
+	tests := []struct {
+		conf ObjLockConf
+		fail bool
+	}{
+		{conf: ObjLockConf{}},
+		{conf: ObjLockConf{Mode: ObjLockGovernance}},
+		{conf: ObjLockConf{Mode: ObjLockCompliance, Retention: cos.Duration(time.Hour)}},
+		{conf: ObjLockConf{Retention: cos.Duration(time.Hour)}, fail: true},
+		{conf: ObjLockConf{Mode: ObjLockGovernance, Retention: -1}, fail: true},
+		{conf: ObjLockConf{Mode: "legal-hold"}, fail: true},
+	}
+	for _, test := range tests {
+		err := test.conf.ValidateAsProps()
+		tassert.Errorf(t, (err != nil) == test.fail, "%+v: unexpected %v", test.conf, err)
+	}
+}
+
+func TestObjLockRetention(t *testing.T) {
+	var (
+		now  = time.Now()
+		oa   = &ObjAttrs{}
+		conf = ObjLockConf{Mode: ObjLockGovernance, Retention: cos.Duration(time.Hour)}
+	)
+	// default retention
+	until, err := conf.RetainUntil("", now)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, until.Equal(now.Add(time.Hour)), "unexpected retain-until %v", until)
+	SetRetainUntil(oa, until)
+	tassert.Fatalf(t, RetainUntil(oa).Equal(until), "unexpected retain-until %v", RetainUntil(oa))
+
+	err = conf.Check("obj", oa, false, now)
+	tassert.Fatalf(t, IsErrObjLocked(err), "expected locked, got %v", err)
+	tassert.CheckError(t, conf.Check("obj", oa, true /*bypass*/, now))
+	tassert.CheckError(t, conf.Check("obj", oa, false, until))
+
+	conf.Mode = ObjLockCompliance
+	tassert.Errorf(t, IsErrObjLocked(conf.Check("obj", oa, true, now)), "compliance mode cannot be bypassed")
+
+	// disabled
+	tassert.CheckError(t, (&ObjLockConf{}).Check("obj", oa, false, now))
+
+	// explicit
+	until, err = conf.RetainUntil(now.Add(48*time.Hour).UTC().Format(time.RFC3339), now)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, until.After(now.Add(47*time.Hour)), "unexpected retain-until %v", until)
+	_, err = conf.RetainUntil(now.Add(-time.Hour).UTC().Format(time.RFC3339), now)
+	tassert.Errorf(t, err != nil, "expected error (retain-until in the past)")
+	_, err = conf.RetainUntil("tomorrow", now)
+	tassert.Errorf(t, err != nil, "expected error (invalid retain-until)")
+	_, err = (&ObjLockConf{}).RetainUntil(now.Add(time.Hour).UTC().Format(time.RFC3339), now)
+	tassert.Errorf(t, err != nil, "expected error (object lock disabled)")
+
+	SetRetainUntil(oa, time.Time{})
+	tassert.Errorf(t, RetainUntil(oa).IsZero(), "expected retain-until to be removed")
+}
+
+func TestObjLockCheckBck(t *testing.T) {
+	for _, mode := range []string{"", ObjLockGovernance, ObjLockCompliance} {
+		conf := ObjLockConf{Mode: mode}
+		for _, action := range []string{"destroy-bck", "evict-remote-bck", "move-bck"} {
+			err := conf.CheckBck("ais://bck", action)
+			tassert.Errorf(t, (err != nil) == conf.Enabled(), "mode %q, action %q: unexpected %v", mode, action, err)
+		}
+	}
+}
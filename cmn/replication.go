@@ -0,0 +1,49 @@
+// Package cmn provides common constants, types, and utilities for AIS clients
+// and AIStore.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package cmn
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/api/apc"
+)
+
+// Continuous replication of a bucket to a bucket in an attached remote AIS cluster,
+// e.g. `ais://@remote/bck` (see `apc.ActReplicate`).
+// Replication ships local PUT and DELETE events and does not copy objects that existed
+// prior to enabling it - to synchronize those, use copy-bucket.
+
+type (
+	ReplicationConf struct {
+		Dst string `json:"dst,omitempty"` // destination bucket, e.g. "ais://@remote/bck"; empty - disabled
+	}
+	ReplicationConfToUpdate struct {
+		Dst *string `json:"dst,omitempty"`
+	}
+)
+
+func (c *ReplicationConf) Enabled() bool { return c.Dst != "" }
+
+func (c *ReplicationConf) ValidateAsProps(...any) error {
+	if !c.Enabled() {
+		return nil
+	}
+	_, err := c.DstBck()
+	return err
+}
+
+func (c *ReplicationConf) DstBck() (bck Bck, err error) {
+	var objName string
+	bck, objName, err = ParseBckObjectURI(c.Dst, ParseURIOpts{})
+	if err != nil {
+		return bck, fmt.Errorf("replication: invalid destination %q: %v", c.Dst, err)
+	}
+	if objName != "" || bck.Name == "" || !bck.IsRemoteAIS() {
+		return bck, fmt.Errorf("replication: invalid destination %q (expecting bucket in a remote AIS cluster, e.g. \"%s@alias/bucket\")",
+			c.Dst, apc.AISScheme+apc.BckProviderSeparator)
+	}
+	return bck, nil
+}
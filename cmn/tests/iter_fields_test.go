@@ -80,6 +80,7 @@ var _ = Describe("IterFields", func() {
 
 					"versioning.enabled":           false,
 					"versioning.validate_warm_get": false,
+					"versioning.retain":            0,
 
 					"checksum.type":              cos.ChecksumXXHash,
 					"checksum.validate_warm_get": false,
@@ -93,6 +94,7 @@ var _ = Describe("IterFields", func() {
 
 					"extra.aws.cloud_region": "us-central",
 					"extra.aws.endpoint":     "",
+					"extra.aws.profile":      "",
 
 					"access":  apc.AccessAttrs(0),
 					"created": int64(0),
@@ -134,6 +136,7 @@ var _ = Describe("IterFields", func() {
 
 					"versioning.enabled":           (*bool)(nil),
 					"versioning.validate_warm_get": (*bool)(nil),
+					"versioning.retain":            (*int)(nil),
 
 					"checksum.type":              api.String(cos.ChecksumXXHash),
 					"checksum.validate_warm_get": (*bool)(nil),
@@ -151,9 +154,20 @@ var _ = Describe("IterFields", func() {
 					"write_policy.md":   api.WritePolicy(apc.WriteDelayed),
 
 					"extra.hdfs.ref_directory": (*string)(nil),
+					"extra.file.ref_directory": (*string)(nil),
 					"extra.aws.cloud_region":   (*string)(nil),
 					"extra.aws.endpoint":       (*string)(nil),
+					"extra.aws.profile":        (*string)(nil),
 					"extra.http.original_url":  (*string)(nil),
+					"extra.http.listing":       (*string)(nil),
+
+					"lifecycle.rules": (*[]cmn.LifecycleRule)(nil),
+					"cors.rules":      (*[]cmn.CORSRule)(nil),
+
+					"object_lock.mode":      (*string)(nil),
+					"object_lock.retention": (*cos.Duration)(nil),
+
+					"replication.dst": (*string)(nil),
 				},
 			),
 			Entry("check for omit tag",
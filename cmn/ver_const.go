@@ -36,6 +36,10 @@ const (
 	MetaverRMD   = 1 // Rebalance MD (jsp)
 	MetaverVMD   = 1 // Volume MD (jsp)
 	MetaverEtlMD = 1 // ETL MD (jsp)
+	MetaverMpt   = 1 // S3 multipart upload state (jsp)
+	MetaverWB    = 1 // write-back journal (jsp)
+	MetaverMPU   = 1 // multipart upload to remote backend (jsp)
+	MetaverRepl  = 1 // replication change log checkpoint (jsp)
 
 	MetaverLOM = 1 // LOM
 
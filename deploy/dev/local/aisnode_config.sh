@@ -63,7 +63,8 @@ cat > $AIS_CONF_FILE <<EOL
 		"cleanupwm":         65,
 		"lowwm":             75,
 		"highwm":            90,
-		"out_of_space":      95
+		"out_of_space":      95,
+		"abandoned_mpt_time": "24h"
 	},
 	"lru": {
 		"dont_evict_time":   "120m",
@@ -81,6 +82,7 @@ cat > $AIS_CONF_FILE <<EOL
 		"dest_retry_time":	"2m",
 		"compression":     	"${AIS_REBALANCE_COMPRESSION:-never}",
 		"bundle_multiplier":	${AIS_REBALANCE_BUNDLE_MULTIPLIER:-2},
+		"bandwidth":		"0",
 		"enabled":         	true
 	},
 	"resilver": {
@@ -111,7 +113,8 @@ cat > $AIS_CONF_FILE <<EOL
 	},
 	"versioning": {
 		"enabled":           true,
-		"validate_warm_get": false
+		"validate_warm_get": false,
+		"retain":            0
 	},
 	"net": {
 		"l4": {
@@ -168,6 +171,14 @@ cat > $AIS_CONF_FILE <<EOL
 		"compression":		"never",
 		"bundle_multiplier":	2
 	},
+	"cold_get": {
+		"min_size":	"0",
+		"chunk_size":	"64mb",
+		"num_workers":	8
+	},
+	"scrub": {
+		"interval":	"0s"
+	},
 	"write_policy": {
 		"data": "${WRITE_POLICY_DATA:-}",
 		"md": "${WRITE_POLICY_MD:-}"
@@ -195,6 +206,10 @@ cat > $AIS_LOCAL_CONF_FILE <<EOL
 		"root":     "${TEST_FSPATH_ROOT:-/tmp/ais$NEXT_TIER/}",
 		"count":    ${TEST_FSPATH_COUNT:-0},
 		"instance": ${INSTANCE:-0}
+	},
+	"topology": {
+		"rack": "${AIS_RACK:-}",
+		"zone": "${AIS_ZONE:-}"
 	}
 }
 EOL
@@ -0,0 +1,207 @@
+// Package ec provides erasure coding (EC) based data protection for AIStore.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ec
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// EC re-encode: migrates erasure coded objects to the bucket's current EC configuration
+// (number of data and parity slices) - runs upon changing the latter (see `Force` in
+// cmn.BucketPropsToUpdate) or, explicitly, via `ais start ec-reencode`.
+// Each target walks its objects and, for each object this target is the main (HRW) one,
+// compares the object's metafile with the bucket props. Objects encoded with a different
+// geometry (or not encoded at all) get re-encoded: new slices (replicas) are distributed
+// first, the main metafile is then atomically replaced (see putJogger.encode), and
+// finally the targets that are no longer part of the new layout are asked to remove
+// their (now obsolete) slices.
+// The objects are re-encoded one at a time per mountpath, with the walk itself paced
+// by disk utilization. Objects that already have the new layout are skipped, which also
+// makes it possible to resume an interrupted (aborted) run by simply starting it again.
+
+type (
+	reencFactory struct {
+		xreg.RenewBase
+		xctn *XactBckReencode
+	}
+	XactBckReencode struct {
+		xact.Base
+		t    cluster.Target
+		bck  *meta.Bck
+		smap *meta.Smap
+		ext  struct {
+			skipped atomic.Int64
+			failed  atomic.Int64
+		}
+	}
+	// extended x-ec-reencode statistics
+	ExtReencodeStats struct {
+		Skipped int64 `json:"skipped,string"` // already have the current layout
+		Failed  int64 `json:"failed,string"`  // failed to re-encode (will be retried upon restart)
+	}
+)
+
+// interface guard
+var (
+	_ cluster.Xact   = (*XactBckReencode)(nil)
+	_ xreg.Renewable = (*reencFactory)(nil)
+)
+
+//////////////////
+// reencFactory //
+//////////////////
+
+func (*reencFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	p := &reencFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+	return p
+}
+
+func (p *reencFactory) Start() error {
+	if p.Bck.Props == nil || !p.Bck.Props.EC.Enabled {
+		return fmt.Errorf("cannot start %s: bucket %s is not erasure coded", p.Kind(), p.Bck)
+	}
+	p.xctn = newXactBckReencode(p.Bck, p.T, p.UUID())
+	return nil
+}
+
+func (*reencFactory) Kind() string        { return apc.ActECReencode }
+func (p *reencFactory) Get() cluster.Xact { return p.xctn }
+
+// the new EC configuration supersedes the one that's being migrated to
+// (objects that have been already re-encoded will be skipped)
+func (*reencFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) { return xreg.WprAbort, nil }
+
+/////////////////////
+// XactBckReencode //
+/////////////////////
+
+func newXactBckReencode(bck *meta.Bck, t cluster.Target, uuid string) (r *XactBckReencode) {
+	r = &XactBckReencode{t: t, bck: bck, smap: t.Sowner().Get()}
+	r.InitBase(uuid, apc.ActECReencode, bck)
+	return
+}
+
+func (r *XactBckReencode) Run(wg *sync.WaitGroup) {
+	wg.Done()
+	bck := r.bck
+	if err := bck.Init(r.t.Bowner()); err != nil {
+		r.Finish(err)
+		return
+	}
+	if !bck.Props.EC.Enabled {
+		r.Finish(fmt.Errorf("bucket %q does not have EC enabled", r.bck.Name))
+		return
+	}
+	glog.Infof("%s: re-encoding to (d=%d, p=%d)", r.Name(), bck.Props.EC.DataSlices, bck.Props.EC.ParitySlices)
+
+	opts := &mpather.JgroupOpts{
+		T:        r.t,
+		CTs:      []string{fs.ObjectType},
+		VisitObj: r.visit,
+		DoLoad:   mpather.Load,
+		Throttle: true,
+	}
+	opts.Bck.Copy(bck.Bucket())
+	jg := mpather.NewJoggerGroup(opts)
+	jg.Run()
+
+	var err error
+	select {
+	case errCause := <-r.ChanAbort():
+		jg.Stop()
+		err = cmn.NewErrAborted(r.Name(), "", errCause)
+	case <-jg.ListenFinished():
+		err = jg.Stop()
+	}
+
+	glog.Infof("%s: re-encoded %d, skipped %d, failed %d", r.Name(),
+		r.Objs(), r.ext.skipped.Load(), r.ext.failed.Load())
+	r.Finish(err)
+}
+
+func (r *XactBckReencode) visit(lom *cluster.LOM, _ []byte) error {
+	if _, local, err := lom.HrwTarget(r.smap); err != nil || !local {
+		return nil // (copies and misplaced objects)
+	}
+	ecConf := &r.bck.Props.EC
+	mdFQN, _, err := cluster.HrwFQN(lom.Bck().Bucket(), fs.ECMetaType, lom.ObjName)
+	if err != nil {
+		glog.Warningf("%s: %s: %v", r, lom, err)
+		return nil
+	}
+	md, err := LoadMetadata(mdFQN)
+	switch {
+	case err == nil:
+		if md.Data == ecConf.DataSlices && md.Parity == ecConf.ParitySlices {
+			r.ext.skipped.Inc()
+			return nil
+		}
+	case os.IsNotExist(err):
+		// not encoded yet (or failed to re-encode during the previous run)
+	default:
+		glog.Warningf("%s: %v", r, err) // damaged metafile: re-encode
+	}
+	return r.reencode(lom)
+}
+
+// re-encode synchronously, one object at a time (per mountpath)
+func (r *XactBckReencode) reencode(lom *cluster.LOM) error {
+	done := make(chan error, 1)
+	cb := func(_ *cluster.LOM, err error) { done <- err }
+	if err := ECM.EncodeObject(lom, cb); err != nil {
+		if err == errSkipped {
+			r.ext.skipped.Inc()
+			return nil
+		}
+		if errors.Is(err, cmn.ErrNotEnoughTargets) {
+			return err // abort the walk
+		}
+		r.ext.failed.Inc()
+		glog.Errorf("%s: failed to re-encode %s: %v", r, lom, err)
+		return nil
+	}
+	select {
+	case err := <-done:
+		switch {
+		case err == nil:
+			r.LomAdd(lom)
+		case err == errSkipped:
+			r.ext.skipped.Inc()
+		default:
+			r.ext.failed.Inc()
+			glog.Errorf("%s: failed to re-encode %s: %v", r, lom, err)
+		}
+	case errCause := <-r.ChanAbort():
+		return cmn.NewErrAborted(r.Name(), "", errCause)
+	}
+	if cmn.FastV(4, cos.SmoduleEC) {
+		glog.Infof("%s: %s", r, lom)
+	}
+	return nil
+}
+
+func (r *XactBckReencode) Snap() (snap *cluster.Snap) {
+	snap = &cluster.Snap{}
+	r.ToSnap(snap)
+
+	snap.Ext = &ExtReencodeStats{Skipped: r.ext.skipped.Load(), Failed: r.ext.failed.Load()}
+	snap.IdleX = r.IsIdle()
+	return
+}
@@ -0,0 +1,389 @@
+// Package ec provides erasure coding (EC) based data protection for AIStore.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package ec
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/transport"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// EC scrub: proactively verifies erasure coded objects, rather than waiting for a GET
+// to stumble upon a lost or corrupted slice.
+// Each target walks its metafiles and, for each object this target is the main (HRW) one:
+// - validates the checksum of the main replica;
+// - requests all the other targets listed in the metafile to verify their respective
+//   slices or replicas (see reqScrub), and waits for their responses;
+// - checks each response for consistency: generation, object checksum, and slice ID
+//   must match the local metafile.
+// Missing, corrupted, or inconsistent slices (replicas) are rebuilt by re-encoding the
+// object. A missing or corrupted main replica gets restored from the remaining slices
+// first; when that fails the object is counted as unrecoverable.
+// NOTE: objects that have lost both the main replica and its metafile are not visited
+// (and, as before, get restored upon GET).
+
+const scrubParallel = 4 // num objects verified concurrently, per mountpath
+
+type (
+	scrubFactory struct {
+		xreg.RenewBase
+		xctn *XactBckScrub
+	}
+	XactBckScrub struct {
+		xact.Base
+		t       cluster.Target
+		bck     *meta.Bck
+		pending map[string]*scrubObj // by object uname
+		wg      sync.WaitGroup       // to wait for re-encoding to finish
+		started int64
+		ext     struct {
+			healthy       atomic.Int64
+			repaired      atomic.Int64
+			unrecoverable atomic.Int64
+		}
+		mu sync.Mutex
+	}
+	// extended x-ec-scrub statistics
+	ExtScrubStats struct {
+		Healthy       int64 `json:"healthy,string"`       // all slices (replicas) present and valid
+		Repaired      int64 `json:"repaired,string"`      // restored and/or re-encoded
+		Unrecoverable int64 `json:"unrecoverable,string"` // cannot be restored
+	}
+
+	// responses from the targets that store slices (replicas) of a given object
+	scrubObj struct {
+		twg  *cos.TimeoutGroup
+		wait cos.StrSet           // yet to respond
+		resp map[string]*Metadata // by target ID; nil when missing or corrupted
+		mu   sync.Mutex
+	}
+)
+
+// interface guard
+var (
+	_ cluster.Xact   = (*XactBckScrub)(nil)
+	_ xreg.Renewable = (*scrubFactory)(nil)
+)
+
+//////////////////
+// scrubFactory //
+//////////////////
+
+func (*scrubFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	p := &scrubFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+	return p
+}
+
+func (p *scrubFactory) Start() error {
+	if p.Bck.Props == nil || !p.Bck.Props.EC.Enabled {
+		return fmt.Errorf("cannot start %s: bucket %s is not erasure coded", p.Kind(), p.Bck)
+	}
+	p.xctn = newXactBckScrub(p.Bck, p.T, p.UUID())
+	return nil
+}
+
+func (*scrubFactory) Kind() string        { return apc.ActECScrub }
+func (p *scrubFactory) Get() cluster.Xact { return p.xctn }
+
+func (*scrubFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) { return xreg.WprUse, nil }
+
+//////////////////
+// XactBckScrub //
+//////////////////
+
+func newXactBckScrub(bck *meta.Bck, t cluster.Target, uuid string) (r *XactBckScrub) {
+	r = &XactBckScrub{t: t, bck: bck, pending: make(map[string]*scrubObj, 16)}
+	r.InitBase(uuid, apc.ActECScrub, bck)
+	return
+}
+
+func (r *XactBckScrub) Run(*sync.WaitGroup) {
+	bck := r.bck
+	r.started = time.Now().UnixNano()
+	xacts := ECM.getBckXacts(bck.Name)
+	xacts.SetScrub(r)
+
+	opts := &mpather.JgroupOpts{
+		T:                     r.t,
+		CTs:                   []string{fs.ECMetaType},
+		VisitCT:               r.visit,
+		Parallel:              scrubParallel,
+		SkipGloballyMisplaced: true, // main target only
+	}
+	opts.Bck.Copy(bck.Bucket())
+	jg := mpather.NewJoggerGroup(opts)
+	jg.Run()
+
+	var err error
+	select {
+	case errCause := <-r.ChanAbort():
+		jg.Stop()
+		err = cmn.NewErrAborted(r.Name(), "", errCause)
+	case <-jg.ListenFinished():
+		err = jg.Stop()
+	}
+	r.wg.Wait()
+	xacts.SetScrub(nil)
+
+	glog.Infof("%s: healthy %d, repaired %d, unrecoverable %d", r.Name(),
+		r.ext.healthy.Load(), r.ext.repaired.Load(), r.ext.unrecoverable.Load())
+	r.Finish(err)
+}
+
+func (r *XactBckScrub) visit(ct *cluster.CT, _ []byte) error {
+	lom := cluster.AllocLOM(ct.ObjectName())
+	if err := lom.InitBck(ct.Bucket()); err == nil {
+		r.scrub(lom, ct)
+	}
+	cluster.FreeLOM(lom)
+	return nil
+}
+
+func (r *XactBckScrub) scrub(lom *cluster.LOM, ctMeta *cluster.CT) {
+	// skip objects that are being (re)written and EC-encoded as we speak
+	if finfo, err := os.Stat(ctMeta.FQN()); err != nil || finfo.ModTime().UnixNano() > r.started {
+		return
+	}
+	md, err := LoadMetadata(ctMeta.FQN())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return
+		}
+		glog.Warningf("%s: %v", r, err) // damaged metafile: the object, if present, will be re-encoded
+	} else if md.SliceID != 0 {
+		return // the slice is misplaced (the job for rebalance)
+	}
+
+	localOK, stale, skip := r.checkLocal(lom, md)
+	if skip {
+		return
+	}
+	bad := 1 // unknown when there's no metadata
+	if md != nil {
+		bad = r.checkPeers(lom, md)
+	}
+	if stale {
+		bad++
+	}
+
+	switch {
+	case localOK && bad == 0:
+		r.ext.healthy.Inc()
+		r.LomAdd(lom)
+	case localOK:
+		r.reencode(lom)
+	default:
+		if !r.rmCorrupted(lom) {
+			return
+		}
+		// NOTE: restoring also sends slices (replicas) to those targets that have none
+		if err := ECM.RestoreObject(lom); err != nil {
+			glog.Errorf("%s: failed to restore %s: %v", r, lom, err)
+			r.ext.unrecoverable.Inc()
+			return
+		}
+		if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+			glog.Errorf("%s: failed to load restored %s: %v", r, lom, err)
+			r.ext.unrecoverable.Inc()
+			return
+		}
+		glog.Warningf("%s: restored %s", r, lom)
+		if bad == 0 {
+			r.ext.repaired.Inc()
+			r.LomAdd(lom)
+			return
+		}
+		r.reencode(lom)
+	}
+}
+
+// validate the main replica; `stale` when the replica was overwritten while the metafile was not
+func (r *XactBckScrub) checkLocal(lom *cluster.LOM, md *Metadata) (localOK, stale, skip bool) {
+	lom.Lock(false)
+	defer lom.Unlock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if !cmn.IsObjNotExist(err) {
+			glog.Errorf("%s: %v", r, err)
+		}
+		return
+	}
+	if finfo, err := os.Stat(lom.FQN); err != nil || finfo.ModTime().UnixNano() > r.started {
+		skip = true
+		return
+	}
+	if err := lom.ValidateContentChecksum(); err != nil {
+		glog.Errorf("%s: %v", r, err)
+		return
+	}
+	localOK = true
+	if md != nil && md.ObjCksum != "" && lom.Checksum() != nil && lom.Checksum().Val() != "" {
+		stale = lom.Checksum().Val() != md.ObjCksum
+	}
+	return
+}
+
+// remove the main replica that failed validation (see checkLocal) - unless it has been
+// removed or overwritten in the meantime; returns true to proceed to restore the object
+func (r *XactBckScrub) rmCorrupted(lom *cluster.LOM) bool {
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if cmn.IsObjNotExist(err) {
+			return true // (nothing to remove)
+		}
+	} else {
+		finfo, err := os.Stat(lom.FQN)
+		if err != nil || finfo.ModTime().UnixNano() > r.started {
+			return false
+		}
+		if lom.ValidateContentChecksum() == nil {
+			return false
+		}
+	}
+	if err := cos.RemoveFile(lom.FQN); err != nil {
+		glog.Errorf("%s: failed to remove corrupted %s: %v", r, lom, err)
+		r.ext.unrecoverable.Inc()
+		return false
+	}
+	return true
+}
+
+// request all the other targets listed in the metafile to verify their slices (replicas);
+// returns the number of slices (replicas) that are missing, corrupted, or inconsistent
+func (r *XactBckScrub) checkPeers(lom *cluster.LOM, md *Metadata) (bad int) {
+	var (
+		smap     = r.t.Sowner().Get()
+		nodes    = make([]*meta.Snode, 0, len(md.Daemons))
+		uname    = lom.Uname()
+		expected = md.Parity
+		healthy  int
+	)
+	if !md.IsCopy {
+		expected += md.Data
+	}
+	for tid := range md.Daemons {
+		if tid == r.t.SID() {
+			continue
+		}
+		if tsi := smap.GetTarget(tid); tsi != nil && !tsi.InMaintOrDecomm() {
+			nodes = append(nodes, tsi)
+		}
+	}
+	if len(nodes) == 0 {
+		return expected
+	}
+
+	so := &scrubObj{twg: cos.NewTimeoutGroup(), wait: make(cos.StrSet, len(nodes)), resp: make(map[string]*Metadata, len(nodes))}
+	for _, tsi := range nodes {
+		so.wait.Add(tsi.ID())
+	}
+	so.twg.Add(len(nodes))
+	r.mu.Lock()
+	r.pending[uname] = so
+	r.mu.Unlock()
+
+	o := transport.AllocSend()
+	o.Hdr = transport.ObjHdr{ObjName: lom.ObjName, Opcode: reqScrub}
+	o.Hdr.Bck.Copy(lom.Bucket())
+	o.Hdr.Opaque = newIntraReq(reqScrub, nil, lom.Bck()).NewPack(r.t.ByteMM())
+	o.Callback = r.sendCallback
+	err := ECM.req().Send(o, nil, nodes...)
+	if err == nil {
+		if so.twg.WaitTimeout(cmn.GCO.Get().Timeout.SendFile.D()) {
+			glog.Warningf("%s: timed out waiting for %s responses", r, lom)
+		}
+	} else {
+		glog.Errorf("%s: failed to send %s request: %v", r, lom, err)
+	}
+	r.mu.Lock()
+	delete(r.pending, uname)
+	r.mu.Unlock()
+
+	so.mu.Lock()
+	for tid, sliceID := range md.Daemons {
+		if pmd := so.resp[tid]; pmd != nil && pmd.Generation == md.Generation && pmd.ObjCksum == md.ObjCksum &&
+			pmd.SliceID == int(sliceID) {
+			healthy++
+		}
+	}
+	so.mu.Unlock()
+	if bad = expected - healthy; bad < 0 {
+		bad = 0
+	}
+	return
+}
+
+func (r *XactBckScrub) sendCallback(hdr transport.ObjHdr, _ io.ReadCloser, _ any, err error) {
+	r.t.ByteMM().Free(hdr.Opaque)
+	if err != nil {
+		glog.Errorf("%s: failed to send %s request: %v", r, hdr.Cname(), err)
+	}
+}
+
+// response to reqScrub (see XactRespond.scrubCT)
+func (r *XactBckScrub) dispatchResp(iReq intraReq, hdr *transport.ObjHdr, bck *meta.Bck) {
+	r.mu.Lock()
+	so, ok := r.pending[bck.MakeUname(hdr.ObjName)]
+	r.mu.Unlock()
+	if !ok {
+		return // timed out
+	}
+	so.mu.Lock()
+	if so.wait.Contains(hdr.SID) {
+		so.wait.Delete(hdr.SID)
+		if iReq.exists {
+			so.resp[hdr.SID] = iReq.meta
+		}
+		so.twg.Done()
+	}
+	so.mu.Unlock()
+}
+
+func (r *XactBckScrub) reencode(lom *cluster.LOM) {
+	r.wg.Add(1)
+	if err := ECM.EncodeObject(lom, r.afterReencode); err != nil {
+		r.afterReencode(lom, err)
+	}
+}
+
+func (r *XactBckScrub) afterReencode(lom *cluster.LOM, err error) {
+	switch {
+	case err == nil:
+		r.ext.repaired.Inc()
+		r.LomAdd(lom)
+	case err != errSkipped:
+		glog.Errorf("%s: failed to re-encode %s: %v", r, lom, err)
+		r.ext.unrecoverable.Inc()
+	}
+	r.wg.Done()
+}
+
+func (r *XactBckScrub) Snap() (snap *cluster.Snap) {
+	snap = &cluster.Snap{}
+	r.ToSnap(snap)
+
+	snap.Ext = &ExtScrubStats{
+		Healthy:       r.ext.healthy.Load(),
+		Repaired:      r.ext.repaired.Load(),
+		Unrecoverable: r.ext.unrecoverable.Load(),
+	}
+	snap.IdleX = r.IsIdle()
+	return
+}
@@ -281,6 +281,8 @@ func Init(t cluster.Target) {
 	xreg.RegBckXact(&putFactory{})
 	xreg.RegBckXact(&rspFactory{})
 	xreg.RegBckXact(&encFactory{})
+	xreg.RegBckXact(&reencFactory{})
+	xreg.RegBckXact(&scrubFactory{})
 
 	if err := initManager(t); err != nil {
 		cos.ExitLogf("Failed to init manager: %v", err)
@@ -23,6 +23,12 @@ const (
 	// a target cleans up the object and notifies all other targets to do
 	// cleanup as well. Destinations do not have to respond
 	reqDel
+	// a target that has the main replica asks another target to verify its
+	// slice/replica (see XactBckScrub); the destination responds with its own
+	// metadata and sets exists=false if the slice/replica is missing or corrupted
+	reqScrub
+	// response to reqScrub
+	respScrub
 )
 
 type (
@@ -265,6 +265,10 @@ func (mgr *Manager) recvResponse(hdr transport.ObjHdr, object io.Reader, err err
 		// Process the request even if the number of targets is insufficient
 		// (might've started when we had enough)
 		mgr.RestoreBckGetXact(bck).DispatchResp(iReq, &hdr, bck, object)
+	case respScrub:
+		if xctn := mgr.getBckXacts(bck.Name).Scrub(); xctn != nil {
+			xctn.dispatchResp(iReq, &hdr, bck)
+		}
 	default:
 		debug.Assertf(false, "unknown EC response action %d", hdr.Opcode)
 	}
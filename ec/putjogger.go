@@ -231,6 +231,9 @@ func (c *putJogger) encode(req *request, lom *cluster.LOM) error {
 	}
 
 	ctMeta := cluster.NewCTFromLOM(lom, fs.ECMetaType)
+	// when re-encoding (see XactBckReencode), the previous layout may include
+	// targets that won't be part of the new one
+	prev, _ := LoadMetadata(ctMeta.FQN())
 	generation := mono.NanoTime()
 	meta := &Metadata{
 		MDVersion:   MDVersionLast,
@@ -275,8 +278,9 @@ func (c *putJogger) encode(req *request, lom *cluster.LOM) error {
 	if err != nil {
 		return err
 	}
+	// write-and-rename to replace the previous metafile, if any, atomically
 	metaBuf := bytes.NewReader(meta.NewPack())
-	if err := ctMeta.Write(c.parent.t, metaBuf, -1); err != nil {
+	if err := ctMeta.Write(c.parent.t, metaBuf, -1, ctMeta.Make(fs.WorkfileType)); err != nil {
 		return err
 	}
 	if _, exists := c.parent.t.Bowner().Get().Get(ctMeta.Bck()); !exists {
@@ -285,9 +289,37 @@ func (c *putJogger) encode(req *request, lom *cluster.LOM) error {
 		}
 		return fmt.Errorf("%s metafile saved while bucket %s was being destroyed", ctMeta.ObjectName(), ctMeta.Bucket())
 	}
+	if prev != nil {
+		c.cleanupObsolete(lom, prev, meta)
+	}
 	return nil
 }
 
+// remove slices and replicas from the targets that are no longer part of the layout
+// (e.g., upon reducing the number of parity slices)
+func (c *putJogger) cleanupObsolete(lom *cluster.LOM, prev, md *Metadata) {
+	nodes := prev.RemoteTargets(c.parent.t)
+	for i := 0; i < len(nodes); {
+		if _, ok := md.Daemons[nodes[i].ID()]; ok {
+			nodes = append(nodes[:i], nodes[i+1:]...)
+		} else {
+			i++
+		}
+	}
+	if len(nodes) == 0 {
+		return
+	}
+	request := newIntraReq(reqDel, nil, lom.Bck()).NewPack(c.parent.t.ByteMM())
+	o := transport.AllocSend()
+	o.Hdr = transport.ObjHdr{ObjName: lom.ObjName, Opaque: request, Opcode: reqDel}
+	o.Hdr.Bck.Copy(lom.Bucket())
+	o.Callback = c.ctSendCallback
+	c.parent.IncPending()
+	if err := c.parent.mgr.req().Send(o, nil, nodes...); err != nil {
+		glog.Errorf("%s: failed to cleanup obsolete slices: %v", lom, err)
+	}
+}
+
 func (c *putJogger) ctSendCallback(hdr transport.ObjHdr, _ io.ReadCloser, _ any, err error) {
 	c.parent.t.ByteMM().Free(hdr.Opaque)
 	if err != nil {
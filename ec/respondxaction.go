@@ -33,9 +33,13 @@ type (
 	// Should not be stopped if number of known targets is small.
 	XactRespond struct {
 		xactECBase
+		scrubSema chan struct{} // limits the number of concurrently verified slices (replicas)
 	}
 )
 
+// max number of slices (replicas) concurrently verified on behalf of other targets (see scrubCT)
+const scrubRespParallel = 4
+
 // interface guard
 var (
 	_ xact.Demand    = (*XactRespond)(nil)
@@ -76,7 +80,10 @@ func NewRespondXact(t cluster.Target, bck *cmn.Bck, mgr *Manager) *XactRespond {
 		config   = cmn.GCO.Get()
 		smap, si = t.Sowner(), t.Snode()
 	)
-	return &XactRespond{xactECBase: newXactECBase(t, smap, si, config, bck, mgr)}
+	return &XactRespond{
+		xactECBase: newXactECBase(t, smap, si, config, bck, mgr),
+		scrubSema:  make(chan struct{}, scrubRespParallel),
+	}
 }
 
 func (r *XactRespond) Run(*sync.WaitGroup) {
@@ -161,6 +168,80 @@ func (r *XactRespond) trySendCT(iReq intraReq, hdr *transport.ObjHdr, bck *meta.
 	return r.dataResponse(respPut, hdr, fqn, bck, objName, md)
 }
 
+// Verifies local slice or replica on behalf of the target that has the main
+// replica (see XactBckScrub) and responds with the local metadata, if any.
+// Runs asynchronously (see DispatchReq) - reads the entire slice (replica)
+// and therefore must not be blocking the receive path.
+func (r *XactRespond) scrubCT(objName, tid string, bck *meta.Bck) {
+	r.scrubSema <- struct{}{}
+	err := r._scrubCT(objName, tid, bck)
+	<-r.scrubSema
+	if err != nil {
+		glog.Error(err)
+	}
+	r.DecPending()
+}
+
+func (r *XactRespond) _scrubCT(objName, tid string, bck *meta.Bck) error {
+	var md *Metadata
+	ct, err := cluster.NewCTFromBO(bck.Bucket(), objName, r.t.Bowner(), fs.ECSliceType)
+	if err != nil {
+		return err
+	}
+	md, err = LoadMetadata(ct.Make(fs.ECMetaType))
+	if err == nil {
+		err = verifyCT(ct, md)
+	}
+	if err != nil && !os.IsNotExist(err) {
+		glog.Warningf("%s: %s: %v", r.t, bck.Cname(objName), err)
+	}
+
+	ireq := newIntraReq(respScrub, md, bck)
+	ireq.exists = err == nil
+	rHdr := transport.ObjHdr{ObjName: objName, Opcode: respScrub}
+	rHdr.Bck.Copy(bck.Bucket())
+	rHdr.Opaque = ireq.NewPack(r.t.ByteMM())
+
+	r.IncPending()
+	cb := func(hdr transport.ObjHdr, _ io.ReadCloser, _ any, err error) {
+		r.t.ByteMM().Free(hdr.Opaque)
+		if err != nil {
+			glog.Errorf("Failed to respond %s: %v", hdr.Cname(), err)
+		}
+		r.DecPending()
+	}
+	return r.sendByDaemonID([]string{tid}, rHdr, nil, cb, false)
+}
+
+// validates the checksum of a full replica (SliceID == 0) or a slice
+func verifyCT(ct *cluster.CT, md *Metadata) error {
+	if md.SliceID == 0 {
+		lom := cluster.AllocLOM(ct.ObjectName())
+		defer cluster.FreeLOM(lom)
+		if err := lom.InitBck(ct.Bucket()); err != nil {
+			return err
+		}
+		lom.Lock(false)
+		defer lom.Unlock(false)
+		if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+			return err
+		}
+		return lom.ValidateContentChecksum()
+	}
+	if md.CksumType == "" || md.CksumType == cos.ChecksumNone || md.CksumValue == "" {
+		return cos.Stat(ct.FQN())
+	}
+	ct.Lock(false)
+	defer ct.Unlock(false)
+	fh, err := os.Open(ct.FQN())
+	if err != nil {
+		return err
+	}
+	err = cksumSlice(fh, cos.NewCksum(md.CksumType, md.CksumValue), ct.ObjectName())
+	cos.Close(fh)
+	return err
+}
+
 // DispatchReq is responsible for handling request from other targets
 func (r *XactRespond) DispatchReq(iReq intraReq, hdr *transport.ObjHdr, bck *meta.Bck) {
 	switch hdr.Opcode {
@@ -174,6 +255,9 @@ func (r *XactRespond) DispatchReq(iReq intraReq, hdr *transport.ObjHdr, bck *met
 		if err != nil {
 			glog.Error(err)
 		}
+	case reqScrub:
+		r.IncPending()
+		go r.scrubCT(hdr.ObjName, hdr.SID, bck)
 	default:
 		// invalid request detected
 		glog.Errorf("Invalid request type %d", hdr.Opcode)
@@ -61,9 +61,10 @@ type (
 	}
 
 	BckXacts struct {
-		get atomic.Pointer // *XactGet
-		put atomic.Pointer // *XactPut
-		req atomic.Pointer // *XactRespond
+		get   atomic.Pointer // *XactGet
+		put   atomic.Pointer // *XactPut
+		req   atomic.Pointer // *XactRespond
+		scrub atomic.Pointer // *XactBckScrub (when running)
 	}
 )
 
@@ -424,6 +425,10 @@ func (xacts *BckXacts) Req() *XactRespond {
 	return (*XactRespond)(xacts.req.Load())
 }
 
+func (xacts *BckXacts) Scrub() *XactBckScrub {
+	return (*XactBckScrub)(xacts.scrub.Load())
+}
+
 func (xacts *BckXacts) SetGet(xctn *XactGet) {
 	xacts.get.Store(unsafe.Pointer(xctn))
 }
@@ -436,6 +441,10 @@ func (xacts *BckXacts) SetReq(xctn *XactRespond) {
 	xacts.req.Store(unsafe.Pointer(xctn))
 }
 
+func (xacts *BckXacts) SetScrub(xctn *XactBckScrub) {
+	xacts.scrub.Store(unsafe.Pointer(xctn))
+}
+
 func (xacts *BckXacts) AbortGet() { // TODO: caller must provide the error (reason) - here and elsewhere
 	xctn := (*XactGet)(xacts.get.Load())
 	if xctn != nil {
@@ -5,17 +5,20 @@
 package dload
 
 import (
+	"encoding/json"
 	"errors"
 	"path"
 	"sync"
 
 	"github.com/NVIDIA/aistore/3rdparty/glog"
 	"github.com/NVIDIA/aistore/cmn/kvdb"
+	jsoniter "github.com/json-iterator/go"
 )
 
 const (
 	downloaderErrors     = "errors"
 	downloaderTasks      = "tasks"
+	downloaderJobs       = "jobs"
 	downloaderCollection = "downloads"
 
 	// Number of errors stored in memory. When the number of errors exceeds
@@ -29,13 +32,23 @@ const (
 
 var errJobNotFound = errors.New("job not found")
 
-type downloaderDB struct {
-	mtx    sync.RWMutex
-	driver kvdb.Driver
+type (
+	downloaderDB struct {
+		mtx    sync.RWMutex
+		driver kvdb.Driver
 
-	errCache      map[string][]TaskErrInfo // memory cache for errors, see: errCacheSize
-	taskInfoCache map[string][]TaskDlInfo  // memory cache for tasks, see: taskInfoCacheSize
-}
+		errCache      map[string][]TaskErrInfo // memory cache for errors, see: errCacheSize
+		taskInfoCache map[string][]TaskDlInfo  // memory cache for tasks, see: taskInfoCacheSize
+	}
+
+	// persisted job: definition (to resume the job after restart) and state
+	// (the latter is final once the job finishes or gets aborted)
+	jobRec struct {
+		Job
+		Type Type            `json:"type"`
+		Body json.RawMessage `json:"body"`
+	}
+)
 
 func newDownloadDB(driver kvdb.Driver) *downloaderDB {
 	return &downloaderDB{
@@ -174,11 +187,54 @@ func (db *downloaderDB) flush(id string) error {
 	return nil
 }
 
+func (db *downloaderDB) setErrors(id string, errs []TaskErrInfo) error {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+	key := path.Join(downloaderErrors, id)
+	if err := db.driver.Set(downloaderCollection, key, errs); err != nil {
+		glog.Error(err)
+		return err
+	}
+	db.errCache[id] = db.errCache[id][:0]
+	return nil
+}
+
+func (db *downloaderDB) persistJob(rec *jobRec) error {
+	key := path.Join(downloaderJobs, rec.ID)
+	if err := db.driver.Set(downloaderCollection, key, rec); err != nil {
+		glog.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (db *downloaderDB) loadJobs() (recs []*jobRec, err error) {
+	all, err := db.driver.GetAll(downloaderCollection, downloaderJobs)
+	if err != nil {
+		if kvdb.IsErrNotFound(err) {
+			err = nil
+		}
+		return nil, err
+	}
+	recs = make([]*jobRec, 0, len(all))
+	for key, val := range all {
+		rec := &jobRec{}
+		if err := jsoniter.UnmarshalFromString(val, rec); err != nil {
+			glog.Errorf("failed to load download job %q: %v", key, err)
+			continue
+		}
+		recs = append(recs, rec)
+	}
+	return recs, nil
+}
+
 func (db *downloaderDB) delete(id string) {
 	db.mtx.Lock()
 	key := path.Join(downloaderErrors, id)
 	db.driver.Delete(downloaderCollection, key)
 	key = path.Join(downloaderTasks, id)
 	db.driver.Delete(downloaderCollection, key)
+	key = path.Join(downloaderJobs, id)
+	db.driver.Delete(downloaderCollection, key)
 	db.mtx.Unlock()
 }
@@ -6,6 +6,7 @@ package dload
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"sort"
@@ -81,6 +82,7 @@ mloop:
 			break mloop
 		case errCause := <-d.xdl.ChanAbort():
 			glog.Infof("%s aborted (cause %v). Exiting...", d.xdl.Name(), errCause)
+			d.interrupt(errCause)
 			break mloop
 		case <-ctx.Done():
 			break mloop
@@ -98,6 +100,7 @@ mloop:
 				break mloop
 			case errCause := <-d.xdl.ChanAbort():
 				glog.Infof("%s has been aborted (cause %v). Exiting...", d.xdl.Name(), errCause)
+				d.interrupt(errCause)
 				break mloop
 			case <-ctx.Done():
 				break mloop
@@ -117,6 +120,13 @@ mloop:
 	return group.Wait()
 }
 
+// unless stopped by user, running jobs will be resumed upon restart
+func (d *dispatcher) interrupt(errCause error) {
+	if !errors.Is(errCause, cmn.ErrXactUserAbort) {
+		dlStore.setInterrupted(d.xdl.ID())
+	}
+}
+
 // stop running joggers
 // no need to cleanup maps, dispatcher should not be used after stop()
 func (d *dispatcher) stop() {
@@ -170,7 +180,10 @@ func (d *dispatcher) dispatchDownload(job jobif) (ok bool) {
 		return !aborted
 	}
 
-	diffResolver := NewDiffResolver(nil)
+	var (
+		diffResolver = NewDiffResolver(nil)
+		resumed      = dlStore.resumed(job.ID())
+	)
 
 	diffResolver.Start()
 
@@ -282,6 +295,9 @@ func (d *dispatcher) dispatchDownload(job jobif) (ok bool) {
 					fromRemote: true,
 				}
 			}
+			if resumed.Contains(obj.objName) { // already processed prior to restart
+				continue
+			}
 
 			dlStore.incScheduled(job.ID())
 
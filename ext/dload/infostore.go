@@ -8,11 +8,16 @@ import (
 	"sync"
 	"time"
 
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/cmn/cos"
 	"github.com/NVIDIA/aistore/cmn/debug"
 	"github.com/NVIDIA/aistore/cmn/kvdb"
 	"github.com/NVIDIA/aistore/hk"
 )
 
+// how often to persist the progress of running jobs
+const persistInterval = 30 * time.Second
+
 var (
 	// global downloader info store
 	db          kvdb.Driver
@@ -20,14 +25,27 @@ var (
 	dlStoreOnce sync.Once
 )
 
-// TODO: stored only in memory, should be persisted at some point (powercycle)
-type infoStore struct {
-	*downloaderDB
-	dljobs map[string]*dljob
-	sync.RWMutex
-}
+type (
+	// Jobs are kept in memory and persisted (see db.go) so that unfinished jobs
+	// survive restarts - see `Unfinished`.
+	infoStore struct {
+		*downloaderDB
+		dljobs map[string]*dljob
+		sync.RWMutex
+	}
+	// unfinished job to resume (see `Unfinished`)
+	Interrupted struct {
+		XactID string // (persisted) ID of the downloader xaction that was running the job
+		Body   Body
+	}
+)
 
-func SetDB(dbdrv kvdb.Driver) { db = dbdrv }
+// Init sets the database and loads persisted jobs; must be called at startup
+// prior to `Unfinished`.
+func Init(dbdrv kvdb.Driver) {
+	db = dbdrv
+	initInfoStore(db)
+}
 
 func initInfoStore(db kvdb.Driver) {
 	dlStoreOnce.Do(func() {
@@ -41,10 +59,111 @@ func newInfoStore(driver kvdb.Driver) *infoStore {
 		downloaderDB: db,
 		dljobs:       make(map[string]*dljob),
 	}
+	if driver != nil {
+		is.load()
+	}
 	hk.Reg("downloader"+hk.NameSuffix, is.housekeep, hk.DayInterval)
+	hk.Reg("downloader-persist"+hk.NameSuffix, is.persistRunning, persistInterval)
 	return is
 }
 
+// load persisted jobs; counters of unfinished jobs are restored from the
+// (persisted) finished tasks and errors
+func (is *infoStore) load() {
+	recs, err := is.loadJobs()
+	if err != nil {
+		glog.Errorf("failed to load download jobs: %v", err)
+		return
+	}
+	for _, rec := range recs {
+		dljob := &dljob{
+			id:          rec.ID,
+			xid:         rec.XactID,
+			description: rec.Description,
+			startedTime: rec.StartedTime,
+			total:       rec.Total,
+			body:        Body{Type: rec.Type, RawMessage: rec.Body},
+		}
+		switch {
+		case rec.Aborted:
+			dljob.aborted.Store(true)
+			if _isRunning(rec.FinishedTime) {
+				rec.FinishedTime = time.Now()
+			}
+			fallthrough
+		case !_isRunning(rec.FinishedTime):
+			dljob.finishedTime.Store(rec.FinishedTime)
+			dljob.finishedCnt.Store(int32(rec.FinishedCnt))
+			dljob.scheduledCnt.Store(int32(rec.ScheduledCnt))
+			dljob.skippedCnt.Store(int32(rec.SkippedCnt))
+			dljob.errorCnt.Store(int32(rec.ErrorCnt))
+			dljob.allDispatched.Store(rec.AllDispatched)
+		default:
+			if err := is.restore(dljob); err != nil {
+				glog.Errorf("failed to restore download job %q: %v", rec.ID, err)
+				continue
+			}
+			glog.Infof("download job %q: %d objects processed prior to restart", rec.ID, len(dljob.done))
+		}
+		is.dljobs[rec.ID] = dljob
+	}
+}
+
+func (is *infoStore) restore(dljob *dljob) error {
+	tasks, err := is.tasks(dljob.id)
+	if err != nil {
+		return err
+	}
+	dlErrs, err := is.errors(dljob.id)
+	if err != nil {
+		return err
+	}
+	// internal errors are caused by the node going down - retry
+	errs := dlErrs[:0]
+	for _, e := range dlErrs {
+		if e.Err != internalErrorMsg {
+			errs = append(errs, e)
+		}
+	}
+	if len(errs) < len(dlErrs) {
+		if err := is.setErrors(dljob.id, errs); err != nil {
+			return err
+		}
+	}
+	dljob.done = make(cos.StrSet, len(tasks)+len(errs))
+	for _, e := range errs {
+		dljob.done.Add(e.Name)
+	}
+	nerr := len(dljob.done)
+	for i := range tasks {
+		dljob.done.Add(tasks[i].Name)
+	}
+	dljob.finishedCnt.Store(int32(len(dljob.done) - nerr))
+	dljob.errorCnt.Store(int32(nerr))
+	dljob.scheduledCnt.Store(int32(len(dljob.done)))
+	return nil
+}
+
+// Unfinished returns jobs that were running when the node went down
+// (and that need to be restarted), indexed by job ID.
+func Unfinished() (jobs map[string]*Interrupted) {
+	if dlStore == nil {
+		return nil
+	}
+	dlStore.RLock()
+	for id, dljob := range dlStore.dljobs {
+		if dljob.done == nil || !_isRunning(dljob.finishedTime.Load()) {
+			continue
+		}
+		if jobs == nil {
+			jobs = make(map[string]*Interrupted, 2)
+		}
+		jobs[id] = &Interrupted{XactID: dljob.xid, Body: dljob.body}
+	}
+	dlStore.RUnlock()
+	return
+}
+
 func (is *infoStore) getJob(id string) (*dljob, error) {
 	is.RLock()
 	defer is.RUnlock()
@@ -70,19 +189,55 @@ func (is *infoStore) getList(req *request) (jobs []*dljob) {
 }
 
 func (is *infoStore) setJob(job jobif) (njob *dljob) {
-	njob = &dljob{
-		id:          job.ID(),
-		xid:         job.XactID(),
-		total:       job.Len(),
-		description: job.Description(),
-		startedTime: time.Now(),
-	}
 	is.Lock()
-	is.dljobs[job.ID()] = njob
+	// resuming (keep counters and start time)
+	if njob = is.dljobs[job.ID()]; njob != nil && njob.done != nil && _isRunning(njob.finishedTime.Load()) {
+		njob.xid = job.XactID()
+		njob.total = job.Len()
+	} else {
+		njob = &dljob{
+			id:          job.ID(),
+			xid:         job.XactID(),
+			total:       job.Len(),
+			description: job.Description(),
+			startedTime: time.Now(),
+			body:        job.Body(),
+		}
+		is.dljobs[job.ID()] = njob
+	}
 	is.Unlock()
+	is.persist(njob)
 	return
 }
 
+// returns names of the objects processed prior to restart (nil if not resuming)
+func (is *infoStore) resumed(id string) cos.StrSet {
+	is.RLock()
+	defer is.RUnlock()
+	if dljob, ok := is.dljobs[id]; ok {
+		return dljob.done
+	}
+	return nil
+}
+
+func (is *infoStore) persist(dljob *dljob) {
+	if is.driver == nil || dljob.interrupted.Load() {
+		return
+	}
+	_ = is.persistJob(&jobRec{Job: dljob.clone(), Type: dljob.body.Type, Body: dljob.body.RawMessage})
+}
+
+// the node is going down: running jobs must not be persisted as finished (or aborted)
+func (is *infoStore) setInterrupted(xid string) {
+	is.RLock()
+	for _, dljob := range is.dljobs {
+		if dljob.xid == xid && _isRunning(dljob.finishedTime.Load()) {
+			dljob.interrupted.Store(true)
+		}
+	}
+	is.RUnlock()
+}
+
 func (is *infoStore) incFinished(id string) {
 	dljob, err := is.getJob(id)
 	debug.AssertNoErr(err)
@@ -121,6 +276,10 @@ func (is *infoStore) markFinished(id string) error {
 		return err
 	}
 	dljob.finishedTime.Store(time.Now())
+	is.persist(dljob)
+	is.Lock()
+	dljob.done = nil
+	is.Unlock()
 	return dljob.valid()
 }
 
@@ -131,6 +290,7 @@ func (is *infoStore) setAborted(id string) {
 	// NOTE: Don't set `FinishedTime` yet as we are not fully done.
 	//       The job now can be removed but there's no guarantee
 	//       that all tasks have been stopped and all resources were freed.
+	is.persist(dljob)
 }
 
 func (is *infoStore) delJob(id string) {
@@ -143,6 +303,9 @@ func (is *infoStore) housekeep() time.Duration {
 
 	is.Lock()
 	for id, dljob := range is.dljobs {
+		if _isRunning(dljob.finishedTime.Load()) {
+			continue
+		}
 		if time.Since(dljob.finishedTime.Load()) > interval {
 			is.delJob(id)
 		}
@@ -151,3 +314,25 @@ func (is *infoStore) housekeep() time.Duration {
 
 	return interval
 }
+
+func (is *infoStore) persistRunning() time.Duration {
+	var running []*dljob
+	is.RLock()
+	for _, dljob := range is.dljobs {
+		if _isRunning(dljob.finishedTime.Load()) {
+			running = append(running, dljob)
+		}
+	}
+	is.RUnlock()
+	for _, dljob := range running {
+		if dljob.interrupted.Load() {
+			continue
+		}
+		if err := is.flush(dljob.id); err != nil {
+			glog.Errorf("failed to persist download job %q: %v", dljob.id, err)
+			continue
+		}
+		is.persist(dljob)
+	}
+	return persistInterval
+}
@@ -0,0 +1,72 @@
+// Package dload implements functionality to download resources into AIS cluster from external source.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package dload
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/kvdb"
+	"github.com/NVIDIA/aistore/hk"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestInfoStoreRestart(t *testing.T) {
+	hk.TestInit()
+	driver, err := kvdb.NewBuntDB(filepath.Join(t.TempDir(), "dload.db"))
+	tassert.CheckFatal(t, err)
+	defer driver.Close()
+
+	var (
+		is      = newInfoStore(driver)
+		body    = Body{Type: TypeRange, RawMessage: []byte(`{"type":"range","template":"obj-{0..9}"}`)}
+		running = &dljob{id: "running", xid: "x1", total: 10, startedTime: time.Now(), body: body}
+		done    = &dljob{id: "done", xid: "x1", total: 1, startedTime: time.Now(), body: body}
+	)
+	is.dljobs[running.id] = running
+	is.dljobs[done.id] = done
+	is.persist(running)
+	tassert.CheckFatal(t, is.persistTaskInfo(running.id, TaskDlInfo{Name: "obj-0"}))
+	tassert.CheckFatal(t, is.persistTaskInfo(running.id, TaskDlInfo{Name: "obj-1"}))
+	tassert.CheckFatal(t, is.persistTaskInfo(running.id, TaskDlInfo{Name: "obj-2"}))
+	is.persistError(running.id, "obj-2", "not found")
+	is.persistError(running.id, "obj-3", internalErrorMsg) // node going down
+	tassert.CheckFatal(t, is.flush(running.id))
+
+	done.finishedCnt.Store(1)
+	done.scheduledCnt.Store(1)
+	done.allDispatched.Store(true)
+	tassert.CheckFatal(t, is.markFinished(done.id))
+
+	// restart
+	is = newInfoStore(driver)
+	tassert.Fatalf(t, len(is.dljobs) == 2, "expected 2 jobs, got %d", len(is.dljobs))
+
+	resumed := is.dljobs[running.id]
+	tassert.Errorf(t, resumed.body.Type == TypeRange && string(resumed.body.RawMessage) == string(body.RawMessage),
+		"unexpected body %+v", resumed.body)
+	tassert.Errorf(t, _isRunning(resumed.finishedTime.Load()), "expected %q to be running", resumed.id)
+	tassert.Errorf(t, resumed.xid == running.xid, "expected %q to resume with xid %q, got %q", resumed.id, running.xid, resumed.xid)
+	tassert.Errorf(t, resumed.done.All("obj-0", "obj-1", "obj-2") && !resumed.done.Contains("obj-3"),
+		"unexpected done set %v", resumed.done)
+	job := resumed.clone()
+	tassert.Errorf(t, job.FinishedCnt == 2 && job.ErrorCnt == 1 && job.ScheduledCnt == 3,
+		"unexpected counters %+v", job)
+	errs, err := is.getErrors(running.id)
+	tassert.CheckFatal(t, err)
+	tassert.Errorf(t, len(errs) == 1 && errs[0].Name == "obj-2", "unexpected errors %v", errs)
+
+	finished := is.dljobs[done.id]
+	tassert.Errorf(t, finished.done == nil && !_isRunning(finished.finishedTime.Load()),
+		"expected %q to be finished", finished.id)
+	tassert.Errorf(t, finished.finishedCnt.Load() == 1, "unexpected counters %+v", finished.clone())
+
+	// interrupted jobs are not persisted as finished
+	resumed.interrupted.Store(true)
+	tassert.CheckFatal(t, is.markFinished(running.id))
+	is = newInfoStore(driver)
+	tassert.Errorf(t, is.dljobs[running.id].done != nil, "expected %q to be resumed again", running.id)
+}
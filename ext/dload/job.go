@@ -51,6 +51,7 @@ type (
 		String() string
 		Notif() cluster.Notif // notifications
 		AddNotif(n cluster.Notif, job jobif)
+		Body() Body // original request (to resume the job after restart)
 
 		// If total length (size) of download job is not known, -1 should be returned.
 		Len() int
@@ -70,6 +71,8 @@ type (
 
 		// job cleanup
 		cleanup()
+
+		setBody(Body)
 	}
 
 	baseDlJob struct {
@@ -78,6 +81,7 @@ type (
 		xdl         *Xact
 		id          string
 		description string
+		body        Body
 		timeout     time.Duration
 		throt       throttler
 	}
@@ -128,6 +132,9 @@ type (
 		total         int
 		aborted       atomic.Bool
 		allDispatched atomic.Bool
+		interrupted   atomic.Bool // node going down - see infoStore.setInterrupted
+		body          Body        // to resume after restart
+		done          cos.StrSet  // objects processed prior to restart (when resuming)
 	}
 )
 
@@ -157,6 +164,7 @@ func (j *baseDlJob) XactID() string         { return j.xdl.ID() }
 func (j *baseDlJob) Bck() *cmn.Bck          { return j.bck.Bucket() }
 func (j *baseDlJob) Timeout() time.Duration { return j.timeout }
 func (j *baseDlJob) Description() string    { return j.description }
+func (j *baseDlJob) Body() Body             { return j.body }
 func (*baseDlJob) Sync() bool               { return false }
 
 func (j *baseDlJob) String() (s string) {
@@ -191,6 +199,7 @@ func (j *baseDlJob) ActiveStats() (*StatusResp, error) {
 
 func (*baseDlJob) checkObj(string) bool    { debug.Assert(false); return false }
 func (j *baseDlJob) throttler() *throttler { return &j.throt }
+func (j *baseDlJob) setBody(dlb Body)      { j.body = dlb }
 
 func (j *baseDlJob) cleanup() {
 	j.throttler().stop()
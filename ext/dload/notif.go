@@ -34,9 +34,11 @@ var (
 	_ cluster.Notif = (*NotifDownload)(nil)
 )
 
-func NewDownloadNL(jobID, action string, smap *meta.Smap, progressInterval time.Duration) *NotifDownloadListerner {
+// srcs: targets that run the job (all active targets, or a single one upon resuming - see `Unfinished`)
+func NewDownloadNL(jobID, action string, smap *meta.Smap, srcs meta.NodeMap,
+	progressInterval time.Duration) *NotifDownloadListerner {
 	return &NotifDownloadListerner{
-		ListenerBase: *nl.NewNLB(jobID, action, smap, smap.Tmap.ActiveMap(), progressInterval),
+		ListenerBase: *nl.NewNLB(jobID, action, smap, srcs, progressInterval),
 	}
 }
 
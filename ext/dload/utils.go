@@ -117,6 +117,15 @@ func NormalizeObjName(objName string) (string, error) {
 }
 
 func ParseStartRequest(t cluster.Target, bck *meta.Bck, id string, dlb Body, xdl *Xact) (jobif, error) {
+	job, err := parseStartRequest(t, bck, id, dlb, xdl)
+	if err != nil {
+		return nil, err
+	}
+	job.setBody(dlb)
+	return job, nil
+}
+
+func parseStartRequest(t cluster.Target, bck *meta.Bck, id string, dlb Body, xdl *Xact) (jobif, error) {
 	switch dlb.Type {
 	case TypeBackend:
 		dp := &BackendBody{}
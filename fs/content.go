@@ -37,8 +37,12 @@ const (
 	WorkfileType = "wk"
 	ECSliceType  = "ec"
 	ECMetaType   = "mt"
+	ObjVerType   = "vr" // prior (retained) object versions
 )
 
+// prior object version: "<object-name>~v<version>"
+const objVerSepa = "~v"
+
 type (
 	ContentResolver interface {
 		// When set to true, services like rebalance have permission to move
@@ -167,6 +171,7 @@ type (
 	WorkfileContentResolver struct{}
 	ECSliceContentResolver  struct{}
 	ECMetaContentResolver   struct{}
+	ObjVerContentResolver   struct{}
 )
 
 func (*ObjectContentResolver) PermToMove() bool                   { return true }
@@ -235,3 +240,30 @@ func (*ECMetaContentResolver) GenUniqueFQN(base, _ string) string { return base
 func (*ECMetaContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
 	return base, false, true
 }
+
+func (*ObjVerContentResolver) PermToMove() bool    { return false }
+func (*ObjVerContentResolver) PermToEvict() bool   { return false }
+func (*ObjVerContentResolver) PermToProcess() bool { return false }
+
+// prefix is the object's version
+func (*ObjVerContentResolver) GenUniqueFQN(base, prefix string) string {
+	return base + objVerSepa + prefix
+}
+
+func (*ObjVerContentResolver) ParseUniqueFQN(base string) (orig string, old, ok bool) {
+	orig, _, ok = ParseObjVer(base)
+	return
+}
+
+// returns object name (or basename) and version of a given prior object version
+func ParseObjVer(name string) (objName, ver string, ok bool) {
+	i := strings.LastIndex(name, objVerSepa)
+	if i <= 0 {
+		return
+	}
+	objName, ver = name[:i], name[i+len(objVerSepa):]
+	if _, err := strconv.ParseUint(ver, 10, 64); err != nil {
+		return "", "", false
+	}
+	return objName, ver, true
+}
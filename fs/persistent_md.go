@@ -30,6 +30,11 @@ var mdFilesDirs = []string{
 	fname.BmdPrevious,
 
 	fname.Vmd,
+
+	fname.MptDir,
+	fname.WriteBackDir,
+	fname.BackendMpuDir,
+	fname.ReplDir,
 }
 
 func MarkerExists(marker string) bool {
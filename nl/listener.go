@@ -49,6 +49,7 @@ type Listener interface {
 
 	// detailed ref-counting
 	ActiveNotifiers() meta.NodeMap
+	AddNotifiers(srcs meta.NodeMap)
 	FinCount() int
 	ActiveCount() int
 	HasFinished(node *meta.Snode) bool
@@ -141,6 +142,26 @@ func (nlb *ListenerBase) ActiveNotifiers() meta.NodeMap { return nlb.ActiveSrcs
 func (nlb *ListenerBase) ActiveCount() int              { return len(nlb.ActiveSrcs) }
 func (nlb *ListenerBase) FinCount() int                 { return len(nlb.Srcs) - nlb.ActiveCount() }
 
+// merge notifiers of the same (UUID) operation registered separately, each on its own behalf
+// (under lock; not modifying the original `Srcs` that may be shared)
+func (nlb *ListenerBase) AddNotifiers(srcs meta.NodeMap) {
+	debug.AssertRWMutexLocked(&nlb.mu)
+	merged := make(meta.NodeMap, len(nlb.Srcs)+len(srcs))
+	for id, si := range nlb.Srcs {
+		merged[id] = si
+	}
+	for id, si := range srcs {
+		if _, ok := merged[id]; ok {
+			continue
+		}
+		merged[id] = si
+		if !si.InMaintOrDecomm() {
+			nlb.ActiveSrcs[id] = si
+		}
+	}
+	nlb.Srcs = merged
+}
+
 func (nlb *ListenerBase) MarkFinished(node *meta.Snode) {
 	delete(nlb.ActiveSrcs, node.ID())
 }
@@ -33,6 +33,7 @@ type (
 		Aborted     bool          `json:"aborted"`             // aborted?
 		Running     bool          `json:"running"`             // running?
 		Quiescent   bool          `json:"quiescent"`           // true when queue is empty
+		Paused      bool          `json:"paused,omitempty"`    // paused (see ActRebPause)
 	}
 )
 
@@ -98,6 +99,7 @@ func (reb *Reb) rxReady(tsi *meta.Snode, rargs *rebArgs) (ok bool) {
 		sleep  = cmn.Timeout.CplaneOperation() * 2
 		maxwt  = rargs.config.Rebalance.DestRetryTime.D() + rargs.config.Rebalance.DestRetryTime.D()/2
 		curwt  time.Duration
+		status *Status
 		logHdr = reb.logHdr(rargs.id, rargs.smap)
 		xreb   = reb.xctn()
 	)
@@ -107,14 +109,16 @@ func (reb *Reb) rxReady(tsi *meta.Snode, rargs *rebArgs) (ok bool) {
 			// do not request the node stage if it has sent stage notification
 			return true
 		}
-		if _, ok = reb.checkStage(tsi, rargs, rebStageTraverse); ok {
+		if status, ok = reb.checkStage(tsi, rargs, rebStageTraverse); ok {
 			return
 		}
 		if err := xreb.AbortedAfter(sleep); err != nil {
 			glog.Infof("%s: abort rx-ready (%v)", logHdr, err)
 			return
 		}
-		curwt += sleep
+		if status == nil || !status.Paused { // (paused is not timing out)
+			curwt += sleep
+		}
 	}
 	glog.Errorf("%s: timed out waiting for %s to reach %s state", logHdr, tsi.StringEx(), stages[rebStageTraverse])
 	return
@@ -135,6 +139,10 @@ func (reb *Reb) waitFinExtended(tsi *meta.Snode, rargs *rebArgs) (ok bool) {
 	)
 	debug.Assertf(reb.RebID() == xreb.RebID(), "%s (rebID=%d) vs %s", logHdr, reb.RebID(), xreb)
 	for curwt < maxwt {
+		if err := reb.waitPaused(xreb); err != nil {
+			glog.Infof("%s: abort wack (%v)", logHdr, err)
+			return
+		}
 		if err := xreb.AbortedAfter(sleep); err != nil {
 			glog.Infof("%s: abort wack (%v)", logHdr, err)
 			return
@@ -151,6 +159,10 @@ func (reb *Reb) waitFinExtended(tsi *meta.Snode, rargs *rebArgs) (ok bool) {
 			glog.Infof("%s: abort wack (%v)", logHdr, err)
 			return
 		}
+		if status.Paused {
+			curwt = 0 // keep waiting for as long as tsi is paused
+			continue
+		}
 		//
 		// tsi in rebStageWaitAck
 		//
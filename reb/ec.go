@@ -286,6 +286,9 @@ func (reb *Reb) walkEC(fqn string, de fs.DirEntry) (err error) {
 		// notify `dir.Walk` to stop iterations
 		return cmn.NewErrAborted(xreb.Name(), "walk-ec", err)
 	}
+	if err := reb.waitPaused(xreb); err != nil {
+		return cmn.NewErrAborted(xreb.Name(), "walk-ec-paused", err)
+	}
 
 	if de.IsDir() {
 		return nil
@@ -333,5 +336,12 @@ func (reb *Reb) walkEC(fqn string, de fs.DirEntry) (err error) {
 	if err != nil {
 		return nil
 	}
-	return reb.sendFromDisk(ct, md, hrwTarget)
+	if err := reb.sendFromDisk(ct, md, hrwTarget); err != nil {
+		return err
+	}
+	size := md.Size
+	if !isReplica {
+		size = ec.SliceSize(md.Size, md.Data)
+	}
+	return reb.throttle(xreb, size)
 }
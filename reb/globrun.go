@@ -90,6 +90,9 @@ type (
 		onAir   atomic.Int64
 		mu      sync.RWMutex
 		laterx  atomic.Bool
+		// pacing (see pace.go)
+		bwlim  bwlim
+		paused atomic.Bool
 	}
 	lomAcks struct {
 		mu *sync.Mutex
@@ -533,6 +536,10 @@ func (reb *Reb) rebWaitAck(rargs *rebArgs) (errCnt int) {
 		// poll for no more than maxwt while keeping track of the cumulative polling time via curwt
 		// (here and elsewhere)
 		for curwt < maxwt {
+			if err := reb.waitPaused(xreb); err != nil {
+				glog.Infof("%s: abort wait-ack (%v)", logHdr, err)
+				return
+			}
 			cnt = 0
 			var logged bool
 			for _, lomack := range reb.lomAcks() {
@@ -686,6 +693,7 @@ func (reb *Reb) fini(rargs *rebArgs, logHdr string, err error) {
 	}
 	reb.stages.stage.Store(rebStageDone)
 	reb.stages.cleanup()
+	reb.paused.Store(false)
 
 	reb.unregRecv()
 	reb.semaCh.Release()
@@ -746,6 +754,9 @@ func (rj *rebJogger) visitObj(fqn string, de fs.DirEntry) (err error) {
 	if err := rj.xreb.AbortErr(); err != nil {
 		return cmn.NewErrAborted(rj.xreb.Name(), "rj-walk", err)
 	}
+	if err := rj.m.waitPaused(rj.xreb); err != nil {
+		return cmn.NewErrAborted(rj.xreb.Name(), "rj-walk-paused", err)
+	}
 	if de.IsDir() {
 		return nil
 	}
@@ -792,12 +803,13 @@ func (rj *rebJogger) _lwalk(lom *cluster.LOM, fqn string) error {
 		return err
 	}
 	// transmit (unlock via transport completion => roc.Close)
+	size := lom.SizeBytes()
 	rj.m.addLomAck(lom)
 	if err := rj.doSend(lom, tsi, roc); err != nil {
 		rj.m.delLomAck(lom, 0, false /*free LOM*/)
 		return err
 	}
-	return nil
+	return rj.m.throttle(rj.xreb, size)
 }
 
 // takes rlock and keeps it _iff_ successful
@@ -0,0 +1,101 @@
+// Package reb provides global cluster-wide rebalance upon adding/removing storage nodes.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package reb
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/xact/xs"
+)
+
+// Pacing the running rebalance:
+// - bandwidth: outgoing traffic of each target is limited to `rebalance.bandwidth`
+//   bytes per second (token bucket with one second worth of burst); the limit is read
+//   from the current config upon every send and can be changed at any time;
+// - pause/resume (apc.ActRebPause, apc.ActRebResume): joggers block in place, thus
+//   retaining both the current stage and the traversal position, while the timeouts
+//   (waiting for ACKs and for other targets) stop ticking. Unlike abort, resume simply
+//   continues from where it left off. Pause applies to the currently running rebalance
+//   only, and is reset when the latter finishes.
+
+const pausePollIval = time.Second
+
+var errRebNotRunning = errors.New("global rebalance is not running")
+
+type bwlim struct {
+	tokens float64 // bytes; negative - outstanding reservations
+	last   int64   // last refill (mono time); zero when unlimited
+	mu     sync.Mutex
+}
+
+func (reb *Reb) Pause() error {
+	xreb := reb.xctn()
+	if xreb == nil || xreb.Finished() || xreb.IsAborted() {
+		return errRebNotRunning
+	}
+	if stage := reb.stages.stage.Load(); stage < rebStageInit || stage >= rebStageFin {
+		return errRebNotRunning
+	}
+	if reb.paused.CAS(false, true) {
+		glog.Warningf("%s: paused %s at stage %s", reb.t, xreb, stages[reb.stages.stage.Load()])
+	}
+	return nil
+}
+
+// (not paused: nothing to do)
+func (reb *Reb) Resume() {
+	if reb.paused.CAS(true, false) {
+		glog.Warningf("%s: resumed %s", reb.t, reb.xctn())
+	}
+}
+
+func (reb *Reb) IsPaused() bool { return reb.paused.Load() }
+
+// wait while paused; returns non-nil upon abort
+func (reb *Reb) waitPaused(xreb *xs.Rebalance) error {
+	for reb.paused.Load() {
+		if err := xreb.AbortedAfter(pausePollIval); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// account for `size` bytes sent and sleep, if need be, to stay within the configured bandwidth
+func (reb *Reb) throttle(xreb *xs.Rebalance, size int64) error {
+	if d := reb.bwlim.reserve(size, int64(cmn.GCO.Get().Rebalance.Bandwidth)); d > 0 {
+		return xreb.AbortedAfter(d)
+	}
+	return nil
+}
+
+func (bw *bwlim) reserve(size, rate int64) (d time.Duration) {
+	bw.mu.Lock()
+	defer bw.mu.Unlock()
+	if rate <= 0 {
+		bw.last = 0
+		return
+	}
+	now := mono.NanoTime()
+	if bw.last == 0 {
+		bw.tokens = float64(rate)
+	} else {
+		bw.tokens += float64(rate) * time.Duration(now-bw.last).Seconds()
+		if bw.tokens > float64(rate) {
+			bw.tokens = float64(rate)
+		}
+	}
+	bw.last = now
+	bw.tokens -= float64(size)
+	if bw.tokens < 0 {
+		d = time.Duration(-bw.tokens / float64(rate) * float64(time.Second))
+	}
+	return
+}
@@ -0,0 +1,46 @@
+// Package reb provides global cluster-wide rebalance upon adding/removing storage nodes.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package reb
+
+import (
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bandwidth limit", func() {
+	It("should not throttle when unlimited", func() {
+		var bw bwlim
+		for i := 0; i < 10; i++ {
+			Expect(bw.reserve(cos.GiB, 0)).To(BeZero())
+		}
+	})
+
+	It("should allow one second worth of burst", func() {
+		var bw bwlim
+		Expect(bw.reserve(cos.MiB/2, cos.MiB)).To(BeZero())
+		Expect(bw.reserve(cos.MiB/2, cos.MiB)).To(BeZero())
+		d := bw.reserve(cos.MiB, cos.MiB)
+		Expect(d).To(BeNumerically("~", time.Second, 10*time.Millisecond))
+	})
+
+	It("should accumulate outstanding reservations", func() {
+		var bw bwlim
+		Expect(bw.reserve(cos.MiB, cos.MiB)).To(BeZero())
+		d1 := bw.reserve(cos.MiB, cos.MiB)
+		d2 := bw.reserve(cos.MiB, cos.MiB)
+		Expect(d2 - d1).To(BeNumerically("~", time.Second, 10*time.Millisecond))
+	})
+
+	It("should reset when the limit is removed", func() {
+		var bw bwlim
+		bw.reserve(10*cos.MiB, cos.MiB)
+		Expect(bw.reserve(cos.MiB, 0)).To(BeZero())
+		Expect(bw.reserve(cos.MiB, cos.MiB)).To(BeZero())
+	})
+})
@@ -31,6 +31,7 @@ func (reb *Reb) RebStatus(status *Status) {
 	status.Stage = reb.stages.stage.Load()
 	status.RebID = reb.rebID.Load()
 	status.Quiescent = reb.isQuiescent()
+	status.Paused = reb.paused.Load()
 	status.SmapVersion = tsmap.Version
 	rsmap := (*meta.Smap)(reb.smap.Load())
 	if rsmap != nil {
@@ -38,6 +38,10 @@ type (
 		StatsT  stats.Tracker
 		Buckets []cmn.Bck // optional list of specific buckets to cleanup
 		WG      *sync.WaitGroup
+		// (optional) multipart uploads, e.g. via S3 API: whether a given workfile (basename)
+		// belongs to an active upload, and removal of uploads abandoned on a given mountpath
+		IsActiveMpt    func(workfileBase string) bool
+		RmAbandonedMpt func(mpath string, maxAge time.Duration) (cnt int, size int64)
 	}
 	XactCln struct {
 		xact.Base
@@ -243,6 +247,7 @@ func (j *clnJ) run(providers []string) {
 	if erm != nil {
 		glog.Error(erm)
 	}
+	j.rmAbandonedMpt()
 
 	// traverse
 	if len(j.ini.Buckets) != 0 {
@@ -344,6 +349,20 @@ func (j *clnJ) removeDeleted() (err error) {
 	return
 }
 
+// remove S3 multipart uploads abandoned on this mountpath (see also: visitCT)
+func (j *clnJ) rmAbandonedMpt() {
+	if j.ini.RmAbandonedMpt == nil {
+		return
+	}
+	cnt, size := j.ini.RmAbandonedMpt(j.mi.Path, j.config.Space.AbandonedMpt())
+	if cnt == 0 {
+		return
+	}
+	j.ini.StatsT.Add(stats.CleanupStoreSize, size)
+	j.ini.Xaction.ObjsAdd(cnt, size)
+	glog.Infof("%s: removed %d abandoned multipart upload%s, size %s", j, cnt, cos.Plural(cnt), cos.ToSizeIEC(size, 1))
+}
+
 func (j *clnJ) jogBck() (size int64, err error) {
 	opts := &fs.WalkOpts{
 		Mi:       j.mi,
@@ -366,8 +385,8 @@ func (j *clnJ) visitCT(parsedFQN fs.ParsedFQN, fqn string) {
 		_, base := filepath.Split(fqn)
 		contentResolver := fs.CSM.Resolver(fs.WorkfileType)
 		_, old, ok := contentResolver.ParseUniqueFQN(base)
-		// workfiles: remove old or do nothing
-		if ok && old {
+		// workfiles: remove old (unless belonging to an active S3 multipart upload) or do nothing
+		if ok && old && (j.ini.IsActiveMpt == nil || !j.ini.IsActiveMpt(base)) {
 			j.oldWork = append(j.oldWork, fqn)
 		}
 	case fs.ECSliceType:
@@ -326,6 +326,14 @@ func (j *lruJ) _visit(lom *cluster.LOM) (pushed bool) {
 	if lom.AtimeUnix()+int64(j.config.LRU.DontEvictTime) > j.now {
 		return
 	}
+	// object lock: never evict objects under retention
+	if lom.Bprops().ObjLock.Check(lom.Cname(), lom, false /*bypass*/, time.Unix(0, j.now)) != nil {
+		return
+	}
+	// nor the ones that are yet to be written back to their remote backends
+	if _, pending := lom.GetCustomKey(cmn.WriteBackObjMD); pending {
+		return
+	}
 	if lom.HasCopies() && lom.IsCopy() {
 		return
 	}
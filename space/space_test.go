@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -283,6 +285,33 @@ var _ = Describe("space evict/cleanup tests", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(len(files)).To(Equal(0))
 			})
+
+			It("should remove old workfiles unless they belong to active multipart uploads", func() {
+				var (
+					mi      = fs.GetAvail()[basePath]
+					bck     = cmn.Bck{Name: bucketName, Provider: apc.AIS, Ns: cmn.NsGlobal}
+					workDir = mi.MakePathCT(&bck, fs.WorkfileType)
+					active  = "upload1.1.obj.tie.0" // (old: pid zero)
+					stale   = "upload2.1.obj.tie.0" // ditto
+					fresh   = fs.CSM.Resolver(fs.WorkfileType).GenUniqueFQN("obj", "upload3")
+				)
+				cos.CreateDir(workDir)
+				for _, name := range []string{active, stale, fresh} {
+					Expect(os.WriteFile(filepath.Join(workDir, name), []byte("part"), cos.PermRWR)).NotTo(HaveOccurred())
+				}
+				var checked []string
+				ini.IsActiveMpt = func(base string) bool {
+					checked = append(checked, base)
+					return strings.HasPrefix(base, "upload1.")
+				}
+
+				space.RunCleanup(ini)
+
+				Expect(filepath.Join(workDir, active)).To(BeARegularFile())
+				Expect(filepath.Join(workDir, fresh)).To(BeARegularFile())
+				Expect(filepath.Join(workDir, stale)).NotTo(BeAnExistingFile())
+				Expect(checked).To(ConsistOf(active, stale))
+			})
 		})
 	})
 })
@@ -73,6 +73,14 @@ type (
 		K8sPodName     string         `json:"k8s_pod_name"` // (via ais-k8s/operator `MY_POD` env var)
 		MemCPUInfo     apc.MemCPUInfo `json:"sys_info"`
 		SmapVersion    int64          `json:"smap_version,string"`
+		// health of remote backends, by provider (targets only)
+		Backends map[string]*BackendHealth `json:"backends,omitempty"`
+	}
+	BackendHealth struct {
+		Err     string `json:"err,omitempty"`     // last probing error, if any
+		Since   int64  `json:"since,string"`      // (unix nano) went offline or back online
+		Probed  int64  `json:"probed,string"`     // (unix nano) last time probed
+		Offline bool   `json:"offline,omitempty"` // serving cached objects only
 	}
 )
 
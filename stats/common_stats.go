@@ -367,6 +367,8 @@ func (s *coreStats) doAdd(name, nameSuffix string, val int64) {
 			s.statsdC.Send(v.label.comm+"."+nameSuffix,
 				1, metric{Type: statsd.Counter, Name: "count", Value: val})
 		}
+	case KindGauge:
+		ratomic.AddInt64(&v.Value, val) // (negative val to decrement)
 	default:
 		debug.Assert(false, v.kind)
 	}
@@ -517,9 +519,9 @@ func (s *coreStats) reset(errorsOnly bool) {
 			v.mu.Lock()
 			v.Value, v.cumulative = 0, 0
 			v.mu.Unlock()
-		case KindCounter, KindSize, KindComputedThroughput, KindGauge:
+		case KindCounter, KindSize, KindComputedThroughput:
 			ratomic.StoreInt64(&v.Value, 0)
-		default: // KindSpecial - do nothing
+		default: // KindSpecial, KindGauge (current state) - do nothing
 		}
 	}
 }
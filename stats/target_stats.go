@@ -43,9 +43,21 @@ const (
 	CleanupStoreCount = "cleanup.store.n"
 	CleanupStoreSize  = "cleanup.store.size"
 
+	// scrub: objects validated (all copies included), and restored replicas
+	ScrubCount         = "scrub.n"
+	ScrubSize          = "scrub.size"
+	ScrubRestoredCount = "scrub.restored.n"
+
 	VerChangeCount = "ver.change.n"
 	VerChangeSize  = "ver.change.size"
 
+	// write-back: objects (asynchronously) written to remote backends
+	WriteBackCount = "wb.n"
+	WriteBackSize  = "wb.size"
+
+	// remote backends: requests delayed by rate limiting and/or retried upon throttling (429, 503)
+	ThrottleCount = "throttle.n"
+
 	// intra-cluster transmit & receive
 	StreamsOutObjCount = transport.OutObjCount
 	StreamsOutObjSize  = transport.OutObjSize
@@ -57,6 +69,18 @@ const (
 	ErrCksumSize     = "err.cksum.size"
 	ErrMetadataCount = "err.md.n"
 	ErrIOCount       = "err.io.n"
+
+	ErrWriteBackCount = "err.wb.n"       // failed write-back attempts (to be retried)
+	ErrThrottleCount  = "err.throttle.n" // throttled requests that failed after max retries
+
+	ErrBackendOfflineCount = "err.backend.offline.n" // remote requests failed fast (503) while backend is offline
+
+	ErrScrubCorruptCount = "err.scrub.corrupt.n" // corrupted or missing object replicas (found by scrub)
+	ErrScrubLostCount    = "err.scrub.lost.n"    // objects that could not be restored
+
+	// KindGauge
+	WriteBackPending = "wb.pending.n"      // objects yet to be written back
+	BackendOffline   = "backend.offline.n" // remote backend endpoints that are currently offline (see ais/tgtbhealth.go)
 	// special
 	RestartCount = "restart.n"
 
@@ -66,6 +90,7 @@ const (
 	GetRedirLatency = "get.redir.ns"
 	PutRedirLatency = "put.redir.ns"
 	DownloadLatency = "dl.ns"
+	ThrottleLatency = "throttle.ns" // total time spent waiting (rate limit and backoff)
 
 	// DSort
 	DSortCreationReqCount    = "dsort.creation.req.n"
@@ -193,9 +218,20 @@ func (r *Trunner) RegMetrics(node *meta.Snode) {
 	r.reg(CleanupStoreCount, KindCounter)
 	r.reg(CleanupStoreSize, KindSize)
 
+	r.reg(ScrubCount, KindCounter)
+	r.reg(ScrubSize, KindSize)
+	r.reg(ScrubRestoredCount, KindCounter)
+
 	r.reg(VerChangeCount, KindCounter)
 	r.reg(VerChangeSize, KindSize)
 
+	r.reg(WriteBackCount, KindCounter)
+	r.reg(WriteBackSize, KindSize)
+	r.reg(WriteBackPending, KindGauge)
+
+	r.reg(ThrottleCount, KindCounter)
+	r.reg(ThrottleLatency, KindLatency)
+
 	r.reg(PutLatency, KindLatency)
 	r.reg(AppendLatency, KindLatency)
 	r.reg(GetRedirLatency, KindLatency)
@@ -214,6 +250,12 @@ func (r *Trunner) RegMetrics(node *meta.Snode) {
 
 	r.reg(ErrMetadataCount, KindCounter)
 	r.reg(ErrIOCount, KindCounter)
+	r.reg(ErrWriteBackCount, KindCounter)
+	r.reg(ErrThrottleCount, KindCounter)
+	r.reg(ErrBackendOfflineCount, KindCounter)
+	r.reg(BackendOffline, KindGauge)
+	r.reg(ErrScrubCorruptCount, KindCounter)
+	r.reg(ErrScrubLostCount, KindCounter)
 
 	// streams
 	r.reg(StreamsOutObjCount, KindCounter)
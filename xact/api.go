@@ -115,6 +115,7 @@ var Table = map[string]Descriptor{
 	// (one bucket) | (all buckets)
 	apc.ActLRU:          {DisplayName: "lru-eviction", Scope: ScopeGB, Startable: true, Mountpath: true},
 	apc.ActStoreCleanup: {DisplayName: "cleanup", Scope: ScopeGB, Startable: true, Mountpath: true},
+	apc.ActStoreScrub:   {DisplayName: "scrub", Scope: ScopeGB, Startable: true, Mountpath: true},
 	apc.ActSummaryBck: {
 		DisplayName: "summary",
 		Scope:       ScopeGB,
@@ -135,6 +136,12 @@ var Table = map[string]Descriptor{
 	apc.ActECRespond: {Scope: ScopeB, Startable: false, Idles: true},
 	apc.ActPutCopies: {Scope: ScopeB, Startable: false, Mountpath: true, RefreshCap: true, Idles: true},
 
+	// on-demand write-back (non-startable, triggered by PUT => bucket with `write_policy.data` = "delayed")
+	apc.ActWriteBack: {Scope: ScopeB, Startable: false, Idles: true},
+
+	// on-demand replication to remote AIS cluster (non-startable, triggered by PUT or DELETE => bucket with `replication.dst`)
+	apc.ActReplicate: {Scope: ScopeB, Startable: false, Idles: true},
+
 	// on-demand multi-object
 	apc.ActArchive:     {Scope: ScopeB, Startable: false, RefreshCap: true, Idles: true},
 	apc.ActCopyObjects: {DisplayName: "copy-objects", Scope: ScopeB, Startable: false, RefreshCap: true, Idles: true},
@@ -178,6 +185,25 @@ var Table = map[string]Descriptor{
 		Mountpath:   true,
 		MassiveBck:  true,
 	},
+	apc.ActECReencode: {
+		DisplayName: "ec-reencode",
+		Scope:       ScopeB,
+		Access:      apc.AccessRW,
+		Startable:   true,
+		Metasync:    true,
+		RefreshCap:  true,
+		Mountpath:   true,
+		MassiveBck:  true,
+	},
+	apc.ActECScrub: {
+		DisplayName: "ec-scrub",
+		Scope:       ScopeB,
+		Access:      apc.AccessRW,
+		Startable:   true,
+		RefreshCap:  true,
+		Mountpath:   true,
+		MassiveBck:  true,
+	},
 	apc.ActMakeNCopies: {
 		DisplayName: "mirror",
 		Scope:       ScopeB,
@@ -222,6 +248,23 @@ var Table = map[string]Descriptor{
 		MassiveBck:  true,
 	},
 
+	apc.ActLifecycle: {
+		DisplayName: "lifecycle-expire",
+		Scope:       ScopeB,
+		Access:      apc.AceObjDELETE,
+		Startable:   true,
+		RefreshCap:  true,
+		Mountpath:   true,
+	},
+	apc.ActSyncBck: {
+		DisplayName: "sync-bucket",
+		Scope:       ScopeB,
+		Access:      apc.AceObjDELETE,
+		Startable:   false, // (via apc.ActSyncBck bucket action)
+		RefreshCap:  true,
+		Mountpath:   true,
+	},
+
 	apc.ActList: {Scope: ScopeB, Access: apc.AceObjLIST, Startable: false, Metasync: false, Owned: true, Idles: true},
 
 	// cache management, internal usage
@@ -68,6 +68,14 @@ func RenewECEncode(t cluster.Target, bck *meta.Bck, uuid, phase string) RenewRes
 	return RenewBucketXact(apc.ActECEncode, bck, Args{T: t, Custom: &ECEncodeArgs{Phase: phase}, UUID: uuid})
 }
 
+func RenewECReencode(t cluster.Target, uuid string, bck *meta.Bck) RenewRes {
+	return RenewBucketXact(apc.ActECReencode, bck, Args{T: t, UUID: uuid})
+}
+
+func RenewECScrub(t cluster.Target, uuid string, bck *meta.Bck) RenewRes {
+	return RenewBucketXact(apc.ActECScrub, bck, Args{T: t, UUID: uuid})
+}
+
 func RenewMakeNCopies(t cluster.Target, uuid, tag string) {
 	var (
 		cfg      = cmn.GCO.Get()
@@ -110,10 +118,26 @@ func RenewBckLoadLomCache(t cluster.Target, uuid string, bck *meta.Bck) RenewRes
 	return RenewBucketXact(apc.ActLoadLomCache, bck, Args{T: t, UUID: uuid})
 }
 
+func RenewBckLifecycle(t cluster.Target, uuid string, bck *meta.Bck) RenewRes {
+	return RenewBucketXact(apc.ActLifecycle, bck, Args{T: t, UUID: uuid})
+}
+
+func RenewBckSync(t cluster.Target, uuid string, bck *meta.Bck, msg *apc.SyncBckMsg) RenewRes {
+	return RenewBucketXact(apc.ActSyncBck, bck, Args{T: t, UUID: uuid, Custom: msg})
+}
+
 func RenewPutMirror(t cluster.Target, lom *cluster.LOM) RenewRes {
 	return RenewBucketXact(apc.ActPutCopies, lom.Bck(), Args{T: t, Custom: lom})
 }
 
+func RenewWriteBack(t cluster.Target, bck *meta.Bck) RenewRes {
+	return RenewBucketXact(apc.ActWriteBack, bck, Args{T: t})
+}
+
+func RenewReplicate(t cluster.Target, bck *meta.Bck, remote any) RenewRes {
+	return RenewBucketXact(apc.ActReplicate, bck, Args{T: t, Custom: remote})
+}
+
 func RenewTCB(t cluster.Target, uuid, kind string, custom *TCBArgs) RenewRes {
 	return RenewBucketXact(
 		kind,
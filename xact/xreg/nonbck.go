@@ -45,6 +45,11 @@ func RenewStoreCleanup(id string) RenewRes {
 	return dreg.renew(e, nil)
 }
 
+func RenewStoreScrub(t cluster.Target, statsT stats.Tracker, id string, bck *meta.Bck) RenewRes {
+	e := dreg.nonbckXacts[apc.ActStoreScrub].New(Args{T: t, UUID: id, Custom: statsT}, bck)
+	return dreg.renew(e, bck)
+}
+
 func RenewDownloader(t cluster.Target, statsT stats.Tracker, xid string) RenewRes {
 	e := dreg.nonbckXacts[apc.ActDownload].New(Args{T: t, UUID: xid, Custom: statsT}, nil)
 	return dreg.renew(e, nil)
@@ -138,7 +138,7 @@ func (r *bsummXact) runBck(bck *meta.Bck, listRemote bool) (err error) {
 	cos.CopyStruct(&msg, r.msg) // each bucket to have it's own copy of the msg (we may update it)
 	if bck.IsRemote() {
 		msg.ObjCached = msg.ObjCached || !listRemote
-		if bck.IsHTTP() && !msg.ObjCached {
+		if bck.IsHTTP() && !bck.Props.Extra.HTTP.Listable() && !msg.ObjCached {
 			glog.Warningf("cannot list %s buckets, assuming 'cached'", apc.DisplayProvider(bck.Provider))
 			msg.ObjCached = true
 		}
@@ -0,0 +1,366 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Reconciles in-cluster objects of a given remote bucket with their remote counterparts
+// that may have been updated or deleted out-of-band (i.e., bypassing AIS).
+// Each target walks its (locally stored) objects in the lexicographical order
+// (see fs.WalkBck) while, at the same time, paging through the remote listing - a merge
+// of two sorted sequences. Since the walk is depth-first, the two orders may differ:
+// e.g., "a/b" is walked before "a-c", while the remote listing has "a-c" first ('-' < '/').
+// Objects that the merge cannot tell about (i.e., those walked out of order) are
+// checked individually via remote HEAD. Objects that are no longer present remotely get evicted;
+// objects that have changed (size, version, or ETag/MD5) get evicted or re-read
+// (`apc.SyncBckMsg.Refresh`). In dry-run mode, the xaction only counts and reports.
+//
+// NOTE: to never evict anything that is still there, the xaction aborts upon the very
+// first remote listing error. It also skips objects that are pending write-back, as well
+// as those that were written (or cold-read) after the xaction has started.
+
+const bsyncMaxSample = 64 // max number of object names in the extended stats
+
+type (
+	bsyncFactory struct {
+		xreg.RenewBase
+		xctn *XactBckSync
+		msg  *apc.SyncBckMsg
+	}
+	XactBckSync struct {
+		t       cluster.Target
+		msg     *apc.SyncBckMsg
+		rit     *bsyncIter
+		started int64
+		ext     struct {
+			stale     atomic.Int64
+			deleted   atomic.Int64
+			refreshed atomic.Int64
+			evicted   atomic.Int64
+			sample    []string
+			mu        sync.Mutex
+		}
+		xact.Base
+	}
+	// extended x-sync-bck statistics
+	ExtBckSyncStats struct {
+		Sample    []string `json:"sample,omitempty"`  // names of (some of) the stale and deleted objects
+		Stale     int64    `json:"stale,string"`      // changed remotely
+		Deleted   int64    `json:"deleted,string"`    // deleted remotely
+		Refreshed int64    `json:"refreshed,string"`  // re-read from remote
+		Evicted   int64    `json:"evicted,string"`    // evicted (both stale and deleted)
+		DryRun    bool     `json:"dry_run,omitempty"` // report only
+	}
+
+	// remote listing, one page at a time
+	bsyncIter struct {
+		list func(msg *apc.LsoMsg, lst *cmn.LsoResult) (int, error)
+		msg  apc.LsoMsg
+		lst  cmn.LsoResult
+		last string // last returned name (to enforce the order)
+		prev string // last looked-up (local) name
+		idx  int
+		eof  bool
+	}
+)
+
+// interface guard
+var (
+	_ cluster.Xact   = (*XactBckSync)(nil)
+	_ xreg.Renewable = (*bsyncFactory)(nil)
+)
+
+//////////////////
+// bsyncFactory //
+//////////////////
+
+func (*bsyncFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	msg := args.Custom.(*apc.SyncBckMsg)
+	p := &bsyncFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}, msg: msg}
+	return p
+}
+
+func (p *bsyncFactory) Start() error {
+	if !p.Bck.IsRemote() || p.Bck.IsRemoteAIS() {
+		return fmt.Errorf("cannot sync %s: expecting cloud or http bucket", p.Bck)
+	}
+	p.xctn = newXactBckSync(p.T, p.UUID(), p.Bck, p.msg)
+	return nil
+}
+
+func (*bsyncFactory) Kind() string        { return apc.ActSyncBck }
+func (p *bsyncFactory) Get() cluster.Xact { return p.xctn }
+
+func (*bsyncFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprKeepAndStartNew, nil
+}
+
+/////////////////
+// XactBckSync //
+/////////////////
+
+func newXactBckSync(t cluster.Target, uuid string, bck *meta.Bck, msg *apc.SyncBckMsg) (r *XactBckSync) {
+	r = &XactBckSync{t: t, msg: msg}
+	r.rit = &bsyncIter{
+		list: func(lsmsg *apc.LsoMsg, lst *cmn.LsoResult) (int, error) {
+			return t.Backend(bck).ListObjects(bck, lsmsg, lst)
+		},
+	}
+	r.rit.msg = apc.LsoMsg{
+		Prefix: msg.Prefix,
+		Props:  strings.Join([]string{apc.GetPropsName, apc.GetPropsSize, apc.GetPropsVersion, apc.GetPropsChecksum}, ","),
+	}
+	r.InitBase(uuid, apc.ActSyncBck, bck)
+	return
+}
+
+func (r *XactBckSync) Run(*sync.WaitGroup) {
+	r.started = time.Now().UnixNano()
+	glog.Infof("%s: prefix %q, refresh %t, dry-run %t", r.Name(), r.msg.Prefix, r.msg.Refresh, r.msg.DryRun)
+
+	opts := &fs.WalkBckOpts{
+		WalkOpts: fs.WalkOpts{CTs: []string{fs.ObjectType}, Callback: r.visit, Sorted: true},
+	}
+	opts.WalkOpts.Bck.Copy(r.Bck().Bucket())
+	if r.msg.Prefix != "" {
+		opts.ValidateCallback = func(fqn string, de fs.DirEntry) error {
+			if !de.IsDir() {
+				return nil
+			}
+			ct, err := cluster.NewCTFromFQN(fqn, nil)
+			if err != nil || cmn.DirHasOrIsPrefix(ct.ObjectName(), r.msg.Prefix) {
+				return nil
+			}
+			return filepath.SkipDir
+		}
+	}
+	err := fs.WalkBck(opts)
+	if err != nil && !cmn.IsErrAborted(err) {
+		glog.Errorf("%s: %v", r, err)
+	}
+	r.Finish(err)
+}
+
+func (r *XactBckSync) visit(fqn string, de fs.DirEntry) error {
+	if de.IsDir() {
+		return nil
+	}
+	select {
+	case errCause := <-r.ChanAbort():
+		return cmn.NewErrAborted(r.Name(), "", errCause)
+	default:
+	}
+	lom := cluster.AllocLOM("")
+	err := r.do(lom, fqn)
+	cluster.FreeLOM(lom)
+	return err
+}
+
+func (r *XactBckSync) do(lom *cluster.LOM, fqn string) error {
+	if err := lom.InitFQN(fqn, r.Bck().Bucket()); err != nil {
+		return nil
+	}
+	// skip copies and misplaced objects, if any
+	if !cmn.ObjHasPrefix(lom.ObjName, r.msg.Prefix) || !lom.IsHRW() {
+		return nil
+	}
+	if err := lom.Load(false /*cache it*/, false /*locked*/); err != nil {
+		return nil
+	}
+	if _, pending := lom.GetCustomKey(cmn.WriteBackObjMD); pending {
+		return nil
+	}
+	entry, ok, err := r.rit.find(lom.ObjName)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		if entry, err = r.head(lom); err != nil {
+			glog.Warningf("%s: failed to HEAD remote %s (skipping): %v", r, lom, err)
+			return nil
+		}
+	}
+	if finfo, err := os.Stat(lom.FQN); err != nil || finfo.ModTime().UnixNano() > r.started {
+		return nil
+	}
+
+	var deleted bool
+	switch {
+	case entry == nil:
+		deleted = true
+		r.ext.deleted.Inc()
+	case bsyncStale(lom.ObjAttrs(), entry):
+		r.ext.stale.Inc()
+	default:
+		return nil
+	}
+	r.addSample(lom.ObjName)
+	size := lom.SizeBytes()
+	if r.msg.DryRun {
+		r.ObjsAdd(1, size)
+		return nil
+	}
+
+	if r.msg.Refresh && !deleted {
+		if _, err := r.t.GetCold(context.Background(), lom, cmn.OwtGetTryLock); err != nil {
+			if err != cmn.ErrSkip {
+				glog.Errorf("%s: failed to refresh %s: %v", r, lom, err)
+			}
+			return nil
+		}
+		r.ext.refreshed.Inc()
+	} else {
+		if _, err := r.t.DeleteObject(lom, true /*evict*/); err != nil {
+			if !cmn.IsObjNotExist(err) {
+				glog.Errorf("%s: failed to evict %s: %v", r, lom, err)
+			}
+			return nil
+		}
+		r.ext.evicted.Inc()
+	}
+	if cmn.FastV(4, cos.SmoduleXs) {
+		glog.Infof("%s: %s (deleted remotely: %t)", r, lom, deleted)
+	}
+	r.ObjsAdd(1, size)
+	return nil
+}
+
+// remote HEAD, for objects that are out of (remote listing's) order
+func (r *XactBckSync) head(lom *cluster.LOM) (*cmn.LsoEntry, error) {
+	oa, errCode, err := r.t.Backend(lom.Bck()).HeadObj(context.Background(), lom)
+	if err != nil {
+		if errCode == http.StatusNotFound {
+			return nil, nil // deleted remotely
+		}
+		return nil, err
+	}
+	entry := &cmn.LsoEntry{Name: lom.ObjName, Size: oa.Size, Version: oa.Ver}
+	if v, ok := oa.GetCustomKey(cmn.ETag); ok {
+		entry.Checksum = v
+	} else if v, ok := oa.GetCustomKey(cmn.MD5ObjMD); ok {
+		entry.Checksum = v
+	}
+	return entry, nil
+}
+
+func (r *XactBckSync) addSample(name string) {
+	r.ext.mu.Lock()
+	if len(r.ext.sample) < bsyncMaxSample {
+		r.ext.sample = append(r.ext.sample, name)
+	}
+	r.ext.mu.Unlock()
+}
+
+func (r *XactBckSync) Snap() (snap *cluster.Snap) {
+	snap = &cluster.Snap{}
+	r.ToSnap(snap)
+
+	ext := &ExtBckSyncStats{
+		Stale:     r.ext.stale.Load(),
+		Deleted:   r.ext.deleted.Load(),
+		Refreshed: r.ext.refreshed.Load(),
+		Evicted:   r.ext.evicted.Load(),
+		DryRun:    r.msg.DryRun,
+	}
+	r.ext.mu.Lock()
+	ext.Sample = append([]string(nil), r.ext.sample...)
+	r.ext.mu.Unlock()
+	snap.Ext = ext
+	snap.IdleX = r.IsIdle()
+	return
+}
+
+// in-cluster object vs remote listing entry: same size and, if available, version and ETag (or MD5)
+func bsyncStale(oa *cmn.ObjAttrs, entry *cmn.LsoEntry) bool {
+	if oa.Size != entry.Size {
+		return true
+	}
+	if entry.Version != "" && oa.Ver != "" {
+		return entry.Version != oa.Ver
+	}
+	if entry.Checksum == "" {
+		return false
+	}
+	etag, _ := oa.GetCustomKey(cmn.ETag)
+	md5, _ := oa.GetCustomKey(cmn.MD5ObjMD)
+	if etag == "" && md5 == "" {
+		return false // nothing to compare with
+	}
+	return entry.Checksum != etag && entry.Checksum != md5
+}
+
+///////////////
+// bsyncIter //
+///////////////
+
+// find the remote entry with a given name, or nil if there's none;
+// returns ok = false when the name is out of order - less than the previously looked-up one,
+// so that the remote entry (if any) may have been already skipped
+func (rit *bsyncIter) find(name string) (_ *cmn.LsoEntry, ok bool, _ error) {
+	if name < rit.prev {
+		return nil, false, nil
+	}
+	rit.prev = name
+	for {
+		if rit.idx >= len(rit.lst.Entries) {
+			if rit.eof {
+				return nil, true, nil
+			}
+			if err := rit.next(); err != nil {
+				return nil, true, err
+			}
+			continue
+		}
+		entry := rit.lst.Entries[rit.idx]
+		switch {
+		case entry.Name < name:
+			rit.idx++
+		case entry.Name == name:
+			rit.idx++
+			return entry, true, nil
+		default:
+			return nil, true, nil // not present remotely
+		}
+	}
+}
+
+func (rit *bsyncIter) next() error {
+	rit.msg.ContinuationToken = rit.lst.ContinuationToken
+	rit.lst = cmn.LsoResult{} // (backends may reuse but not necessarily reset existing entries)
+	rit.idx = 0
+	if _, err := rit.list(&rit.msg, &rit.lst); err != nil {
+		return fmt.Errorf("remote listing failed: %w", err)
+	}
+	// a merge of two sorted sequences must never see remote objects out of order
+	for _, entry := range rit.lst.Entries {
+		if entry.Name <= rit.last {
+			return fmt.Errorf("remote listing is not sorted: %q after %q", entry.Name, rit.last)
+		}
+		rit.last = entry.Name
+	}
+	rit.eof = rit.lst.ContinuationToken == ""
+	return nil
+}
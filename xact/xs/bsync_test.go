@@ -0,0 +1,157 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cluster/mock"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestBckSyncIter(t *testing.T) {
+	var (
+		pages = [][]string{{"a", "b", "d"}, {"e", "g"}, {"h"}}
+		calls int
+	)
+	rit := &bsyncIter{
+		list: func(msg *apc.LsoMsg, lst *cmn.LsoResult) (int, error) {
+			if calls > 0 {
+				tassert.Fatalf(t, msg.ContinuationToken == strconv.Itoa(calls), "unexpected token %q", msg.ContinuationToken)
+			}
+			tassert.Fatalf(t, calls < len(pages), "listing past the last page")
+			for _, name := range pages[calls] {
+				lst.Entries = append(lst.Entries, &cmn.LsoEntry{Name: name})
+			}
+			if calls++; calls < len(pages) {
+				lst.ContinuationToken = strconv.Itoa(calls)
+			}
+			return 0, nil
+		},
+	}
+
+	// local names: present remotely or not
+	for _, tc := range []struct {
+		name  string
+		found bool
+	}{
+		{"a", true}, {"c", false}, {"d", true}, {"f", false}, {"h", true}, {"i", false}, {"j", false},
+	} {
+		entry, ok, err := rit.find(tc.name)
+		tassert.CheckFatal(t, err)
+		tassert.Fatalf(t, ok, "%q: unexpected out of order", tc.name)
+		tassert.Errorf(t, (entry != nil) == tc.found, "%q: expected found=%t", tc.name, tc.found)
+		if entry != nil {
+			tassert.Errorf(t, entry.Name == tc.name, "%q: got %q", tc.name, entry.Name)
+		}
+	}
+	tassert.Errorf(t, calls == len(pages), "expected %d pages, got %d", len(pages), calls)
+
+	// out of order
+	rit = &bsyncIter{
+		list: func(_ *apc.LsoMsg, lst *cmn.LsoResult) (int, error) {
+			lst.Entries = []*cmn.LsoEntry{{Name: "b"}, {Name: "a"}}
+			return 0, nil
+		},
+	}
+	_, _, err := rit.find("a")
+	tassert.Fatalf(t, err != nil, "expected unsorted listing error")
+}
+
+type bsyncBackend struct {
+	cluster.BackendProvider
+	remote map[string]*cmn.ObjAttrs
+	heads  []string
+}
+
+func (*bsyncBackend) Provider() string { return apc.AWS }
+
+func (b *bsyncBackend) ListObjects(_ *meta.Bck, _ *apc.LsoMsg, lst *cmn.LsoResult) (int, error) {
+	names := make([]string, 0, len(b.remote))
+	for name := range b.remote {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		lst.Entries = append(lst.Entries, &cmn.LsoEntry{Name: name, Size: b.remote[name].Size})
+	}
+	return 0, nil
+}
+
+func (b *bsyncBackend) HeadObj(_ context.Context, lom *cluster.LOM) (*cmn.ObjAttrs, int, error) {
+	b.heads = append(b.heads, lom.ObjName)
+	oa, ok := b.remote[lom.ObjName]
+	if !ok {
+		return nil, http.StatusNotFound, cos.NewErrNotFound("%s", lom)
+	}
+	return oa, 0, nil
+}
+
+// depth-first walk yields "a/b" before "a-c", while the remote listing has "a-c" first
+func TestBckSyncOrder(t *testing.T) {
+	var (
+		mpath   = t.TempDir()
+		bck     = meta.NewBck("bsync", apc.AWS, cmn.NsGlobal, &cmn.BucketProps{BID: 0xb1b2b3b4})
+		backend = &bsyncBackend{remote: map[string]*cmn.ObjAttrs{"a-c": {Size: 3}, "a/b": {Size: 3}}}
+		tm      = &wbTarget{mock.NewTarget(mock.NewBaseBownerMock(bck)), backend}
+	)
+	config := cmn.GCO.BeginUpdate()
+	config.TestFSP.Count = 1
+	cmn.GCO.CommitUpdate(config)
+	fs.TestNew(nil)
+	fs.TestDisableValidation()
+	_, err := fs.Add(mpath, "daeID")
+	tassert.CheckFatal(t, err)
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{})
+
+	for _, name := range []string{"a-c", "a/b", "a/d"} {
+		lom := cluster.AllocLOM(name)
+		tassert.CheckFatal(t, lom.InitBck(bck.Bucket()))
+		_, err = cos.SaveReader(lom.FQN, bytes.NewReader([]byte("abc")), nil, cos.ChecksumNone, -1)
+		tassert.CheckFatal(t, err)
+		lom.SetSize(3)
+		lom.SetAtimeUnix(time.Now().UnixNano())
+		tassert.CheckFatal(t, lom.Persist())
+		cluster.FreeLOM(lom)
+	}
+
+	r := newXactBckSync(tm, cos.GenUUID(), bck, &apc.SyncBckMsg{DryRun: true})
+	r.Run(nil)
+	tassert.CheckFatal(t, r.AbortErr())
+	snap := r.Snap()
+	ext := snap.Ext.(*ExtBckSyncStats)
+	tassert.Errorf(t, ext.Deleted == 1 && ext.Stale == 0, "expected a/d (and only a/d) deleted remotely, got %+v", ext)
+	tassert.Errorf(t, len(ext.Sample) == 1 && ext.Sample[0] == "a/d", "expected [a/d], got %v", ext.Sample)
+	tassert.Errorf(t, len(backend.heads) == 1 && backend.heads[0] == "a-c", "expected HEAD(a-c), got %v", backend.heads)
+}
+
+func TestBckSyncStale(t *testing.T) {
+	oa := &cmn.ObjAttrs{Size: 10, Ver: "v1"}
+	oa.SetCustomKey(cmn.ETag, "etag1")
+	tassert.Errorf(t, !bsyncStale(oa, &cmn.LsoEntry{Size: 10, Version: "v1", Checksum: "xyz"}), "same version")
+	tassert.Errorf(t, bsyncStale(oa, &cmn.LsoEntry{Size: 10, Version: "v2"}), "different version")
+	tassert.Errorf(t, bsyncStale(oa, &cmn.LsoEntry{Size: 11, Version: "v1"}), "different size")
+	tassert.Errorf(t, !bsyncStale(oa, &cmn.LsoEntry{Size: 10, Checksum: "etag1"}), "same etag")
+	tassert.Errorf(t, bsyncStale(oa, &cmn.LsoEntry{Size: 10, Checksum: "etag2"}), "different etag")
+
+	oa = &cmn.ObjAttrs{Size: 10}
+	oa.SetCustomKey(cmn.MD5ObjMD, "md5")
+	tassert.Errorf(t, !bsyncStale(oa, &cmn.LsoEntry{Size: 10, Checksum: "md5"}), "same md5")
+	tassert.Errorf(t, bsyncStale(oa, &cmn.LsoEntry{Size: 10, Checksum: "etag"}), "different md5")
+	tassert.Errorf(t, !bsyncStale(&cmn.ObjAttrs{Size: 10}, &cmn.LsoEntry{Size: 10, Checksum: "md5"}), "nothing to compare")
+}
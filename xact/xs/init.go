@@ -16,6 +16,7 @@ func Xreg() {
 	xreg.RegNonBckXact(&resFactory{})
 	xreg.RegNonBckXact(&rebFactory{})
 	xreg.RegNonBckXact(&etlFactory{})
+	xreg.RegNonBckXact(&scrubFactory{})
 
 	xreg.RegBckXact(&bmvFactory{})
 	xreg.RegBckXact(&evdFactory{kind: apc.ActEvictObjects})
@@ -26,6 +27,10 @@ func Xreg() {
 
 	xreg.RegBckXact(&proFactory{})
 	xreg.RegBckXact(&llcFactory{})
+	xreg.RegBckXact(&lcyFactory{})
+	xreg.RegBckXact(&bsyncFactory{})
+	xreg.RegBckXact(&wbFactory{})
+	xreg.RegBckXact(&replFactory{})
 
 	xreg.RegBckXact(&tcoFactory{streamingF: streamingF{kind: apc.ActETLObjects}})
 	xreg.RegBckXact(&tcoFactory{streamingF: streamingF{kind: apc.ActCopyObjects}})
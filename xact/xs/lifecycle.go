@@ -0,0 +1,125 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"os"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Walks a given bucket and removes objects that have expired as per the bucket's
+// lifecycle rules (see cmn.LifecycleConf). Objects of remote buckets get evicted
+// rather than deleted.
+
+type (
+	lcyFactory struct {
+		xreg.RenewBase
+		xctn *xactLcy
+	}
+	xactLcy struct {
+		conf  cmn.LifecycleConf
+		evict bool
+		xact.BckJog
+	}
+)
+
+// interface guard
+var (
+	_ cluster.Xact   = (*xactLcy)(nil)
+	_ xreg.Renewable = (*lcyFactory)(nil)
+)
+
+////////////////
+// lcyFactory //
+////////////////
+
+func (*lcyFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	p := &lcyFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+	return p
+}
+
+func (p *lcyFactory) Start() error {
+	xctn := newXactLcy(p.T, p.UUID(), p.Bck)
+	p.xctn = xctn
+	return nil
+}
+
+func (*lcyFactory) Kind() string        { return apc.ActLifecycle }
+func (p *lcyFactory) Get() cluster.Xact { return p.xctn }
+
+func (*lcyFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) { return xreg.WprUse, nil }
+
+/////////////
+// xactLcy //
+/////////////
+
+func newXactLcy(t cluster.Target, uuid string, bck *meta.Bck) (r *xactLcy) {
+	r = &xactLcy{conf: bck.Props.Lifecycle, evict: bck.IsRemote()}
+	mpopts := &mpather.JgroupOpts{
+		T:        t,
+		CTs:      []string{fs.ObjectType},
+		VisitObj: r.visitObj,
+		DoLoad:   mpather.Load,
+		Throttle: true,
+	}
+	mpopts.Bck.Copy(bck.Bucket())
+	r.BckJog.Init(uuid, apc.ActLifecycle, bck, mpopts, cmn.GCO.Get())
+	return
+}
+
+func (r *xactLcy) Run(*sync.WaitGroup) {
+	r.BckJog.Run()
+	glog.Infoln(r.Name())
+	err := r.BckJog.Wait()
+	r.Finish(err)
+}
+
+func (r *xactLcy) visitObj(lom *cluster.LOM, _ []byte) error {
+	finfo, err := os.Stat(lom.FQN)
+	if err != nil {
+		return nil // removed in the meantime
+	}
+	now := time.Now()
+	rule := r.conf.Expired(lom.ObjName, lom.GetCustomMD(), finfo.ModTime(), now)
+	if rule == nil {
+		return nil
+	}
+	if lom.Bprops().ObjLock.Check(lom.Cname(), lom, false /*bypass*/, now) != nil {
+		return nil // under retention (object lock)
+	}
+	size := lom.SizeBytes()
+	if _, err := r.T.DeleteObject(lom, r.evict); err != nil {
+		if !cmn.IsObjNotExist(err) {
+			glog.Errorf("%s: failed to remove expired %s: %v", r, lom, err)
+		}
+		return nil
+	}
+	if cmn.FastV(4, cos.SmoduleXs) {
+		glog.Infof("%s: %s expired (rule %q)", r, lom, rule.ID)
+	}
+	r.ObjsAdd(1, size)
+	return nil
+}
+
+func (r *xactLcy) Snap() (snap *cluster.Snap) {
+	snap = &cluster.Snap{}
+	r.ToSnap(snap)
+
+	snap.IdleX = r.IsIdle()
+	return
+}
@@ -0,0 +1,762 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/OneOfOne/xxhash"
+)
+
+// Replication: PUTs and DELETEs in a bucket with `replication.dst` (see cmn.ReplicationConf)
+// get recorded in the bucket's change log, to be then shipped to the destination bucket
+// in the attached remote AIS cluster:
+// - the change log is per target: one append-only file per (bucket, mountpath) under
+//   fname.ReplDir, each with its persistent checkpoint - the offset of the first record
+//   that is yet to be shipped; both survive restarts;
+// - records are shipped in batches by the bucket's on-demand xaction (apc.ActReplicate);
+//   multiple records of the same object coalesce: the object gets PUT if it exists
+//   at the time of shipping, and deleted otherwise;
+// - the checkpoint advances only when the entire batch succeeds; otherwise, the batch
+//   is retried with exponential backoff (which is why shipping must be idempotent);
+// - fully shipped logs get truncated;
+// - the lag (number of records yet to be shipped and the age of the oldest one) is
+//   reported via xaction snapshot - see ExtReplStats;
+// - change logs not owned by any running xaction (e.g., upon restart) get periodically
+//   claimed - see ReplOrphans.
+
+const (
+	ReplPut = 'P'
+	ReplDel = 'D'
+)
+
+const (
+	replBatch      = 256 // records
+	replWorkers    = 8   // concurrent PUTs and DELETEs (per batch)
+	replBackoffMin = time.Second
+	replBackoffMax = 5 * time.Minute
+	replIval       = time.Second
+	replCkptSuffix = ".ckpt"
+)
+
+type (
+	// remote AIS cluster (see backend.AISBackendProvider)
+	ReplRemote interface {
+		PutObjRemote(remoteBck *cmn.Bck, lom *cluster.LOM, r cos.ReadOpenCloser) (errCode int, err error)
+		DeleteObjRemote(remoteBck *cmn.Bck, objName string) (errCode int, err error)
+	}
+
+	replFactory struct {
+		xreg.RenewBase
+		xctn   *XactReplicate
+		remote ReplRemote
+	}
+	XactReplicate struct {
+		t       cluster.Target
+		remote  ReplRemote
+		workCh  chan struct{} // (new records)
+		lastErr string
+		xact.DemandBase
+		next     int64 // mono time of the next attempt (upon failure)
+		mu       sync.Mutex
+		deleted  atomic.Int64
+		attempts int
+		stopped  bool // under rlogs.mu
+	}
+	// extended x-replicate statistics
+	ExtReplStats struct {
+		Dst     string       `json:"dst"`
+		Err     string       `json:"err,omitempty"`  // last error (and the reason for the lag to grow)
+		Pending int64        `json:"pending,string"` // change log records yet to be shipped
+		Lag     cos.Duration `json:"lag"`            // age of the oldest record yet to be shipped
+		Deleted int64        `json:"deleted,string"` // objects deleted in the destination bucket
+	}
+
+	// change log of a given bucket on a given mountpath
+	replLog struct {
+		owner  *XactReplicate // nil when orphaned
+		fh     *os.File       // (append)
+		path   string         // log file (and path + replCkptSuffix)
+		ckpt   replCkpt       // under rlogs.mu
+		n      int64          // ditto: number of records past checkpoint
+		oldest int64          // ditto: ctime of the first record past checkpoint
+		size   int64          // under mu
+		mu     sync.Mutex
+	}
+	replCkpt struct {
+		Bck cmn.Bck `json:"bck"`
+		Off int64   `json:"off,string"`
+	}
+	replRec struct {
+		name  string
+		ctime int64
+		off   int64 // end offset
+		op    byte
+	}
+)
+
+// interface guard
+var (
+	_ xact.Demand    = (*XactReplicate)(nil)
+	_ xreg.Renewable = (*replFactory)(nil)
+	_ jsp.Opts       = (*replCkpt)(nil)
+)
+
+// all change logs by path
+var rlogs struct {
+	m  map[string]*replLog
+	mu sync.Mutex
+}
+
+/////////////////
+// replFactory //
+/////////////////
+
+func (*replFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	p := &replFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+	p.remote, _ = args.Custom.(ReplRemote)
+	return p
+}
+
+func (p *replFactory) Start() error {
+	debug.Assert(p.remote != nil)
+	r := &XactReplicate{t: p.T, remote: p.remote, workCh: make(chan struct{}, 1)}
+	r.DemandBase.Init(cos.GenUUID(), apc.ActReplicate, p.Bck, 0 /*use default*/)
+	p.xctn = r
+	go r.Run(nil)
+	return nil
+}
+
+func (*replFactory) Kind() string        { return apc.ActReplicate }
+func (p *replFactory) Get() cluster.Xact { return p.xctn }
+
+func (p *replFactory) WhenPrevIsRunning(xprev xreg.Renewable) (xreg.WPR, error) {
+	debug.Assertf(false, "%s vs %s", p.Str(p.Kind()), xprev) // xreg.usePrev() must've returned true
+	return xreg.WprUse, nil
+}
+
+///////////////////
+// XactReplicate //
+///////////////////
+
+func (r *XactReplicate) Run(*sync.WaitGroup) {
+	glog.Infoln(r.Name())
+	ticker := time.NewTicker(replIval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.workCh:
+			r.ship()
+		case <-ticker.C:
+			if r.Pending() > 0 {
+				r.ship()
+			}
+		case <-r.IdleTimer():
+			r.stop()
+			r.Finish(nil)
+			return
+		case errCause := <-r.ChanAbort():
+			n := r.stop()
+			glog.Infof("%s aborted (cause %v): %d record%s remain to be shipped", r, errCause, n, cos.Plural(n))
+			r.Finish(cmn.NewErrAborted(r.Name(), "", errCause))
+			return
+		}
+	}
+}
+
+// Record PUT or DELETE of a given object in the change log
+func (r *XactReplicate) Add(lom *cluster.LOM, op byte) error {
+	var (
+		bck   = lom.Bucket()
+		path  = replPath(lom.Mountpath().Path, bck)
+		ctime = time.Now().UnixNano()
+	)
+	rlogs.mu.Lock()
+	rl, ok := rlogs.m[path]
+	if !ok {
+		rl = &replLog{path: path, ckpt: replCkpt{Bck: cmn.Bck{Name: bck.Name, Provider: bck.Provider, Ns: bck.Ns}}}
+		rlogs.m[path] = rl
+	}
+	rlogs.mu.Unlock()
+
+	if err := rl.append(op, ctime, lom.ObjName); err != nil {
+		return cmn.NewErrFailedTo(r, "log", lom.Cname(), err)
+	}
+
+	var cnt int64
+	rlogs.mu.Lock()
+	rl.n++
+	if rl.oldest == 0 {
+		rl.oldest = ctime
+	}
+	switch {
+	case rl.owner == r:
+		cnt = 1
+	case rl.owner == nil && !r.stopped:
+		rl.owner, cnt = r, rl.n
+	} // otherwise, orphaned
+	rlogs.mu.Unlock()
+
+	if cnt > 0 {
+		for i := int64(0); i < cnt; i++ {
+			r.IncPending()
+		}
+		select {
+		case r.workCh <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// claim orphaned change logs of this xaction's bucket
+func (r *XactReplicate) Claim() (n int) {
+	rlogs.mu.Lock()
+	if !r.stopped {
+		for _, rl := range rlogs.m {
+			if rl.owner == nil && rl.n > 0 && rl.ckpt.Bck.Equal(r.Bck().Bucket()) {
+				rl.owner = r
+				n += int(rl.n)
+			}
+		}
+	}
+	rlogs.mu.Unlock()
+	for i := 0; i < n; i++ {
+		r.IncPending()
+	}
+	return
+}
+
+func (r *XactReplicate) owned() (rls []*replLog) {
+	rlogs.mu.Lock()
+	for _, rl := range rlogs.m {
+		if rl.owner == r && rl.n > 0 {
+			rls = append(rls, rl)
+		}
+	}
+	rlogs.mu.Unlock()
+	return
+}
+
+// ship all owned change logs (until caught up, or failed, or aborted)
+func (r *XactReplicate) ship() {
+	if r.next != 0 && mono.NanoTime() < r.next {
+		return
+	}
+	props, present := r.t.Bowner().Get().Get(r.Bck())
+	if !present || !props.Replication.Enabled() {
+		// (bucket destroyed or replication disabled in the meantime)
+		r.discard()
+		return
+	}
+	dst, err := props.Replication.DstBck()
+	if err != nil {
+		r.failed(err)
+		return
+	}
+	for _, rl := range r.owned() {
+		for !r.IsAborted() {
+			done, err := r.batch(rl, &dst)
+			if err != nil {
+				r.failed(err)
+				return
+			}
+			if done {
+				break
+			}
+		}
+	}
+	if r.attempts > 0 {
+		glog.Infof("%s: resumed shipping to %s after %d failed attempt%s", r, dst.Cname(""), r.attempts, cos.Plural(r.attempts))
+	}
+	r.mu.Lock()
+	r.attempts, r.next, r.lastErr = 0, 0, ""
+	r.mu.Unlock()
+}
+
+func (r *XactReplicate) failed(err error) {
+	r.mu.Lock()
+	r.attempts++
+	r.lastErr = err.Error()
+	backoff := replBackoffMax
+	if r.attempts <= 10 {
+		backoff = cos.MinDuration(replBackoffMin<<(r.attempts-1), replBackoffMax)
+	}
+	r.next = mono.NanoTime() + int64(backoff)
+	r.mu.Unlock()
+	glog.Errorf("%s: failed to replicate (attempt %d, retrying in %v): %v", r, r.attempts, backoff, err)
+}
+
+// ship the next batch of records of a given change log
+func (r *XactReplicate) batch(rl *replLog, dst *cmn.Bck) (done bool, err error) {
+	rl.mu.Lock()
+	size := rl.size
+	rl.mu.Unlock()
+	rlogs.mu.Lock()
+	off := rl.ckpt.Off
+	rlogs.mu.Unlock()
+	if off >= size {
+		return true, nil
+	}
+
+	recs, err := rl.read(off, size, replBatch+1)
+	if err != nil {
+		return false, err
+	}
+	var next int64 // ctime of the first record past the batch
+	if len(recs) > replBatch {
+		next = recs[replBatch].ctime
+		recs = recs[:replBatch]
+	}
+
+	// coalesce and ship
+	var (
+		names = make([]string, 0, len(recs))
+		seen  = make(map[string]struct{}, len(recs))
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		cnt   int
+		work  = make(chan string, len(recs))
+	)
+	for _, rec := range recs {
+		if _, ok := seen[rec.name]; !ok {
+			seen[rec.name] = struct{}{}
+			names = append(names, rec.name)
+		}
+	}
+	for _, name := range names {
+		work <- name
+	}
+	close(work)
+	for i := 0; i < cos.Min(replWorkers, len(names)); i++ {
+		wg.Add(1)
+		go func() {
+			for name := range work {
+				if errV := r.do(dst, name); errV != nil {
+					mu.Lock()
+					if cnt++; err == nil {
+						err = errV
+					}
+					mu.Unlock()
+				}
+			}
+			wg.Done()
+		}()
+	}
+	wg.Wait()
+	if err != nil {
+		return false, fmt.Errorf("failed to ship %d (out of %d) object%s to %s: %v", cnt, len(names),
+			cos.Plural(len(names)), dst.Cname(""), err)
+	}
+
+	// advance checkpoint
+	k := len(recs)
+	off = recs[k-1].off
+	rlogs.mu.Lock()
+	rl.ckpt.Off = off
+	rl.n -= int64(k)
+	debug.Assert(rl.n >= 0)
+	rl.oldest = next
+	if rl.n > 0 && next == 0 {
+		rl.oldest = time.Now().UnixNano() // (appended while shipping)
+	}
+	ckpt := rl.ckpt
+	rlogs.mu.Unlock()
+	r.SubPending(k)
+
+	if off == size {
+		if err := rl.truncate(off); err != nil {
+			glog.Errorf("%s: failed to truncate change log %q: %v", r, rl.path, err)
+		}
+		return true, nil
+	}
+	if err := ckpt.persist(rl.path); err != nil {
+		glog.Errorf("%s: failed to checkpoint change log %q: %v", r, rl.path, err)
+	}
+	return false, nil
+}
+
+// replicate the current state of a given object: PUT if exists, DELETE otherwise
+func (r *XactReplicate) do(dst *cmn.Bck, name string) error {
+	lom := cluster.AllocLOM(name)
+	defer cluster.FreeLOM(lom)
+	if err := lom.InitBck(r.Bck().Bucket()); err != nil {
+		return err
+	}
+	lom.Lock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		lom.Unlock(false)
+		if !cmn.IsObjNotExist(err) {
+			return err
+		}
+		errCode, err := r.remote.DeleteObjRemote(dst, name)
+		if err != nil && errCode != http.StatusNotFound {
+			return err
+		}
+		if err == nil {
+			r.deleted.Inc()
+		}
+		return nil
+	}
+	fh, err := cos.NewFileHandle(lom.FQN)
+	if err != nil {
+		lom.Unlock(false)
+		return err
+	}
+	size := lom.SizeBytes()
+	_, err = r.remote.PutObjRemote(dst, lom, fh) // (closes fh)
+	lom.Unlock(false)
+	if err == nil {
+		r.OutObjsAdd(1, size)
+	}
+	return err
+}
+
+// discard all change logs of this xaction's bucket
+func (r *XactReplicate) discard() {
+	if n := ReplDiscard(r.Bck().Bucket()); n > 0 {
+		glog.Warningf("%s: replication disabled - discarded %d record%s", r, n, cos.Plural(n))
+	}
+}
+
+// disown change logs (that remain on disk)
+func (r *XactReplicate) stop() (n int) {
+	r.DemandBase.Stop()
+	rlogs.mu.Lock()
+	r.stopped = true
+	for _, rl := range rlogs.m {
+		if rl.owner == r {
+			rl.owner = nil
+			n += int(rl.n)
+		}
+	}
+	rlogs.mu.Unlock()
+	if pending := int(r.Pending()); pending > 0 {
+		r.SubPending(pending)
+	}
+	return
+}
+
+func (r *XactReplicate) Snap() (snap *cluster.Snap) {
+	snap = &cluster.Snap{}
+	r.ToSnap(snap)
+
+	ext := &ExtReplStats{Deleted: r.deleted.Load()}
+	if props, present := r.t.Bowner().Get().Get(r.Bck()); present {
+		ext.Dst = props.Replication.Dst
+	}
+	var oldest int64
+	rlogs.mu.Lock()
+	for _, rl := range rlogs.m {
+		if rl.owner == r {
+			ext.Pending += rl.n
+			if rl.oldest != 0 && (oldest == 0 || rl.oldest < oldest) {
+				oldest = rl.oldest
+			}
+		}
+	}
+	rlogs.mu.Unlock()
+	if oldest != 0 {
+		ext.Lag = cos.Duration(time.Since(time.Unix(0, oldest)))
+	}
+	r.mu.Lock()
+	ext.Err = r.lastErr
+	r.mu.Unlock()
+
+	snap.Ext = ext
+	snap.IdleX = r.IsIdle()
+	return
+}
+
+/////////////
+// replLog //
+/////////////
+
+// record format: "<op> <ctime> <quoted object name>\n"
+func (rl *replLog) append(op byte, ctime int64, objName string) (err error) {
+	b := make([]byte, 0, len(objName)+32)
+	b = append(b, op, ' ')
+	b = strconv.AppendInt(b, ctime, 10)
+	b = append(b, ' ')
+	b = strconv.AppendQuote(b, objName)
+	b = append(b, '\n')
+
+	rl.mu.Lock()
+	if rl.fh == nil {
+		if rl.size == 0 {
+			// (new log: checkpoint first)
+			rlogs.mu.Lock()
+			ckpt := rl.ckpt
+			rlogs.mu.Unlock()
+			if err = ckpt.persist(rl.path); err != nil {
+				rl.mu.Unlock()
+				return
+			}
+		}
+		if rl.fh, err = os.OpenFile(rl.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, cos.PermRWR); err != nil {
+			rl.mu.Unlock()
+			return
+		}
+	}
+	if _, err = rl.fh.Write(b); err == nil {
+		rl.size += int64(len(b))
+	}
+	rl.mu.Unlock()
+	return
+}
+
+func (rl *replLog) read(off, size int64, max int) (recs []replRec, err error) {
+	fh, err := os.Open(rl.path)
+	if err != nil {
+		return nil, err
+	}
+	defer fh.Close()
+	if _, err = fh.Seek(off, io.SeekStart); err != nil {
+		return nil, err
+	}
+	br := bufio.NewReader(io.LimitReader(fh, size-off))
+	for len(recs) < max {
+		line, err := br.ReadString('\n')
+		if err != nil {
+			if err == io.EOF {
+				err = nil // (not expecting partial records below `size`)
+			}
+			return recs, err
+		}
+		off += int64(len(line))
+		rec, err := parseReplRec(line)
+		if err != nil {
+			return recs, fmt.Errorf("change log %q at offset %d: %v", rl.path, off-int64(len(line)), err)
+		}
+		rec.off = off
+		recs = append(recs, rec)
+	}
+	return recs, nil
+}
+
+func parseReplRec(line string) (rec replRec, err error) {
+	parts := strings.SplitN(strings.TrimSuffix(line, "\n"), " ", 3)
+	if len(parts) != 3 || len(parts[0]) != 1 || (parts[0][0] != ReplPut && parts[0][0] != ReplDel) {
+		return rec, fmt.Errorf("invalid record %q", line)
+	}
+	rec.op = parts[0][0]
+	if rec.ctime, err = strconv.ParseInt(parts[1], 10, 64); err != nil {
+		return rec, fmt.Errorf("invalid record %q: %v", line, err)
+	}
+	if rec.name, err = strconv.Unquote(parts[2]); err != nil {
+		return rec, fmt.Errorf("invalid record %q: %v", line, err)
+	}
+	return rec, nil
+}
+
+// truncate fully shipped log (unless appended in the meantime)
+func (rl *replLog) truncate(off int64) (err error) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	rlogs.mu.Lock()
+	if rl.size != off || rl.ckpt.Off != off {
+		ckpt := rl.ckpt
+		rlogs.mu.Unlock()
+		return ckpt.persist(rl.path)
+	}
+	rl.ckpt.Off = 0
+	ckpt := rl.ckpt
+	rlogs.mu.Unlock()
+
+	// checkpoint first, so that a crash in-between would only result in re-shipping
+	if err = ckpt.persist(rl.path); err != nil {
+		return
+	}
+	if rl.fh != nil {
+		err = rl.fh.Truncate(0)
+	} else {
+		err = os.Truncate(rl.path, 0)
+	}
+	if err == nil {
+		rl.size = 0
+	}
+	return
+}
+
+func (rl *replLog) remove() {
+	rl.mu.Lock()
+	if rl.fh != nil {
+		cos.Close(rl.fh)
+		rl.fh = nil
+	}
+	rl.size = 0
+	rl.mu.Unlock()
+	for _, path := range []string{rl.path, rl.path + replCkptSuffix} {
+		if err := cos.RemoveFile(path); err != nil {
+			glog.Errorf("failed to remove %q: %v", path, err)
+		}
+	}
+}
+
+//////////////
+// replCkpt //
+//////////////
+
+var replJspOpts = jsp.CksumSign(cmn.MetaverRepl)
+
+func (*replCkpt) JspOpts() jsp.Options { return replJspOpts }
+
+func (ckpt *replCkpt) persist(path string) error {
+	return jsp.SaveMeta(path+replCkptSuffix, ckpt, nil /*wto*/)
+}
+
+func replPath(mpath string, bck *cmn.Bck) string {
+	uname := bck.MakeUname("")
+	h := xxhash.Checksum64S(cos.UnsafeB(uname), cos.MLCG32)
+	return filepath.Join(mpath, fname.ReplDir, strconv.FormatUint(h, 16))
+}
+
+//
+// change logs: startup and orphans
+//
+
+// load change logs from all available mountpaths (the logs remain orphaned
+// until claimed - see ReplOrphans)
+func ReplInit() {
+	var total int
+	rlogs.m = make(map[string]*replLog, 4)
+	for mpath := range fs.GetAvail() {
+		dir := filepath.Join(mpath, fname.ReplDir)
+		dentries, err := os.ReadDir(dir)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				glog.Errorf("failed to read %q: %v", dir, err)
+			}
+			continue
+		}
+		for _, dent := range dentries {
+			if dent.IsDir() || !strings.HasSuffix(dent.Name(), replCkptSuffix) {
+				continue
+			}
+			rl := &replLog{path: filepath.Join(dir, strings.TrimSuffix(dent.Name(), replCkptSuffix))}
+			if err := rl.load(); err != nil {
+				glog.Errorf("failed to load replication change log %q (removing): %v", rl.path, err)
+				rl.remove()
+				continue
+			}
+			rlogs.m[rl.path] = rl
+			total += int(rl.n)
+		}
+	}
+	if total > 0 {
+		glog.Infof("loaded %d replication change log record%s yet to be shipped", total, cos.Plural(total))
+	}
+}
+
+func (rl *replLog) load() error {
+	if _, err := jsp.LoadMeta(rl.path+replCkptSuffix, &rl.ckpt); err != nil {
+		return err
+	}
+	finfo, err := os.Stat(rl.path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return err
+		}
+		finfo = nil
+	}
+	if finfo != nil {
+		rl.size = finfo.Size()
+	}
+	if rl.ckpt.Off > rl.size {
+		return fmt.Errorf("checkpoint %d is past the end of the log (%d)", rl.ckpt.Off, rl.size)
+	}
+	// count (and validate) records past checkpoint; drop partially written last record, if any
+	off := rl.ckpt.Off
+	for off < rl.size {
+		recs, err := rl.read(off, rl.size, replBatch)
+		if err != nil {
+			return err
+		}
+		if len(recs) == 0 {
+			break
+		}
+		if rl.oldest == 0 {
+			rl.oldest = recs[0].ctime
+		}
+		rl.n += int64(len(recs))
+		off = recs[len(recs)-1].off
+	}
+	if off < rl.size {
+		glog.Warningf("change log %q: truncating partially written record at offset %d", rl.path, off)
+		if err := os.Truncate(rl.path, off); err != nil {
+			return err
+		}
+		rl.size = off
+	}
+	return nil
+}
+
+// returns buckets that have change log records not owned by any running xaction
+func ReplOrphans() (bcks []cmn.Bck) {
+	rlogs.mu.Lock()
+	for _, rl := range rlogs.m {
+		if rl.owner != nil || rl.n == 0 {
+			continue
+		}
+		var found bool
+		for i := range bcks {
+			if bcks[i].Equal(&rl.ckpt.Bck) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			bcks = append(bcks, rl.ckpt.Bck)
+		}
+	}
+	rlogs.mu.Unlock()
+	return
+}
+
+// discard change logs of a given bucket (e.g., when the bucket no longer exists
+// or is not replicated anymore); returns the number of discarded records
+func ReplDiscard(bck *cmn.Bck) (n int) {
+	var rls []*replLog
+	rlogs.mu.Lock()
+	for path, rl := range rlogs.m {
+		if rl.ckpt.Bck.Equal(bck) {
+			if owner := rl.owner; owner != nil && rl.n > 0 {
+				owner.SubPending(int(rl.n))
+			}
+			n += int(rl.n)
+			delete(rlogs.m, path)
+			rls = append(rls, rl)
+		}
+	}
+	rlogs.mu.Unlock()
+	for _, rl := range rls {
+		rl.remove()
+	}
+	return
+}
@@ -0,0 +1,88 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestReplChangeLog(t *testing.T) {
+	var (
+		mpath = t.TempDir()
+		bck1  = cmn.Bck{Name: "b1", Provider: apc.AIS}
+		bck2  = cmn.Bck{Name: "b2", Provider: apc.AIS}
+		names = []string{"a", "dir/b c", "quote\"and\nnewline", "a"}
+	)
+	config := cmn.GCO.BeginUpdate()
+	config.TestFSP.Count = 1
+	cmn.GCO.CommitUpdate(config)
+	fs.TestNew(nil)
+	fs.TestDisableValidation()
+	_, err := fs.Add(mpath, "daeID")
+	tassert.CheckFatal(t, err)
+
+	now := time.Now().UnixNano()
+	rl1 := &replLog{path: replPath(mpath, &bck1), ckpt: replCkpt{Bck: bck1}}
+	for i, name := range names {
+		op := byte(ReplPut)
+		if i == 2 {
+			op = ReplDel
+		}
+		tassert.CheckFatal(t, rl1.append(op, now+int64(i), name))
+	}
+	rl2 := &replLog{path: replPath(mpath, &bck2), ckpt: replCkpt{Bck: bck2}}
+	tassert.CheckFatal(t, rl2.append(ReplPut, now, "x"))
+	rl1.fh.Close()
+	rl2.fh.Close()
+	// partially written record
+	fh, err := os.OpenFile(rl1.path, os.O_APPEND|os.O_WRONLY, 0o644)
+	tassert.CheckFatal(t, err)
+	_, err = fh.WriteString("P 123 \"partial")
+	fh.Close()
+	tassert.CheckFatal(t, err)
+
+	// restart
+	ReplInit()
+	tassert.Fatalf(t, len(rlogs.m) == 2, "expected 2 change logs, got %d", len(rlogs.m))
+	rl := rlogs.m[rl1.path]
+	tassert.Fatalf(t, rl != nil && rl.n == int64(len(names)) && rl.oldest == now,
+		"expected %d records since %d, got %+v", len(names), now, rl)
+	recs, err := rl.read(0, rl.size, 100)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, len(recs) == len(names), "expected %d records, got %d", len(names), len(recs))
+	for i, rec := range recs {
+		tassert.Errorf(t, rec.name == names[i] && rec.ctime == now+int64(i), "record %d: unexpected %+v", i, rec)
+	}
+	tassert.Errorf(t, recs[2].op == ReplDel && recs[3].off == rl.size, "unexpected records %+v", recs)
+
+	// checkpoint past the 2nd record, and restart
+	rl.ckpt.Off = recs[1].off
+	tassert.CheckFatal(t, rl.truncate(recs[1].off)) // (not fully shipped - checkpoint only)
+	ReplInit()
+	rl = rlogs.m[rl1.path]
+	tassert.Fatalf(t, rl.n == 2 && rl.oldest == now+2, "expected 2 records since %d, got %+v", now+2, rl)
+
+	// fully shipped
+	rl.ckpt.Off = rl.size
+	tassert.CheckFatal(t, rl.truncate(rl.size))
+	ReplInit()
+	rl = rlogs.m[rl1.path]
+	tassert.Fatalf(t, rl.n == 0 && rl.size == 0 && rl.ckpt.Off == 0, "expected empty change log, got %+v", rl)
+
+	orphans := ReplOrphans()
+	tassert.Fatalf(t, len(orphans) == 1 && orphans[0].Equal(&bck2), "unexpected orphans %v", orphans)
+	n := ReplDiscard(&bck2)
+	tassert.Errorf(t, n == 1, "expected 1 discarded record, got %d", n)
+	ReplInit()
+	tassert.Errorf(t, len(rlogs.m) == 1, "expected 1 change log, got %d", len(rlogs.m))
+}
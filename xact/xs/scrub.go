@@ -0,0 +1,355 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"context"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/ec"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/fs/mpather"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+)
+
+// Storage scrub: proactively detects bit rot, rather than waiting for a GET (with
+// `validate_warm_get`) to stumble upon it. Walks a given bucket or all buckets and,
+// for each object stored at its HRW location, reads the object and all its local copies
+// (see `mirror`) and recomputes their checksums. Corrupted (or missing) replicas get
+// restored from a good one; when there's none, the object is restored from its EC slices
+// or, for remote buckets, re-read from the backend. Otherwise, it is counted as unrecoverable.
+// Runs on demand (`ais storage scrub`) or periodically (see cmn.ScrubConf).
+// NOTE: objects without stored checksums (e.g., in buckets with checksumming disabled) are skipped.
+
+type (
+	scrubFactory struct {
+		xreg.RenewBase
+		xctn   *XactScrub
+		statsT stats.Tracker
+	}
+	XactScrub struct {
+		t      cluster.Target
+		statsT stats.Tracker
+		bck    *meta.Bck // nil: all buckets
+		ext    struct {
+			corrupted     atomic.Int64
+			restored      atomic.Int64
+			unrecoverable atomic.Int64
+		}
+		xact.Base
+	}
+	// extended x-scrub statistics
+	ExtStoreScrubStats struct {
+		Corrupted     int64 `json:"corrupted,string"`     // corrupted or missing replicas
+		Restored      int64 `json:"restored,string"`      // replicas restored
+		Unrecoverable int64 `json:"unrecoverable,string"` // objects that could not be restored
+	}
+)
+
+// interface guard
+var (
+	_ cluster.Xact   = (*XactScrub)(nil)
+	_ xreg.Renewable = (*scrubFactory)(nil)
+)
+
+//////////////////
+// scrubFactory //
+//////////////////
+
+func (*scrubFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	p := &scrubFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+	p.statsT, _ = args.Custom.(stats.Tracker)
+	return p
+}
+
+func (p *scrubFactory) Start() error {
+	p.xctn = newXactScrub(p.T, p.statsT, p.UUID(), p.Bck)
+	return nil
+}
+
+func (*scrubFactory) Kind() string        { return apc.ActStoreScrub }
+func (p *scrubFactory) Get() cluster.Xact { return p.xctn }
+
+func (*scrubFactory) WhenPrevIsRunning(prevEntry xreg.Renewable) (xreg.WPR, error) {
+	return xreg.WprUse, cmn.NewErrXactUsePrev(prevEntry.Get().String())
+}
+
+///////////////
+// XactScrub //
+///////////////
+
+func newXactScrub(t cluster.Target, statsT stats.Tracker, uuid string, bck *meta.Bck) (r *XactScrub) {
+	r = &XactScrub{t: t, statsT: statsT, bck: bck}
+	r.InitBase(uuid, apc.ActStoreScrub, bck)
+	return
+}
+
+func (r *XactScrub) Run(*sync.WaitGroup) {
+	var (
+		err  error
+		bcks []*meta.Bck
+	)
+	if r.bck != nil {
+		bcks = []*meta.Bck{r.bck}
+	} else {
+		r.t.Bowner().Get().Range(nil, nil, func(bck *meta.Bck) bool {
+			bcks = append(bcks, bck)
+			return false
+		})
+	}
+	glog.Infof("%s: scrubbing %d bucket(s)", r.Name(), len(bcks))
+	for _, bck := range bcks {
+		if err = r.scrubBck(bck); err != nil {
+			break
+		}
+	}
+	glog.Infof("%s: validated %d objects; corrupted %d, restored %d, unrecoverable %d", r.Name(), r.Objs(),
+		r.ext.corrupted.Load(), r.ext.restored.Load(), r.ext.unrecoverable.Load())
+	r.Finish(err)
+}
+
+func (r *XactScrub) scrubBck(bck *meta.Bck) (err error) {
+	opts := &mpather.JgroupOpts{
+		T:                     r.t,
+		CTs:                   []string{fs.ObjectType},
+		VisitObj:              r.visit,
+		SkipGloballyMisplaced: true, // (and EC replicas - see ec-scrub)
+		Throttle:              true,
+	}
+	opts.Bck.Copy(bck.Bucket())
+	jg := mpather.NewJoggerGroup(opts)
+	jg.Run()
+
+	select {
+	case errCause := <-r.ChanAbort():
+		jg.Stop()
+		err = cmn.NewErrAborted(r.Name(), "", errCause)
+	case <-jg.ListenFinished():
+		err = jg.Stop()
+	}
+	if cmn.IsErrBckNotFound(err) {
+		err = nil // removed in the meantime
+	}
+	return
+}
+
+func (r *XactScrub) visit(lom *cluster.LOM, _ []byte) error {
+	lom.Lock(false)
+	cksum, fqns, skip := r.load(lom)
+	if skip {
+		lom.Unlock(false)
+		return nil
+	}
+	var bad, good []string
+	for _, fqn := range fqns {
+		if validate(fqn, cksum) {
+			good = append(good, fqn)
+		} else {
+			bad = append(bad, fqn)
+		}
+	}
+	size := lom.SizeBytes() * int64(len(fqns))
+	lom.Unlock(false)
+
+	r.ObjsAdd(1, size)
+	if r.statsT != nil {
+		r.statsT.AddMany(
+			cos.NamedVal64{Name: stats.ScrubCount, Value: 1},
+			cos.NamedVal64{Name: stats.ScrubSize, Value: size},
+		)
+	}
+	if len(bad) > 0 {
+		r.ext.corrupted.Add(int64(len(bad)))
+		r.inc(stats.ErrScrubCorruptCount, int64(len(bad)))
+		glog.Errorf("%s: %s: corrupted or missing %v", r, lom, bad)
+		r.repair(lom, cksum, bad, good)
+	}
+	return nil
+}
+
+// returns the stored checksum and the object's replicas (FQNs), including the main one
+func (r *XactScrub) load(lom *cluster.LOM) (cksum *cos.Cksum, fqns []string, skip bool) {
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		if !cmn.IsObjNotExist(err) {
+			glog.Warningf("%s: %v", r, err)
+		}
+		return nil, nil, true
+	}
+	// copies get validated along with their respective main replicas
+	if !lom.IsHRW() {
+		return nil, nil, true
+	}
+	cksum = lom.Checksum()
+	if cksum.IsEmpty() {
+		return nil, nil, true
+	}
+	copies := lom.GetCopies()
+	if len(copies) == 0 {
+		return cksum, []string{lom.FQN}, false
+	}
+	fqns = make([]string, 0, len(copies))
+	for fqn := range copies {
+		fqns = append(fqns, fqn)
+	}
+	return cksum, fqns, false
+}
+
+// read the file in its entirety and compare the checksums
+func validate(fqn string, cksum *cos.Cksum) bool {
+	file, err := os.Open(fqn)
+	if err != nil {
+		return false
+	}
+	_, computed, err := cos.CopyAndChecksum(io.Discard, file, nil, cksum.Ty())
+	cos.Close(file)
+	return err == nil && computed != nil && computed.Equal(cksum)
+}
+
+// restore corrupted replicas, starting from the main one (when bad)
+func (r *XactScrub) repair(lom *cluster.LOM, cksum *cos.Cksum, bad, good []string) {
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil || !lom.EqCksum(cksum) {
+		return // removed or overwritten in the meantime
+	}
+	var (
+		mainBad bool
+		mis     = make([]*fs.Mountpath, 0, len(bad))
+		copies  = lom.GetCopies()
+	)
+	for _, fqn := range bad {
+		if fqn == lom.FQN {
+			mainBad = true
+			continue
+		}
+		mi := copies[fqn]
+		if err := lom.DelCopies(fqn); err != nil {
+			glog.Errorf("%s: %v", r, err)
+			continue
+		}
+		mis = append(mis, mi)
+	}
+	if len(mis) > 0 {
+		if err := lom.Persist(); err != nil {
+			glog.Errorf("%s: %v", r, err)
+			return
+		}
+	}
+	if mainBad {
+		var err error
+		if len(good) > 0 {
+			err = r.fromCopy(lom, good[0])
+		} else {
+			err = r.fromAny(lom)
+		}
+		if err != nil {
+			r.ext.unrecoverable.Inc()
+			r.inc(stats.ErrScrubLostCount, 1)
+			glog.Errorf("%s: failed to restore %s: %v", r, lom, err)
+			return
+		}
+		r.restored(lom, 1)
+	}
+
+	// finally, re-create the copies (from the now good main replica)
+	if len(mis) == 0 {
+		return
+	}
+	buf, slab := r.t.PageMM().Alloc()
+	for _, mi := range mis {
+		if mi == nil || !lom.MirrorConf().Enabled {
+			continue
+		}
+		if err := lom.Copy(mi, buf); err != nil {
+			glog.Errorf("%s: failed to restore %s copy on %s: %v", r, lom, mi, err)
+			continue
+		}
+		r.restored(lom, 1)
+	}
+	slab.Free(buf)
+}
+
+// overwrite the main replica with a good copy
+func (r *XactScrub) fromCopy(lom *cluster.LOM, fqn string) error {
+	src := lom.CloneMD(fqn)
+	defer cluster.FreeLOM(src)
+	if err := src.InitFQN(fqn, lom.Bucket()); err != nil {
+		return err
+	}
+	if err := src.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return err
+	}
+	buf, slab := r.t.PageMM().Alloc()
+	dst, err := src.Copy2FQN(lom.FQN, buf)
+	slab.Free(buf)
+	if err != nil {
+		return err
+	}
+	cluster.FreeLOM(dst)
+	lom.Uncache(true /*delDirty*/)
+	return lom.Load(false /*cache it*/, true /*locked*/)
+}
+
+// no good replicas: restore from EC slices, or re-read from the remote backend
+func (r *XactScrub) fromAny(lom *cluster.LOM) (err error) {
+	switch {
+	case lom.ECEnabled():
+		if err = cos.RemoveFile(lom.FQN); err != nil {
+			return
+		}
+		lom.Uncache(true /*delDirty*/)
+		lom.Unlock(true) // (EC restore takes its own locks)
+		err = ec.ECM.RestoreObject(lom)
+		lom.Lock(true)
+	case lom.Bck().IsRemote():
+		_, err = r.t.GetCold(context.Background(), lom, cmn.OwtGetPrefetchLock /*already locked*/)
+	default:
+		return cmn.NewErrNotImpl("restore", lom.Bck().Provider+" bucket w/ no copies and no EC")
+	}
+	if err == nil {
+		lom.Uncache(true /*delDirty*/)
+		err = lom.Load(false /*cache it*/, true /*locked*/)
+	}
+	return
+}
+
+func (r *XactScrub) restored(lom *cluster.LOM, n int64) {
+	r.ext.restored.Add(n)
+	r.inc(stats.ScrubRestoredCount, n)
+	if cmn.FastV(4, cos.SmoduleXs) {
+		glog.Infof("%s: restored %s", r, lom)
+	}
+}
+
+func (r *XactScrub) inc(name string, n int64) {
+	if r.statsT != nil {
+		r.statsT.Add(name, n)
+	}
+}
+
+func (r *XactScrub) Snap() (snap *cluster.Snap) {
+	snap = &cluster.Snap{}
+	r.ToSnap(snap)
+
+	snap.Ext = &ExtStoreScrubStats{
+		Corrupted:     r.ext.corrupted.Load(),
+		Restored:      r.ext.restored.Load(),
+		Unrecoverable: r.ext.unrecoverable.Load(),
+	}
+	snap.IdleX = r.IsIdle()
+	return
+}
@@ -66,6 +66,10 @@ func setWanted(e *cmn.LsoEntry, lom *cluster.LOM, tmformat string, wanted cos.Bi
 			if md := lom.GetCustomMD(); len(md) > 0 {
 				e.Custom = fmt.Sprintf("%+v", md)
 			}
+		case apc.GetPropsTags:
+			if tags := lom.ObjAttrs().GetTags(); len(tags) > 0 {
+				e.Tags = cmn.Tags2S(tags)
+			}
 		default:
 			debug.Assert(false, name)
 		}
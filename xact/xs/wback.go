@@ -0,0 +1,542 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/3rdparty/glog"
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/atomic"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/fname"
+	"github.com/NVIDIA/aistore/cmn/jsp"
+	"github.com/NVIDIA/aistore/cmn/mono"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/stats"
+	"github.com/NVIDIA/aistore/xact"
+	"github.com/NVIDIA/aistore/xact/xreg"
+	"github.com/OneOfOne/xxhash"
+)
+
+// Write-back: PUT into a bucket with remote backend and `write_policy.data` = "delayed"
+// completes upon local write, while the object gets written to the backend asynchronously:
+// - every object pending upload has its journal record - a small jsp-formatted file
+//   under fname.WriteBackDir on the object's mountpath - that survives restarts;
+// - the object itself is marked with cmn.WriteBackObjMD (protecting it from eviction)
+//   until it is written back;
+// - uploads are performed by the bucket's on-demand xaction (apc.ActWriteBack) that
+//   retries failures with exponential backoff;
+// - journal records that are not owned by any running xaction (e.g., upon restart
+//   or abort) get periodically claimed - see WriteBackOrphans.
+
+const (
+	wbBackoffMin  = 10 * time.Second
+	wbBackoffMax  = 10 * time.Minute
+	wbRetryIval   = time.Second
+	wbWorkersMpth = 4 // concurrent uploads per mountpath
+	wbWorkChSize  = 256
+)
+
+var errWbChanged = errors.New("changed while being written back")
+
+type (
+	wbFactory struct {
+		xreg.RenewBase
+		xctn *XactWriteBack
+	}
+	XactWriteBack struct {
+		t      cluster.Target
+		workCh chan *wbRec
+		stopCh cos.StopCh
+		retry  []*wbRec // failed uploads waiting for their respective next attempts
+		xact.DemandBase
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		failed  atomic.Int64
+		stopped bool // under wbj.mu
+	}
+	// extended x-write-back statistics
+	ExtWriteBackStats struct {
+		Pending int64 `json:"pending,string"` // owned by this xaction, including failed
+		Failed  int64 `json:"failed,string"`  // failed at least once (waiting for retry)
+	}
+
+	// journal record
+	wbRec struct {
+		owner    *XactWriteBack // nil when orphaned
+		path     string         // journal file
+		Bck      cmn.Bck        `json:"bck"`
+		ObjName  string         `json:"name"`
+		Err      string         `json:"err,omitempty"` // last error
+		Ctime    int64          `json:"ctime,string"`  // when journaled (unix nanoseconds)
+		Attempts int            `json:"attempts"`
+		next     int64          // mono time of the next attempt
+		gen      atomic.Int64   // incremented upon every subsequent (journaled) overwrite - see Add
+	}
+)
+
+// interface guard
+var (
+	_ xact.Demand    = (*XactWriteBack)(nil)
+	_ xreg.Renewable = (*wbFactory)(nil)
+	_ jsp.Opts       = (*wbRec)(nil)
+)
+
+// journal: all records by object's uname
+var wbj struct {
+	m      map[string]*wbRec
+	statsT cos.StatsUpdater
+	mu     sync.Mutex
+}
+
+///////////////
+// wbFactory //
+///////////////
+
+func (*wbFactory) New(args xreg.Args, bck *meta.Bck) xreg.Renewable {
+	p := &wbFactory{RenewBase: xreg.RenewBase{Args: args, Bck: bck}}
+	return p
+}
+
+func (p *wbFactory) Start() error {
+	r := &XactWriteBack{t: p.T, workCh: make(chan *wbRec, wbWorkChSize)}
+	r.stopCh.Init()
+	r.DemandBase.Init(cos.GenUUID(), apc.ActWriteBack, p.Bck, 0 /*use default*/)
+	p.xctn = r
+	go r.Run(nil)
+	return nil
+}
+
+func (*wbFactory) Kind() string        { return apc.ActWriteBack }
+func (p *wbFactory) Get() cluster.Xact { return p.xctn }
+
+func (p *wbFactory) WhenPrevIsRunning(xprev xreg.Renewable) (xreg.WPR, error) {
+	debug.Assertf(false, "%s vs %s", p.Str(p.Kind()), xprev) // xreg.usePrev() must've returned true
+	return xreg.WprUse, nil
+}
+
+///////////////////
+// XactWriteBack //
+///////////////////
+
+func (r *XactWriteBack) Run(*sync.WaitGroup) {
+	glog.Infoln(r.Name())
+	n := cos.Max(fs.NumAvail(), 1) * wbWorkersMpth
+	for i := 0; i < n; i++ {
+		r.wg.Add(1)
+		go r.work()
+	}
+	ticker := time.NewTicker(wbRetryIval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			r.retryDue()
+		case <-r.IdleTimer():
+			r.stop()
+			r.Finish(nil)
+			return
+		case errCause := <-r.ChanAbort():
+			n := r.stop()
+			glog.Infof("%s aborted (cause %v): %d object%s remain journaled", r, errCause, n, cos.Plural(n))
+			r.Finish(cmn.NewErrAborted(r.Name(), "", errCause))
+			return
+		}
+	}
+}
+
+// Journal a given (locked) object, so that it'd be written back asynchronously.
+// Must be called prior to making the new content visible (see ais/tgtobj.go).
+func (r *XactWriteBack) Add(lom *cluster.LOM) error {
+	uname := lom.Uname()
+	wbj.mu.Lock()
+	rec, ok := wbj.m[uname]
+	wbj.mu.Unlock()
+	if ok {
+		// already journaled: the upload will pick up the latest content (see upload)
+		rec.gen.Inc()
+		return nil
+	}
+	bck := lom.Bucket()
+	rec = &wbRec{
+		Bck:     cmn.Bck{Name: bck.Name, Provider: bck.Provider, Ns: bck.Ns},
+		ObjName: lom.ObjName,
+		Ctime:   time.Now().UnixNano(),
+		path:    wbPath(lom.Mountpath().Path, uname),
+	}
+	if err := rec.persist(); err != nil {
+		return cmn.NewErrFailedTo(r, "journal", lom.Cname(), err)
+	}
+	wbj.mu.Lock()
+	wbj.m[uname] = rec
+	post := !r.stopped
+	if post {
+		rec.owner = r
+		r.IncPending()
+	} // otherwise, orphaned
+	wbj.mu.Unlock()
+
+	if wbj.statsT != nil {
+		wbj.statsT.Add(stats.WriteBackPending, 1)
+	}
+	if post {
+		r.post(rec)
+	}
+	return nil
+}
+
+// claim orphaned records of this xaction's bucket
+func (r *XactWriteBack) Claim() (n int) {
+	var recs []*wbRec
+	wbj.mu.Lock()
+	if !r.stopped {
+		for _, rec := range wbj.m {
+			if rec.owner == nil && rec.Bck.Equal(r.Bck().Bucket()) {
+				rec.owner = r
+				r.IncPending()
+				recs = append(recs, rec)
+			}
+		}
+	}
+	wbj.mu.Unlock()
+	for _, rec := range recs {
+		r.post(rec)
+	}
+	return len(recs)
+}
+
+func (r *XactWriteBack) post(rec *wbRec) {
+	select {
+	case r.workCh <- rec:
+	default:
+		r.mu.Lock()
+		r.retry = append(r.retry, rec) // (next = 0: retry right away)
+		r.mu.Unlock()
+	}
+}
+
+func (r *XactWriteBack) retryDue() {
+	now := mono.NanoTime()
+	r.mu.Lock()
+	retry := r.retry[:0]
+	for _, rec := range r.retry {
+		if rec.next <= now {
+			select {
+			case r.workCh <- rec:
+				continue
+			default:
+			}
+		}
+		retry = append(retry, rec)
+	}
+	r.retry = retry
+	r.mu.Unlock()
+}
+
+func (r *XactWriteBack) work() {
+	defer r.wg.Done()
+	for {
+		select {
+		case rec := <-r.workCh:
+			r.do(rec)
+		case <-r.stopCh.Listen():
+			return
+		}
+	}
+}
+
+func (r *XactWriteBack) do(rec *wbRec) {
+	lom := cluster.AllocLOM(rec.ObjName)
+	err := lom.InitBck(&rec.Bck)
+	if err == nil {
+		err = r.upload(lom, rec)
+	}
+	cluster.FreeLOM(lom)
+
+	switch {
+	case err == nil:
+		// done
+	case err == errWbChanged:
+		r.post(rec)
+	case cmn.IsObjNotExist(err) || cmn.IsErrBckNotFound(err) || cmn.IsErrRemoteBckNotFound(err):
+		// deleted (or migrated) in the meantime
+		if cmn.FastV(4, cos.SmoduleXs) {
+			glog.Infof("%s: %s/%s: %v - dropping", r, rec.Bck, rec.ObjName, err)
+		}
+		r.drop(rec)
+	default:
+		r.fail(rec, err)
+	}
+}
+
+// 1) under read lock: write the object to its remote backend
+// 2) under write lock: unless changed in the meantime, update and persist its metadata
+// (concurrent overwrites are detected via journal record's generation rather than checksum,
+// which may well be "none")
+func (r *XactWriteBack) upload(lom *cluster.LOM, rec *wbRec) error {
+	lom.Lock(false)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		lom.Unlock(false)
+		return err
+	}
+	if _, ok := lom.GetCustomKey(cmn.WriteBackObjMD); !ok {
+		lom.Unlock(false)
+		r.drop(rec) // e.g., overwritten via write-through
+		return nil
+	}
+	var (
+		gen     = rec.gen.Load()
+		size    = lom.SizeBytes()
+		backend = r.t.Backend(lom.Bck())
+	)
+	// (not to modify cached metadata under read lock)
+	md := make(cos.StrKVs, len(lom.GetCustomMD()))
+	for k, v := range lom.GetCustomMD() {
+		md[k] = v
+	}
+	lom.SetCustomMD(md)
+	lom.ObjAttrs().DelCustomKeys(cmn.WriteBackObjMD, cmn.SourceObjMD, cmn.CRC32CObjMD, cmn.ETag, cmn.MD5ObjMD,
+		cmn.VersionObjMD)
+
+	fh, err := cos.NewFileHandle(lom.FQN)
+	if err != nil {
+		lom.Unlock(false)
+		return err
+	}
+	_, err = backend.PutObj(fh, lom) // (closes fh)
+	lom.Unlock(false)
+	if err != nil {
+		return err
+	}
+
+	// metadata set by the backend
+	var (
+		ver     = lom.Version()
+		updated = make(cos.StrKVs, 4)
+	)
+	for _, k := range []string{cmn.CRC32CObjMD, cmn.ETag, cmn.MD5ObjMD, cmn.VersionObjMD} {
+		if v, ok := lom.GetCustomKey(k); ok {
+			updated[k] = v
+		}
+	}
+	if !lom.Bck().IsRemoteAIS() {
+		updated[cmn.SourceObjMD] = backend.Provider()
+	}
+
+	lom.Lock(true)
+	defer lom.Unlock(true)
+	if err := lom.Load(false /*cache it*/, true /*locked*/); err != nil {
+		return err
+	}
+	if _, ok := lom.GetCustomKey(cmn.WriteBackObjMD); !ok {
+		r.drop(rec) // overwritten via write-through in the meantime
+		return nil
+	}
+	if rec.gen.Load() != gen {
+		return errWbChanged
+	}
+	lom.ObjAttrs().DelCustomKeys(cmn.WriteBackObjMD)
+	for k, v := range updated {
+		lom.SetCustomKey(k, v)
+	}
+	if ver != "" {
+		lom.SetVersion(ver)
+	}
+	if err := lom.Persist(); err != nil {
+		return err
+	}
+	r.drop(rec)
+	r.ObjsAdd(1, size)
+	if wbj.statsT != nil {
+		wbj.statsT.AddMany(
+			cos.NamedVal64{Name: stats.WriteBackCount, Value: 1},
+			cos.NamedVal64{Name: stats.WriteBackSize, Value: size},
+		)
+	}
+	return nil
+}
+
+func (r *XactWriteBack) fail(rec *wbRec, err error) {
+	rec.Attempts++
+	rec.Err = err.Error()
+	if rec.Attempts == 1 {
+		r.failed.Inc()
+	}
+	backoff := wbBackoffMax
+	if rec.Attempts <= 8 {
+		backoff = cos.MinDuration(wbBackoffMin<<(rec.Attempts-1), wbBackoffMax)
+	}
+	rec.next = mono.NanoTime() + int64(backoff)
+	if errV := rec.persist(); errV != nil {
+		glog.Errorf("%s: failed to update journal record %q: %v", r, rec.path, errV)
+	}
+	glog.Errorf("%s: failed to write back %s/%s (attempt %d, retrying in %v): %v",
+		r, rec.Bck, rec.ObjName, rec.Attempts, backoff, err)
+	if wbj.statsT != nil {
+		wbj.statsT.Inc(stats.ErrWriteBackCount)
+	}
+	r.mu.Lock()
+	r.retry = append(r.retry, rec)
+	r.mu.Unlock()
+}
+
+// remove journal record
+func (r *XactWriteBack) drop(rec *wbRec) {
+	wbj.mu.Lock()
+	uname := (*meta.Bck)(&rec.Bck).MakeUname(rec.ObjName)
+	if wbj.m[uname] == rec {
+		delete(wbj.m, uname)
+	}
+	wbj.mu.Unlock()
+	if err := cos.RemoveFile(rec.path); err != nil {
+		glog.Errorf("%s: failed to remove journal record %q: %v", r, rec.path, err)
+	}
+	if rec.Attempts > 0 {
+		r.failed.Dec()
+	}
+	r.DecPending()
+	if wbj.statsT != nil {
+		wbj.statsT.Add(stats.WriteBackPending, -1)
+	}
+}
+
+// stop workers and disown remaining records (that remain journaled)
+func (r *XactWriteBack) stop() (n int) {
+	r.DemandBase.Stop()
+	r.stopCh.Close()
+	r.wg.Wait()
+	wbj.mu.Lock()
+	r.stopped = true
+	for _, rec := range wbj.m {
+		if rec.owner == r {
+			rec.owner = nil
+			n++
+		}
+	}
+	wbj.mu.Unlock()
+	if n > 0 {
+		r.SubPending(n)
+	}
+	return
+}
+
+func (r *XactWriteBack) Snap() (snap *cluster.Snap) {
+	snap = &cluster.Snap{}
+	r.ToSnap(snap)
+
+	snap.Ext = &ExtWriteBackStats{Pending: r.Pending(), Failed: r.failed.Load()}
+	snap.IdleX = r.IsIdle()
+	return
+}
+
+///////////
+// wbRec //
+///////////
+
+var wbJspOpts = jsp.CksumSign(cmn.MetaverWB)
+
+func (*wbRec) JspOpts() jsp.Options { return wbJspOpts }
+
+func (rec *wbRec) persist() error { return jsp.SaveMeta(rec.path, rec, nil /*wto*/) }
+
+func wbPath(mpath, uname string) string {
+	h := xxhash.Checksum64S(cos.UnsafeB(uname), cos.MLCG32)
+	return filepath.Join(mpath, fname.WriteBackDir, strconv.FormatUint(h, 16))
+}
+
+//
+// journal: startup and orphans
+//
+
+// load journal records from all available mountpaths (the records remain orphaned
+// until claimed - see WriteBackOrphans)
+func WriteBackInit(statsT cos.StatsUpdater) {
+	wbj.m = make(map[string]*wbRec, 16)
+	wbj.statsT = statsT
+	for mpath := range fs.GetAvail() {
+		dir := filepath.Join(mpath, fname.WriteBackDir)
+		dentries, err := os.ReadDir(dir)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				glog.Errorf("failed to read %q: %v", dir, err)
+			}
+			continue
+		}
+		for _, dent := range dentries {
+			if dent.IsDir() || strings.Contains(dent.Name(), ".tmp.") {
+				continue
+			}
+			rec := &wbRec{path: filepath.Join(dir, dent.Name())}
+			if _, err := jsp.LoadMeta(rec.path, rec); err != nil {
+				glog.Errorf("failed to load write-back journal record %q (removing): %v", rec.path, err)
+				cos.RemoveFile(rec.path)
+				continue
+			}
+			wbj.m[(*meta.Bck)(&rec.Bck).MakeUname(rec.ObjName)] = rec
+		}
+	}
+	if n := len(wbj.m); n > 0 {
+		glog.Infof("loaded %d object%s pending write-back", n, cos.Plural(n))
+		if statsT != nil {
+			statsT.Add(stats.WriteBackPending, int64(n))
+		}
+	}
+}
+
+// returns buckets that have journaled objects not owned by any running xaction
+func WriteBackOrphans() (bcks []cmn.Bck) {
+	wbj.mu.Lock()
+	for _, rec := range wbj.m {
+		if rec.owner != nil {
+			continue
+		}
+		var found bool
+		for i := range bcks {
+			if bcks[i].Equal(&rec.Bck) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			bcks = append(bcks, rec.Bck)
+		}
+	}
+	wbj.mu.Unlock()
+	return
+}
+
+// discard orphaned journal records of a given bucket (e.g., when the bucket no longer exists)
+func WriteBackDiscard(bck *cmn.Bck) (n int) {
+	var recs []*wbRec
+	wbj.mu.Lock()
+	for uname, rec := range wbj.m {
+		if rec.owner == nil && rec.Bck.Equal(bck) {
+			delete(wbj.m, uname)
+			recs = append(recs, rec)
+		}
+	}
+	wbj.mu.Unlock()
+	for _, rec := range recs {
+		if err := cos.RemoveFile(rec.path); err != nil {
+			glog.Errorf("failed to remove write-back journal record %q: %v", rec.path, err)
+		}
+	}
+	if n = len(recs); n > 0 && wbj.statsT != nil {
+		wbj.statsT.Add(stats.WriteBackPending, -int64(n))
+	}
+	return
+}
@@ -0,0 +1,144 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2023, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"bytes"
+	"io"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/api/apc"
+	"github.com/NVIDIA/aistore/cluster"
+	"github.com/NVIDIA/aistore/cluster/meta"
+	"github.com/NVIDIA/aistore/cluster/mock"
+	"github.com/NVIDIA/aistore/cmn"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/fs"
+	"github.com/NVIDIA/aistore/tools/tassert"
+)
+
+func TestWriteBackJournal(t *testing.T) {
+	var (
+		mpath = t.TempDir()
+		bck1  = cmn.Bck{Name: "b1", Provider: apc.AWS}
+		bck2  = cmn.Bck{Name: "b2", Provider: apc.GCP}
+	)
+	config := cmn.GCO.BeginUpdate()
+	config.TestFSP.Count = 1
+	cmn.GCO.CommitUpdate(config)
+	fs.TestNew(nil)
+	fs.TestDisableValidation()
+	_, err := fs.Add(mpath, "daeID")
+	tassert.CheckFatal(t, err)
+
+	for i, bck := range []cmn.Bck{bck1, bck1, bck2} {
+		objName := "obj-" + cos.GenTie()
+		rec := &wbRec{
+			Bck:      bck,
+			ObjName:  objName,
+			Ctime:    time.Now().UnixNano(),
+			Attempts: i,
+			path:     wbPath(mpath, bck.MakeUname(objName)),
+		}
+		tassert.CheckFatal(t, rec.persist())
+	}
+
+	// restart
+	WriteBackInit(nil)
+	tassert.Fatalf(t, len(wbj.m) == 3, "expected 3 journal records, got %d", len(wbj.m))
+	for uname, rec := range wbj.m {
+		tassert.Errorf(t, rec.Bck.MakeUname(rec.ObjName) == uname && rec.owner == nil, "unexpected record %+v", rec)
+	}
+	orphans := WriteBackOrphans()
+	tassert.Fatalf(t, len(orphans) == 2, "expected 2 buckets with orphaned records, got %v", orphans)
+
+	n := WriteBackDiscard(&bck1)
+	tassert.Errorf(t, n == 2, "expected 2 discarded records, got %d", n)
+	orphans = WriteBackOrphans()
+	tassert.Fatalf(t, len(orphans) == 1 && orphans[0].Equal(&bck2), "unexpected orphans %v", orphans)
+
+	WriteBackInit(nil)
+	tassert.Errorf(t, len(wbj.m) == 1, "expected 1 journal record, got %d", len(wbj.m))
+}
+
+type (
+	wbTarget struct {
+		*mock.TargetMock
+		backend cluster.BackendProvider
+	}
+	wbBackend struct {
+		cluster.BackendProvider
+		onPut func(lom *cluster.LOM)
+		puts  int
+	}
+)
+
+func (t *wbTarget) Backend(*meta.Bck) cluster.BackendProvider { return t.backend }
+
+func (*wbBackend) Provider() string { return apc.AWS }
+
+func (b *wbBackend) PutObj(r io.ReadCloser, lom *cluster.LOM) (int, error) {
+	cos.DrainReader(r)
+	r.Close()
+	b.puts++
+	if b.onPut != nil {
+		b.onPut(lom)
+	}
+	lom.SetCustomKey(cmn.ETag, "etag-"+strconv.Itoa(b.puts))
+	return 0, nil
+}
+
+// write back objects in a bucket with checksumming disabled, with and without concurrent overwrites
+func TestWriteBackUpload(t *testing.T) {
+	var (
+		mpath   = t.TempDir()
+		props   = &cmn.BucketProps{Cksum: cmn.CksumConf{Type: cos.ChecksumNone}, BID: 0xa1b2c3d4}
+		bck     = meta.NewBck("wb", apc.AWS, cmn.NsGlobal, props)
+		backend = &wbBackend{}
+		r       = &XactWriteBack{t: &wbTarget{mock.NewTarget(mock.NewBaseBownerMock(bck)), backend}}
+	)
+	config := cmn.GCO.BeginUpdate()
+	config.TestFSP.Count = 1
+	cmn.GCO.CommitUpdate(config)
+	fs.TestNew(nil)
+	fs.TestDisableValidation()
+	_, err := fs.Add(mpath, "daeID")
+	tassert.CheckFatal(t, err)
+	fs.CSM.Reg(fs.ObjectType, &fs.ObjectContentResolver{})
+	WriteBackInit(nil)
+
+	lom := cluster.AllocLOM("obj")
+	defer cluster.FreeLOM(lom)
+	tassert.CheckFatal(t, lom.InitBck(bck.Bucket()))
+	_, err = cos.SaveReader(lom.FQN, bytes.NewReader([]byte("content")), nil, cos.ChecksumNone, -1)
+	tassert.CheckFatal(t, err)
+	lom.SetSize(7)
+	lom.SetAtimeUnix(time.Now().UnixNano())
+	lom.SetCustomKey(cmn.WriteBackObjMD, "pending")
+	tassert.CheckFatal(t, lom.Persist())
+	tassert.CheckFatal(t, r.Add(lom))
+	rec := wbj.m[lom.Uname()]
+	tassert.Fatalf(t, rec != nil, "expected %s to be journaled", lom)
+
+	// overwritten (and journaled again) while being written back
+	backend.onPut = func(lom *cluster.LOM) { tassert.CheckFatal(t, r.Add(lom)) }
+	err = r.upload(lom, rec)
+	tassert.Fatalf(t, err == errWbChanged, "expected %v, got %v", errWbChanged, err)
+	_, pending := lom.GetCustomKey(cmn.WriteBackObjMD)
+	tassert.Errorf(t, pending, "expected %s to remain pending write-back", lom)
+
+	// unchanged: must complete (no checksum to compare with)
+	backend.onPut = nil
+	err = r.upload(lom, rec)
+	tassert.CheckFatal(t, err)
+	tassert.Fatalf(t, backend.puts == 2, "expected 2 uploads, got %d", backend.puts)
+	_, pending = lom.GetCustomKey(cmn.WriteBackObjMD)
+	etag, _ := lom.GetCustomKey(cmn.ETag)
+	tassert.Errorf(t, !pending && etag == "etag-2", "expected %s to be written back (etag %q)", lom, etag)
+	tassert.Errorf(t, len(wbj.m) == 0, "expected journal record to be removed, got %d", len(wbj.m))
+}